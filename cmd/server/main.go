@@ -7,19 +7,35 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/api"
 	"github.com/mcoot/crosswordgame-go2/internal/factory"
+	"github.com/mcoot/crosswordgame-go2/internal/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/game"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
 	redisstorage "github.com/mcoot/crosswordgame-go2/internal/storage/redis"
 	"github.com/mcoot/crosswordgame-go2/internal/web"
 )
 
+// defaultBotThinkTime is how long bots pause before each action unless
+// overridden by BOT_THINK_TIME
+const defaultBotThinkTime = 800 * time.Millisecond
+
 func main() {
-	// Set up logging with JSON output
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	// Set up logging with JSON output, tagging records logged with a request's
+	// context with its request ID
+	logger := slog.New(middleware.NewRequestIDHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
-	}))
+	})))
 	slog.SetDefault(logger)
 
 	// Build factory config from environment
@@ -29,6 +45,286 @@ func main() {
 		StorageType:    os.Getenv("STORAGE_TYPE"),
 	}
 
+	// Bots pause before acting in the web UI so their moves feel natural
+	cfg.BotConfig = bot.DefaultConfig()
+	cfg.BotConfig.ThinkTime = defaultBotThinkTime
+	if v := os.Getenv("BOT_THINK_TIME"); v != "" {
+		thinkTime, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Error("invalid BOT_THINK_TIME", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.BotConfig.ThinkTime = thinkTime
+	}
+	if v := os.Getenv("MAX_BOTS"); v != "" {
+		maxBots, err := strconv.Atoi(v)
+		if err != nil || maxBots < 1 {
+			logger.Error("invalid MAX_BOTS: must be a positive integer")
+			os.Exit(1)
+		}
+		cfg.BotConfig.MaxBots = maxBots
+	}
+
+	cfg.ScoringConfig = scoring.DefaultConfig()
+	if v := os.Getenv("TIE_BREAK"); v != "" {
+		if v != scoring.TieBreakNone && v != scoring.TieBreakSpeed {
+			logger.Error("invalid TIE_BREAK: must be 'none' or 'speed'")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.TieBreak = v
+	}
+	if v := os.Getenv("SYMMETRY_BONUS"); v != "" {
+		bonus, err := strconv.Atoi(v)
+		if err != nil || bonus < 0 {
+			logger.Error("invalid SYMMETRY_BONUS: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.SymmetryBonus = bonus
+	}
+	if v := os.Getenv("PERFECT_BOARD_BONUS"); v != "" {
+		bonus, err := strconv.Atoi(v)
+		if err != nil || bonus < 0 {
+			logger.Error("invalid PERFECT_BOARD_BONUS: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.PerfectBoardBonus = bonus
+	}
+	if v := os.Getenv("UNIQUE_WORD_BONUS"); v != "" {
+		bonus, err := strconv.Atoi(v)
+		if err != nil || bonus < 0 {
+			logger.Error("invalid UNIQUE_WORD_BONUS: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.UniqueWordBonus = bonus
+	}
+	if v := os.Getenv("DEDUPE_WORDS"); v != "" {
+		dedupe, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Error("invalid DEDUPE_WORDS", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.DedupeWords = dedupe
+	}
+	if v := os.Getenv("BEST_WORD_ONLY"); v != "" {
+		bestOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Error("invalid BEST_WORD_ONLY", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.BestWordOnly = bestOnly
+	}
+	if v := os.Getenv("WORD_VALUE"); v != "" {
+		if v != scoring.WordValueLength && v != scoring.WordValueTile && v != scoring.WordValueTileLength {
+			logger.Error("invalid WORD_VALUE: must be 'length', 'tile' or 'tile+length'")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.WordValue = v
+	}
+
+	// Players whose boards expired are scored as empty unless this is "error"
+	if v := os.Getenv("MISSING_BOARD_POLICY"); v != "" {
+		policy := game.MissingBoardPolicy(v)
+		if policy != game.MissingBoardEmpty && policy != game.MissingBoardError {
+			logger.Error("invalid MISSING_BOARD_POLICY: must be 'empty' or 'error'")
+			os.Exit(1)
+		}
+		cfg.GameConfig.MissingBoards = policy
+	}
+
+	// Crossword-style play: every letter after a board's first must touch one
+	if v := os.Getenv("ADJACENT_PLACEMENT"); v != "" {
+		adjacent, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Error("invalid ADJACENT_PLACEMENT", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.GameConfig.AdjacentPlacement = adjacent
+	}
+
+	// Announcers who stall past the timeout lose their turn to announce
+	if v := os.Getenv("ANNOUNCE_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout < 0 {
+			logger.Error("invalid ANNOUNCE_TIMEOUT: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.GameConfig.AnnounceTimeout = timeout
+	}
+
+	// Games still running this long after they start are abandoned
+	if v := os.Getenv("MAX_GAME_DURATION"); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil || duration < 0 {
+			logger.Error("invalid MAX_GAME_DURATION: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.GameConfig.MaxGameDuration = duration
+	}
+
+	// Announcers choose from a small rack of random letters instead of A-Z
+	if v := os.Getenv("RACK_SIZE"); v != "" {
+		rackSize, err := strconv.Atoi(v)
+		if err != nil || rackSize < 0 {
+			logger.Error("invalid RACK_SIZE: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.GameConfig.RackSize = rackSize
+	}
+
+	// How many placement hints each player may ask for per turn (default 1)
+	if v := os.Getenv("HINTS_PER_TURN"); v != "" {
+		hints, err := strconv.Atoi(v)
+		if err != nil || hints <= 0 {
+			logger.Error("invalid HINTS_PER_TURN: must be a positive integer")
+			os.Exit(1)
+		}
+		cfg.GameConfig.HintsPerTurn = hints
+	}
+
+	// The first announce can wait for every player to load the game
+	if v := os.Getenv("REQUIRE_START_ACK"); v != "" {
+		require, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Error("invalid REQUIRE_START_ACK", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.GameConfig.RequireStartAck = require
+	}
+	if v := os.Getenv("START_ACK_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout < 0 {
+			logger.Error("invalid START_ACK_TIMEOUT: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.GameConfig.StartAckTimeout = timeout
+	}
+
+	// The first player each turn to complete a row word earns this many
+	// points; 0 turns steals off
+	if v := os.Getenv("STEAL_BONUS"); v != "" {
+		bonus, err := strconv.Atoi(v)
+		if err != nil || bonus < 0 {
+			logger.Error("invalid STEAL_BONUS: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.ScoringConfig.StealBonus = bonus
+	}
+
+	// Players leaving mid-game can have a bot finish their board for them
+	if v := os.Getenv("LEAVER_STRATEGY"); v != "" {
+		if !slices.Contains(model.ValidBotStrategies(), v) {
+			logger.Error("invalid LEAVER_STRATEGY: must be a bot strategy", slog.String("strategies", strings.Join(model.ValidBotStrategies(), ", ")))
+			os.Exit(1)
+		}
+		cfg.GameConfig.LeaverStrategy = v
+	}
+
+	// Puzzle grids block some cells on every board, e.g. "2,2;0,4"
+	if v := os.Getenv("BLOCKED_CELLS"); v != "" {
+		blocked, ok := parseBlockedCells(v)
+		if !ok {
+			logger.Error("invalid BLOCKED_CELLS: must be row,col pairs separated by ';'")
+			os.Exit(1)
+		}
+		cfg.GameConfig.BlockedCells = blocked
+	}
+
+	// Non-English word lists can fold accents (é -> E) or allow extra letters
+	if v := os.Getenv("ALPHABET_FOLD_ACCENTS"); v != "" {
+		fold, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Error("invalid ALPHABET_FOLD_ACCENTS", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.Alphabet.FoldAccents = fold
+	}
+	cfg.Alphabet.ExtraLetters = os.Getenv("ALPHABET_EXTRA_LETTERS")
+
+	if v := os.Getenv("SLOW_REQUEST_THRESHOLD"); v != "" {
+		threshold, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Error("invalid SLOW_REQUEST_THRESHOLD", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		cfg.SlowRequestThreshold = threshold
+	}
+
+	// Proxies that close idle connections need more frequent SSE heartbeats
+	if v := os.Getenv("SSE_HEARTBEAT_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			logger.Error("invalid SSE_HEARTBEAT_INTERVAL: must be a positive duration")
+			os.Exit(1)
+		}
+		cfg.SSEConfig.HeartbeatInterval = interval
+	}
+	if v := os.Getenv("SSE_SLOW_CLIENT_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			logger.Error("invalid SSE_SLOW_CLIENT_TIMEOUT: must be a positive duration")
+			os.Exit(1)
+		}
+		cfg.SSEConfig.SlowClientTimeout = timeout
+	}
+
+	// Multi-instance deployments can tolerate unsynced clocks at session expiry
+	if v := os.Getenv("SESSION_CLOCK_SKEW"); v != "" {
+		skew, err := time.ParseDuration(v)
+		if err != nil || skew < 0 {
+			logger.Error("invalid SESSION_CLOCK_SKEW: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.AuthConfig.ClockSkew = skew
+	}
+
+	// Small instances can cap concurrent lobbies
+	if v := os.Getenv("MAX_LOBBIES"); v != "" {
+		maxLobbies, err := strconv.Atoi(v)
+		if err != nil || maxLobbies < 0 {
+			logger.Error("invalid MAX_LOBBIES: must be a non-negative integer")
+			os.Exit(1)
+		}
+		cfg.MaxLobbies = maxLobbies
+	}
+
+	// Offer only specific grid sizes, e.g. "4,5,7", instead of the full range
+	if v := os.Getenv("ALLOWED_GRID_SIZES"); v != "" {
+		for _, part := range strings.Split(v, ",") {
+			size, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || size <= 0 {
+				logger.Error("invalid ALLOWED_GRID_SIZES: must be a comma-separated list of positive integers")
+				os.Exit(1)
+			}
+			cfg.AllowedGridSizes = append(cfg.AllowedGridSizes, size)
+		}
+	}
+
+	// Finished games return their lobby to waiting if the host doesn't dismiss them
+	if v := os.Getenv("AUTO_DISMISS_DELAY"); v != "" {
+		delay, err := time.ParseDuration(v)
+		if err != nil || delay < 0 {
+			logger.Error("invalid AUTO_DISMISS_DELAY: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.LobbyConfig.AutoDismissDelay = delay
+	}
+
+	// Lobbies wait this long after a game before starting another
+	if v := os.Getenv("GAME_COOLDOWN"); v != "" {
+		cooldown, err := time.ParseDuration(v)
+		if err != nil || cooldown < 0 {
+			logger.Error("invalid GAME_COOLDOWN: must be a non-negative duration")
+			os.Exit(1)
+		}
+		cfg.LobbyConfig.GameCooldown = cooldown
+	}
+
+	// Public deployments can reject display names containing blocked words
+	cfg.NameBlocklistPath = os.Getenv("NAME_BLOCKLIST_PATH")
+
+	// Admin endpoints are only served when a token is configured
+	cfg.AdminToken = os.Getenv("ADMIN_TOKEN")
+
 	// Configure Redis if storage type is redis
 	if cfg.StorageType == factory.StorageTypeRedis {
 		redisURL := os.Getenv("REDIS_URL")
@@ -38,9 +334,32 @@ func main() {
 		}
 		redisCfg := redisstorage.DefaultConfig()
 		redisCfg.URL = redisURL
+		// Boards saved as JSON stay readable after switching this on
+		if v := os.Getenv("REDIS_BINARY_BOARDS"); v != "" {
+			binaryBoards, err := strconv.ParseBool(v)
+			if err != nil {
+				logger.Error("invalid REDIS_BINARY_BOARDS", slog.String("error", err.Error()))
+				os.Exit(1)
+			}
+			redisCfg.BinaryBoards = binaryBoards
+		}
 		cfg.RedisConfig = &redisCfg
 	}
 
+	// Without Redis, the in-memory store can be snapshotted to survive restarts
+	if v := os.Getenv("MEMORY_SNAPSHOT_PATH"); v != "" {
+		cfg.MemorySnapshot = memory.DefaultSnapshotConfig()
+		cfg.MemorySnapshot.Path = v
+		if v := os.Getenv("MEMORY_SNAPSHOT_INTERVAL"); v != "" {
+			interval, err := time.ParseDuration(v)
+			if err != nil || interval < 0 {
+				logger.Error("invalid MEMORY_SNAPSHOT_INTERVAL: must be a non-negative duration")
+				os.Exit(1)
+			}
+			cfg.MemorySnapshot.Interval = interval
+		}
+	}
+
 	// Create application factory
 	app, err := factory.New(cfg)
 	if err != nil {
@@ -48,36 +367,56 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Load dictionary
-	if err := app.DictionaryService.LoadFromFile(context.Background(), "data/words.txt"); err != nil {
-		logger.Warn("could not load dictionary", slog.String("error", err.Error()))
-	}
+	// Load the dictionary in the background so large word lists don't delay
+	// startup; games can't be scored until it's ready
+	go func() {
+		onProgress := func(p dictionary.LoadProgress) {
+			logger.Debug("loading dictionary",
+				slog.Int("word_count", p.Words),
+				slog.Int64("bytes_read", p.BytesRead),
+				slog.Int64("total_bytes", p.TotalBytes),
+			)
+		}
+		if err := app.DictionaryService.LoadFromFileWithProgress(context.Background(), "data/words.txt", onProgress); err != nil {
+			logger.Warn("could not load dictionary", slog.String("error", err.Error()))
+		}
+	}()
 
 	// Find static files directory
 	staticDir := findStaticDir()
 
 	// Create API router
 	apiRouter := api.NewRouter(api.RouterConfig{
-		Logger:          logger,
-		AuthService:     app.AuthService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		BotService:      app.BotService,
-		HubManager:      app.HubManager,
+		Logger:            logger,
+		AuthService:       app.AuthService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		BoardImageService: app.BoardImageService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		ScoringService:    app.ScoringService,
+		HubManager:        app.HubManager,
+		Storage:           app.Storage,
+
+		SlowRequestThreshold: app.SlowRequestThreshold,
+		AdminToken:           app.AdminToken,
 	})
 
 	// Create web router
 	webRouter := web.NewRouter(web.RouterConfig{
-		Logger:          logger,
-		AuthService:     app.AuthService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		ScoringService:  app.ScoringService,
-		BotService:      app.BotService,
-		HubManager:      app.HubManager,
-		StaticDir:       staticDir,
+		Logger:            logger,
+		AuthService:       app.AuthService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		ScoringService:    app.ScoringService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		HubManager:        app.HubManager,
+		StaticDir:         staticDir,
+
+		SlowRequestThreshold: app.SlowRequestThreshold,
 	})
 
 	// Combine routers
@@ -117,12 +456,20 @@ func main() {
 			os.Exit(1)
 		}
 	case <-ctx.Done():
+		// Tell SSE clients to reconnect elsewhere and end their streams,
+		// which would otherwise hold the HTTP shutdown open
+		app.HubManager.Shutdown()
 		if err := server.Shutdown(context.Background()); err != nil {
 			logger.Error("shutdown error", slog.String("error", err.Error()))
 			os.Exit(1)
 		}
 	}
 
+	// Save a final memory snapshot now that no requests are in flight
+	if err := app.Close(); err != nil {
+		logger.Error("failed to save memory snapshot", slog.String("error", err.Error()))
+	}
+
 	logger.Info("server stopped")
 }
 
@@ -144,3 +491,24 @@ func findStaticDir() string {
 	// Default to relative path
 	return "internal/web/static"
 }
+
+// parseBlockedCells parses "row,col;row,col" into board positions
+func parseBlockedCells(v string) ([]model.Position, bool) {
+	var cells []model.Position
+	for _, pair := range strings.Split(v, ";") {
+		rowStr, colStr, found := strings.Cut(strings.TrimSpace(pair), ",")
+		if !found {
+			return nil, false
+		}
+		row, err := strconv.Atoi(strings.TrimSpace(rowStr))
+		if err != nil || row < 0 {
+			return nil, false
+		}
+		col, err := strconv.Atoi(strings.TrimSpace(colStr))
+		if err != nil || col < 0 {
+			return nil, false
+		}
+		cells = append(cells, model.Position{Row: row, Col: col})
+	}
+	return cells, true
+}
@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -78,6 +79,57 @@ func (r *cliRunner) runWithToken(token string, args ...string) (string, error) {
 	return string(output), err
 }
 
+// start launches a long-running CLI command with text output, sending each
+// line it prints to the returned channel
+func (r *cliRunner) start(t *testing.T, token string, args ...string) (*exec.Cmd, <-chan string) {
+	t.Helper()
+
+	fullArgs := append([]string{
+		"--server", r.serverURL,
+		"--token", token,
+		"--output", "text",
+	}, args...)
+
+	cmd := exec.Command(r.binaryPath, fullArgs...)
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+	})
+
+	lines := make(chan string, 100)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	return cmd, lines
+}
+
+// waitForLine waits for a line containing substr, failing after a timeout
+func waitForLine(t *testing.T, lines <-chan string, substr string) string {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatalf("output ended before a line containing %q", substr)
+			}
+			if strings.Contains(line, substr) {
+				return line
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for a line containing %q", substr)
+		}
+	}
+}
+
 func findProjectRoot(t *testing.T) string {
 	t.Helper()
 
@@ -103,8 +155,18 @@ type testServer struct {
 	shutdown func()
 }
 
+// testAdminToken is the admin token the e2e servers accept
+const testAdminToken = "e2e-admin-token"
+
 func startTestServer(t *testing.T) *testServer {
 	t.Helper()
+	return startTestServerWithDictionary(t, true)
+}
+
+// startTestServerWithDictionary starts a server, optionally without loading
+// the dictionary, as if it were still loading or had failed to
+func startTestServerWithDictionary(t *testing.T, loadDictionary bool) *testServer {
+	t.Helper()
 
 	// Find a free port
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
@@ -120,35 +182,43 @@ func startTestServer(t *testing.T) *testServer {
 	require.NoError(t, err)
 
 	// Load dictionary
-	err = app.DictionaryService.LoadFromFile(context.Background(), filepath.Join(projectRoot, "data/words.txt"))
-	require.NoError(t, err)
+	if loadDictionary {
+		err = app.DictionaryService.LoadFromFile(context.Background(), filepath.Join(projectRoot, "data/words.txt"))
+		require.NoError(t, err)
+	}
 
 	// Create services
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 	authService := auth.New(app.Storage, app.Clock, auth.DefaultConfig(), logger)
-	hubManager := sse.NewHubManager(logger)
+	hubManager := sse.NewHubManager(sse.DefaultHubConfig(), app.Clock, logger)
 
 	// Create routers
 	apiRouter := api.NewRouter(api.RouterConfig{
-		Logger:          logger,
-		AuthService:     authService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		BotService:      app.BotService,
-		HubManager:      hubManager,
+		Logger:            logger,
+		AuthService:       authService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		BoardImageService: app.BoardImageService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		ScoringService:    app.ScoringService,
+		HubManager:        hubManager,
+		Storage:           app.Storage,
+		AdminToken:        testAdminToken,
 	})
 
 	webRouter := web.NewRouter(web.RouterConfig{
-		Logger:          logger,
-		AuthService:     authService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		ScoringService:  app.ScoringService,
-		BotService:      app.BotService,
-		HubManager:      hubManager,
-		StaticDir:       filepath.Join(projectRoot, "internal/web/static"),
+		Logger:            logger,
+		AuthService:       authService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		ScoringService:    app.ScoringService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		HubManager:        hubManager,
+		StaticDir:         filepath.Join(projectRoot, "internal/web/static"),
 	})
 
 	// Combine routers
@@ -261,6 +331,16 @@ type healthResponse struct {
 	Status string `json:"status"`
 }
 
+type detailedHealthResponse struct {
+	Status  string `json:"status"`
+	Storage struct {
+		Reachable bool `json:"reachable"`
+	} `json:"storage"`
+	Dictionary struct {
+		Loaded bool `json:"loaded"`
+	} `json:"dictionary"`
+}
+
 type messageResponse struct {
 	Message string `json:"message"`
 }
@@ -281,6 +361,38 @@ func TestCLI_HealthCheck(t *testing.T) {
 	assert.Equal(t, "ok", resp.Status)
 }
 
+func TestCLI_AdminHealthcheck(t *testing.T) {
+	ts := startTestServer(t)
+	defer ts.shutdown()
+
+	cli := newCLIRunner(t, ts.addr)
+
+	output, err := cli.run("admin", "healthcheck", "--admin-token", testAdminToken)
+	require.NoError(t, err, "output: %s", output)
+
+	var resp detailedHealthResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &resp))
+	assert.Equal(t, "ok", resp.Status)
+	assert.True(t, resp.Storage.Reachable)
+	assert.True(t, resp.Dictionary.Loaded)
+
+	// The admin token is required
+	output, err = cli.run("admin", "healthcheck", "--admin-token", "wrong")
+	assert.Error(t, err, "output: %s", output)
+}
+
+func TestCLI_AdminHealthcheckWithoutDictionary(t *testing.T) {
+	ts := startTestServerWithDictionary(t, false)
+	defer ts.shutdown()
+
+	cli := newCLIRunner(t, ts.addr)
+
+	output, err := cli.run("admin", "healthcheck", "--admin-token", testAdminToken)
+	require.Error(t, err)
+	assert.Contains(t, output, `"unhealthy"`)
+	assert.Contains(t, output, "server is unhealthy")
+}
+
 func TestCLI_PlayerCommands(t *testing.T) {
 	ts := startTestServer(t)
 	defer ts.shutdown()
@@ -556,3 +668,84 @@ func TestCLI_ErrorHandling(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, strings.ToLower(output), "not found")
 }
+
+func TestCLI_LobbyWatch(t *testing.T) {
+	ts := startTestServer(t)
+	defer ts.shutdown()
+
+	cli := newCLIRunner(t, ts.addr)
+
+	// Create players
+	output, err := cli.run("player", "guest", "--name", "Alice")
+	require.NoError(t, err)
+	var auth1 authResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &auth1))
+	token1 := auth1.SessionToken
+
+	output, err = cli.run("player", "guest", "--name", "Bob")
+	require.NoError(t, err)
+	var auth2 authResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &auth2))
+	token2 := auth2.SessionToken
+
+	output, err = cli.runWithToken(token1, "lobby", "create")
+	require.NoError(t, err)
+	var lobby lobbyResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &lobby))
+	lobbyCode := lobby.Code
+
+	// Alice watches the lobby
+	watch, lines := cli.start(t, token1, "lobby", "watch", lobbyCode, "--exit-on-end")
+	waitForLine(t, lines, "Connected")
+
+	// Bob joining is broadcast as a member update naming him
+	_, err = cli.runWithToken(token2, "lobby", "join", lobbyCode)
+	require.NoError(t, err)
+	line := waitForLine(t, lines, "Members updated")
+	assert.Contains(t, line, "Bob")
+
+	// Starting and abandoning the game ends the watch
+	_, err = cli.runWithToken(token1, "game", "start", lobbyCode)
+	require.NoError(t, err)
+	waitForLine(t, lines, "Game started")
+
+	_, err = cli.runWithToken(token1, "game", "abandon", lobbyCode)
+	require.NoError(t, err)
+	waitForLine(t, lines, "Game abandoned")
+
+	done := make(chan error, 1)
+	go func() { done <- watch.Wait() }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err, "watch should exit cleanly when the game ends")
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not exit after the game ended")
+	}
+}
+
+func TestCLI_LobbyWatchRejectsNonMember(t *testing.T) {
+	ts := startTestServer(t)
+	defer ts.shutdown()
+
+	cli := newCLIRunner(t, ts.addr)
+
+	output, err := cli.run("player", "guest", "--name", "Alice")
+	require.NoError(t, err)
+	var auth1 authResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &auth1))
+
+	output, err = cli.run("player", "guest", "--name", "Bob")
+	require.NoError(t, err)
+	var auth2 authResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &auth2))
+
+	output, err = cli.runWithToken(auth1.SessionToken, "lobby", "create")
+	require.NoError(t, err)
+	var lobby lobbyResponse
+	require.NoError(t, json.Unmarshal([]byte(output), &lobby))
+
+	// Bob isn't in the lobby, so there is nothing to reconnect to
+	output, err = cli.runWithToken(auth2.SessionToken, "lobby", "watch", lobby.Code)
+	assert.Error(t, err)
+	assert.Contains(t, output, "rejected")
+}
@@ -2,28 +2,43 @@ package api_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"image/png"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mcoot/crosswordgame-go2/internal/api"
+	"github.com/mcoot/crosswordgame-go2/internal/api/apierr"
+	"github.com/mcoot/crosswordgame-go2/internal/api/openapi"
 	"github.com/mcoot/crosswordgame-go2/internal/api/response"
 	"github.com/mcoot/crosswordgame-go2/internal/factory"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/services/boardimage"
 	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
+	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 )
 
+// testAdminToken authorizes the admin endpoints in tests
+const testAdminToken = "test-admin-token"
+
 // testServer creates a test server with all dependencies
 type testServer struct {
 	handler http.Handler
 	storage *memory.Storage
 	auth    *auth.Service
+	hubs    *sse.HubManager
 }
 
 func newTestServer(t *testing.T) *testServer {
@@ -38,19 +53,25 @@ func newTestServer(t *testing.T) *testServer {
 	require.NoError(t, err)
 
 	router := api.NewRouter(api.RouterConfig{
-		Logger:          logger,
-		AuthService:     app.AuthService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		BotService:      app.BotService,
-		HubManager:      app.HubManager,
+		Logger:            logger,
+		AuthService:       app.AuthService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		BoardImageService: app.BoardImageService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		ScoringService:    app.ScoringService,
+		HubManager:        app.HubManager,
+		Storage:           app.Storage,
+		AdminToken:        testAdminToken,
 	})
 
 	return &testServer{
 		handler: router,
 		storage: app.Storage.(*memory.Storage),
 		auth:    app.AuthService,
+		hubs:    app.HubManager,
 	}
 }
 
@@ -79,214 +100,1728 @@ func TestHealthCheck(t *testing.T) {
 
 	rr := ts.request(http.MethodGet, "/api/v1/health", nil, "")
 	assert.Equal(t, http.StatusOK, rr.Code)
-	assert.Contains(t, rr.Body.String(), "ok")
+
+	var resp struct {
+		Status           string `json:"status"`
+		DictionaryLoaded bool   `json:"dictionary_loaded"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "ok", resp.Status)
+	assert.True(t, resp.DictionaryLoaded)
+}
+
+func TestDictionaryInfo(t *testing.T) {
+	ts := newTestServer(t)
+
+	rr := ts.request(http.MethodGet, "/api/v1/dictionary/info", nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp struct {
+		Name      string     `json:"name"`
+		WordCount int        `json:"word_count"`
+		Loaded    bool       `json:"loaded"`
+		LoadedAt  *time.Time `json:"loaded_at"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "words", resp.Name)
+	assert.Positive(t, resp.WordCount)
+	assert.True(t, resp.Loaded)
+	assert.NotNil(t, resp.LoadedAt)
+
+	rr = ts.request(http.MethodGet, "/api/v1/dictionary/info?name=words", nil, "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodGet, "/api/v1/dictionary/info?name=french", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Contains(t, rr.Body.String(), "DICTIONARY_NOT_FOUND")
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	ts := newTestServer(t)
+
+	rr := ts.request(http.MethodGet, "/api/v1/openapi.json", nil, "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+	assert.Contains(t, doc, "paths")
+}
+
+func TestOpenAPISpecCoversEveryRoute(t *testing.T) {
+	ts := newTestServer(t)
+
+	var doc struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(openapi.Spec, &doc))
+
+	router, ok := ts.handler.(*mux.Router)
+	require.True(t, ok, "the API handler should be a mux router")
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil // A subrouter prefix, not an endpoint
+		}
+		tmpl, err := route.GetPathTemplate()
+		require.NoError(t, err)
+		path := strings.TrimPrefix(tmpl, "/api/v1")
+
+		item, ok := doc.Paths[path]
+		if !assert.True(t, ok, "%s is missing from the OpenAPI spec", path) {
+			return nil
+		}
+		for _, method := range methods {
+			assert.Contains(t, item, strings.ToLower(method), "%s %s is missing from the OpenAPI spec", method, path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestAdminHubs(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	alice, err := ts.auth.ValidateSession(aliceToken)
+	require.NoError(t, err)
+	bob, err := ts.auth.ValidateSession(bobToken)
+	require.NoError(t, err)
+
+	// Alice has the lobby open in two tabs, Bob in one
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := ts.hubs.GetOrCreateHub(model.LobbyCode(lobbyCode))
+	for _, playerID := range []model.PlayerID{alice.PlayerID, alice.PlayerID, bob.PlayerID} {
+		req := httptest.NewRequest(http.MethodGet, "/lobby/"+lobbyCode+"/events", nil).WithContext(ctx)
+		go sse.ServeSSE(httptest.NewRecorder(), req, hub, playerID)
+	}
+
+	var hubsResp response.HubsResponse
+	require.Eventually(t, func() bool {
+		rr := ts.request(http.MethodGet, "/api/v1/admin/hubs", nil, testAdminToken)
+		if rr.Code != http.StatusOK || json.Unmarshal(rr.Body.Bytes(), &hubsResp) != nil {
+			return false
+		}
+		return len(hubsResp.Hubs) == 1 && hubsResp.Hubs[0].Clients == 3
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, lobbyCode, hubsResp.Hubs[0].LobbyCode)
+	assert.Equal(t, map[string]int{
+		string(alice.PlayerID): 2,
+		string(bob.PlayerID):   1,
+	}, hubsResp.Hubs[0].PlayerClients)
+
+	// Session tokens don't grant admin access
+	rr = ts.request(http.MethodGet, "/api/v1/admin/hubs", nil, aliceToken)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/admin/hubs", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestAdminHealth(t *testing.T) {
+	ts := newTestServer(t)
+
+	rr := ts.request(http.MethodGet, "/api/v1/admin/health", nil, testAdminToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp response.DetailedHealth
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "ok", resp.Status)
+	assert.True(t, resp.Storage.Reachable)
+	assert.True(t, resp.Dictionary.Loaded)
+	assert.Positive(t, resp.Dictionary.Words)
+
+	rr = ts.request(http.MethodGet, "/api/v1/admin/health", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestAdminLobbyExportImport(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+
+	rr := ts.request(http.MethodGet, "/api/v1/admin/lobbies/"+lobbyCode+"/export", nil, testAdminToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	export := json.RawMessage(rr.Body.Bytes())
+
+	// Alice is still in the lobby, so it can't be imported alongside it
+	rr = ts.request(http.MethodPost, "/api/v1/admin/lobbies/import", export, testAdminToken)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/leave", nil, aliceToken)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/admin/lobbies/import", export, testAdminToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	assert.Equal(t, lobbyCode, lobbyResp.Code)
+	assert.Len(t, lobbyResp.Members, 1)
+
+	rr = ts.request(http.MethodPost, "/api/v1/admin/lobbies/import", map[string]any{}, testAdminToken)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/admin/lobbies/"+lobbyCode+"/export", nil, aliceToken)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestAdminForceAdvance(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, aliceToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var started response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &started))
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": 0}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Bob never places
+	path := "/api/v1/admin/games/" + started.ID + "/force-advance"
+	rr = ts.request(http.MethodPost, path, nil, aliceToken)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	rr = ts.request(http.MethodPost, path, nil, testAdminToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp response.ForceAdvanceResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "announcing", resp.State)
+	assert.Equal(t, 1, resp.CurrentTurn)
+	require.Len(t, resp.Skipped, 1)
+
+	// Nothing to force until the next letter is announced
+	rr = ts.request(http.MethodPost, path, nil, testAdminToken)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestMyTurn(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, aliceToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	myTurn := func(token string) response.MyTurn {
+		t.Helper()
+		rr := ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game/my-turn", nil, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp response.MyTurn
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		return resp
+	}
+
+	// Alice announces first
+	alice := myTurn(aliceToken)
+	assert.True(t, alice.IsMyTurn)
+	assert.True(t, alice.CanAnnounce)
+	assert.False(t, alice.CanPlace)
+	assert.Nil(t, alice.CurrentLetter)
+	bob := myTurn(bobToken)
+	assert.False(t, bob.IsMyTurn)
+	assert.False(t, bob.CanAnnounce)
+	assert.False(t, bob.CanPlace)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": 0}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	bob = myTurn(bobToken)
+	assert.True(t, bob.CanPlace)
+	assert.False(t, bob.HasPlaced)
+	require.NotNil(t, bob.CurrentLetter)
+	assert.Equal(t, "A", *bob.CurrentLetter)
+	alice = myTurn(aliceToken)
+	assert.False(t, alice.CanAnnounce)
+	assert.False(t, alice.CanPlace)
+	assert.True(t, alice.HasPlaced)
+}
+
+func TestPlacementHint(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, aliceToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	play := func(letter string, row, col int) {
+		t.Helper()
+		rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": letter}, aliceToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": row, "col": col}, aliceToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+	play("C", 0, 0)
+	play("A", 0, 1)
+
+	// No letter announced yet
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game/hint", nil, aliceToken)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "T"}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game/hint", nil, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp response.PlacementHintsResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "T", resp.Letter)
+	require.NotEmpty(t, resp.Hints)
+	assert.LessOrEqual(t, len(resp.Hints), 3)
+	// Completing CAT is the best placement
+	assert.Equal(t, 0, resp.Hints[0].Row)
+	assert.Equal(t, 2, resp.Hints[0].Col)
+	assert.Positive(t, resp.Hints[0].Score)
+
+	// One hint per turn by default
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game/hint", nil, aliceToken)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Contains(t, rr.Body.String(), "HINT_LIMIT_REACHED")
+}
+
+func TestStandings(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	// Keep boards private, so players only see opponents' ranks
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 3, "spectators_see_boards": false}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, aliceToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	// With private boards, the viewer's own entry is the only one with a score
+	standings := func(token string) (own, opponent response.Standing) {
+		t.Helper()
+		rr := ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game/standings", nil, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp response.StandingsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Len(t, resp.Standings, 2)
+		for _, s := range resp.Standings {
+			if s.Score != nil {
+				own = s
+			} else {
+				opponent = s
+			}
+		}
+		require.NotNil(t, own.Score)
+		require.NotEmpty(t, opponent.PlayerID, "opponents' scores are private")
+		return own, opponent
+	}
+
+	turn := func(announcer, letter string, aliceCol, bobRow, bobCol int) {
+		t.Helper()
+		rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": letter}, announcer)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": aliceCol}, aliceToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": bobRow, "col": bobCol}, bobToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	turn(aliceToken, "C", 0, 2, 2)
+	turn(bobToken, "A", 1, 1, 0)
+
+	// No words yet, so everyone shares first place
+	alice, bob := standings(aliceToken)
+	assert.Equal(t, 1, alice.Rank)
+	assert.Equal(t, 0, alice.Score.TotalScore)
+	assert.Equal(t, 1, bob.Rank)
+
+	// Alice completes CAT and takes the lead
+	turn(aliceToken, "T", 2, 0, 2)
+	alice, bob = standings(aliceToken)
+	assert.Equal(t, 1, alice.Rank)
+	assert.Positive(t, alice.Score.TotalScore)
+	assert.Equal(t, 2, bob.Rank)
+
+	bob, alice = standings(bobToken)
+	assert.Equal(t, 2, bob.Rank)
+	assert.Equal(t, 0, bob.Score.TotalScore)
+	assert.Equal(t, 1, alice.Rank)
 }
 
 func TestCreateGuestPlayer(t *testing.T) {
 	ts := newTestServer(t)
 
-	body := map[string]string{"display_name": "Alice"}
-	rr := ts.request(http.MethodPost, "/api/v1/players/guest", body, "")
+	body := map[string]string{"display_name": "Alice"}
+	rr := ts.request(http.MethodPost, "/api/v1/players/guest", body, "")
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp response.AuthResponse
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Alice", resp.Player.DisplayName)
+	assert.True(t, resp.Player.IsGuest)
+	assert.NotEmpty(t, resp.SessionToken)
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	ts := newTestServer(t)
+
+	// Register
+	registerBody := map[string]string{
+		"username":     "alice",
+		"password":     "secret123",
+		"display_name": "Alice",
+	}
+	rr := ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var registerResp response.AuthResponse
+	err := json.Unmarshal(rr.Body.Bytes(), &registerResp)
+	require.NoError(t, err)
+	assert.False(t, registerResp.Player.IsGuest)
+
+	// Login
+	loginBody := map[string]string{
+		"username": "alice",
+		"password": "secret123",
+	}
+	rr = ts.request(http.MethodPost, "/api/v1/players/login", loginBody, "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var loginResp response.AuthResponse
+	err = json.Unmarshal(rr.Body.Bytes(), &loginResp)
+	require.NoError(t, err)
+	assert.Equal(t, registerResp.Player.ID, loginResp.Player.ID)
+}
+
+func TestResetPasswordWithRecoveryCode(t *testing.T) {
+	ts := newTestServer(t)
+
+	registerBody := map[string]string{
+		"username":     "alice",
+		"password":     "secret123",
+		"display_name": "Alice",
+	}
+	rr := ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	var registerResp response.AuthResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &registerResp))
+	require.NotEmpty(t, registerResp.RecoveryCode)
+
+	// Wrong code is rejected
+	resetBody := map[string]string{
+		"username":      "alice",
+		"recovery_code": "AAAA-BBBB-CCCC-DDDD",
+		"new_password":  "newsecret456",
+	}
+	rr = ts.request(http.MethodPost, "/api/v1/players/reset-password", resetBody, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// Right code resets the password
+	resetBody["recovery_code"] = registerResp.RecoveryCode
+	rr = ts.request(http.MethodPost, "/api/v1/players/reset-password", resetBody, "")
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/players/login", map[string]string{"username": "alice", "password": "secret123"}, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/players/login", map[string]string{"username": "alice", "password": "newsecret456"}, "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Login responses never carry a recovery code
+	var loginResp response.AuthResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &loginResp))
+	assert.Empty(t, loginResp.RecoveryCode)
+
+	rr = ts.request(http.MethodPost, "/api/v1/players/reset-password", map[string]string{"username": "alice"}, "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ts := newTestServer(t)
+
+	registerBody := map[string]string{
+		"username":     "alice",
+		"password":     "secret123",
+		"display_name": "Alice",
+	}
+	rr := ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var registerResp response.AuthResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &registerResp))
+	aliceToken := registerResp.SessionToken
+
+	// Alice joins a guest host's lobby
+	hostToken := createGuestPlayer(t, ts, "Host")
+	code := createLobby(t, ts, hostToken, 0)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+code+"/join", nil, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Deletion requires the password
+	rr = ts.request(http.MethodDelete, "/api/v1/players/me", map[string]string{"password": "wrong"}, aliceToken)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// A refused deletion leaves Alice in the lobby
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+code, nil, hostToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var before response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &before))
+	assert.Len(t, before.Members, 2)
+
+	rr = ts.request(http.MethodDelete, "/api/v1/players/me", map[string]string{"password": "secret123"}, aliceToken)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	// Alice is gone from the lobby and can no longer log in
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+code, nil, hostToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobby response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobby))
+	assert.Len(t, lobby.Members, 1)
+
+	rr = ts.request(http.MethodPost, "/api/v1/players/login", map[string]string{"username": "alice", "password": "secret123"}, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// The username is free to reuse
+	rr = ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	// Guests are deleted without a password
+	rr = ts.request(http.MethodDelete, "/api/v1/players/me", nil, hostToken)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	rr = ts.request(http.MethodGet, "/api/v1/players/me", nil, hostToken)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestGetMyStats(t *testing.T) {
+	ts := newTestServer(t)
+
+	registerBody := map[string]string{
+		"username":     "alice",
+		"password":     "secret123",
+		"display_name": "Alice",
+	}
+	rr := ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var registerResp response.AuthResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &registerResp))
+
+	rr = ts.request(http.MethodGet, "/api/v1/players/me/stats", nil, registerResp.SessionToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var stats response.PlayerStats
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &stats))
+	assert.Zero(t, stats.GamesPlayed)
+	assert.Nil(t, stats.BestWord)
+
+	// Guests have no stats
+	guestToken := createGuestPlayer(t, ts, "Guest")
+	rr = ts.request(http.MethodGet, "/api/v1/players/me/stats", nil, guestToken)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestLobbyRules(t *testing.T) {
+	ts := newTestServer(t)
+	token := createGuestPlayer(t, ts, "Host")
+	code := createLobby(t, ts, token, 0)
+
+	getRules := func() response.LobbyRules {
+		t.Helper()
+		rr := ts.request(http.MethodGet, "/api/v1/lobbies/"+code+"/rules", nil, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var rules response.LobbyRules
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rules))
+		return rules
+	}
+
+	rules := getRules()
+	assert.Equal(t, 5, rules.GridSize)
+	assert.Equal(t, 2, rules.MinWordLength)
+	assert.Equal(t, 2, rules.FullLineMultiplier)
+	assert.False(t, rules.Diagonals)
+	assert.False(t, rules.RequireEdgeAnchored)
+	assert.Equal(t, "none", rules.TieBreak)
+	require.NotNil(t, rules.DictionaryWords)
+	assert.Positive(t, *rules.DictionaryWords)
+
+	// Host changes are reflected immediately
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+code+"/config", map[string]any{
+		"grid_size":             4,
+		"require_edge_anchored": true,
+	}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rules = getRules()
+	assert.Equal(t, 4, rules.GridSize)
+	assert.True(t, rules.RequireEdgeAnchored)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/NOPE00/rules", nil, token)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGetMe(t *testing.T) {
+	ts := newTestServer(t)
+
+	// Create guest first
+	body := map[string]string{"display_name": "Bob"}
+	rr := ts.request(http.MethodPost, "/api/v1/players/guest", body, "")
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	var authResp response.AuthResponse
+	err := json.Unmarshal(rr.Body.Bytes(), &authResp)
+	require.NoError(t, err)
+
+	// Get me
+	rr = ts.request(http.MethodGet, "/api/v1/players/me", nil, authResp.SessionToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var meResp response.Player
+	err = json.Unmarshal(rr.Body.Bytes(), &meResp)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", meResp.DisplayName)
+}
+
+func TestGetMeIncludesActiveGame(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token1, 5)
+
+	// No active game before starting
+	rr := ts.request(http.MethodGet, "/api/v1/players/me", nil, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var meResp response.PlayerMe
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &meResp))
+	require.NotNil(t, meResp.ActiveLobby)
+	assert.Equal(t, lobbyCode, *meResp.ActiveLobby)
+	assert.Nil(t, meResp.ActiveGame)
+
+	// Start game
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+
+	rr = ts.request(http.MethodGet, "/api/v1/players/me", nil, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	meResp = response.PlayerMe{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &meResp))
+	require.NotNil(t, meResp.ActiveGame)
+	assert.Equal(t, gameResp.ID, *meResp.ActiveGame)
+	assert.Equal(t, "Alice", meResp.DisplayName)
+}
+
+func TestRenamePlayerUpdatesLobbyMembers(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token1, 5)
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Bob renames himself
+	rr = ts.request(http.MethodPatch, "/api/v1/players/me", map[string]string{"display_name": "Robert"}, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var meResp response.PlayerMe
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &meResp))
+	assert.Equal(t, "Robert", meResp.DisplayName)
+
+	// The session reflects the new name
+	rr = ts.request(http.MethodGet, "/api/v1/players/me", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	meResp = response.PlayerMe{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &meResp))
+	assert.Equal(t, "Robert", meResp.DisplayName)
+
+	// The lobby member list reflects the new name
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode, nil, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	require.Len(t, lobbyResp.Members, 2)
+	assert.Equal(t, "Robert", lobbyResp.Members[1].DisplayName)
+}
+
+func TestRenamePlayerRejectsInvalidNames(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+
+	rr := ts.request(http.MethodPatch, "/api/v1/players/me", map[string]string{"display_name": ""}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodPatch, "/api/v1/players/me", map[string]string{"display_name": "ThisNameIsWayTooLongToUse"}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestUnauthorizedWithoutToken(t *testing.T) {
+	ts := newTestServer(t)
+
+	// Try to get /me without token
+	rr := ts.request(http.MethodGet, "/api/v1/players/me", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// Try to create lobby without token
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestCreateAndJoinLobby(t *testing.T) {
+	ts := newTestServer(t)
+
+	// Create two players
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+
+	// Alice creates a lobby
+	body := map[string]int{"grid_size": 5}
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies", body, token1)
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var lobbyResp response.Lobby
+	err := json.Unmarshal(rr.Body.Bytes(), &lobbyResp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "waiting", lobbyResp.State)
+	assert.Equal(t, 5, lobbyResp.Config.GridSize)
+	assert.Len(t, lobbyResp.Members, 1)
+	assert.True(t, lobbyResp.Members[0].IsHost)
+
+	// Bob joins the lobby
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyResp.Code+"/join", nil, token2)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var joinResp response.Lobby
+	err = json.Unmarshal(rr.Body.Bytes(), &joinResp)
+	require.NoError(t, err)
+	assert.Len(t, joinResp.Members, 2)
+}
+
+func TestJoinLobbyTwiceIsIdempotent(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+
+	for range 2 {
+		rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp response.Lobby
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Len(t, resp.Members, 2)
+	}
+}
+
+func TestJoinAutoStartsFullLobby(t *testing.T) {
+	ts := newTestServer(t)
+
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, aliceToken, 3)
+
+	body := map[string]any{"grid_size": 3, "max_players": 2, "auto_start": true}
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", body, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var configResp response.LobbyConfig
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &configResp))
+	assert.Equal(t, 2, configResp.MaxPlayers)
+	assert.True(t, configResp.AutoStart)
+
+	// Bob fills the lobby, starting the game
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var joinResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &joinResp))
+	assert.Equal(t, "in_game", joinResp.State)
+	assert.NotNil(t, joinResp.CurrentGame)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, bobToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestLobbyGridSizeValidation(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies", map[string]int{"grid_size": 9}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodeInvalidGridSize, errResp.Error.Code)
+	assert.Contains(t, errResp.Error.Message, "between 2 and 7")
+
+	lobbyCode := createLobby(t, ts, token, 3)
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]int{"grid_size": 1}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]int{"grid_size": 7}, token)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestValidateLobbyConfig(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	otherToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token, 3)
+	path := "/api/v1/lobbies/" + lobbyCode + "/config/validate"
+
+	validate := func(body map[string]any) response.ConfigValidation {
+		t.Helper()
+		rr := ts.request(http.MethodPost, path, body, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp response.ConfigValidation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		return resp
+	}
+
+	// Every problem is reported against its field
+	resp := validate(map[string]any{"grid_size": 9, "placement_mode": "spiral"})
+	assert.False(t, resp.Valid)
+	require.Len(t, resp.Errors, 2)
+	assert.Equal(t, "grid_size", resp.Errors[0].Field)
+	assert.Contains(t, resp.Errors[0].Message, "between 2 and 7")
+	assert.Equal(t, "placement_mode", resp.Errors[1].Field)
+
+	resp = validate(map[string]any{"grid_size": 4})
+	assert.True(t, resp.Valid)
+	assert.Empty(t, resp.Errors)
+
+	// Nothing was applied
+	rr := ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	assert.Equal(t, 3, lobbyResp.Config.GridSize)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, otherToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, path, map[string]any{"grid_size": 4}, otherToken)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestSequentialPlacementMode(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 3, "placement_mode": "spiral"}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 3, "placement_mode": "sequential"}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var cfg response.LobbyConfig
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &cfg))
+	assert.Equal(t, "sequential", cfg.PlacementMode)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+	require.NotNil(t, gameResp.RequiredRow)
+	require.NotNil(t, gameResp.RequiredCol)
+	assert.Equal(t, 0, *gameResp.RequiredRow)
+	assert.Equal(t, 0, *gameResp.RequiredCol)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 2, "col": 2}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodePositionNotAllowed, errResp.Error.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": 0}, token)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestLobbyHostActions(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+
+	// Create lobby
+	lobbyCode := createLobby(t, ts, token1, 5)
+
+	// Bob joins
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Bob tries to update config (should fail - not host)
+	body := map[string]int{"grid_size": 7}
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", body, token2)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	// Alice updates config (should succeed)
+	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", body, token1)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var configResp response.LobbyConfig
+	err := json.Unmarshal(rr.Body.Bytes(), &configResp)
+	require.NoError(t, err)
+	assert.Equal(t, 7, configResp.GridSize)
+}
+
+func TestRegenerateLobbyCode(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+	oldCode := createLobby(t, ts, token1, 3)
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+oldCode+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Only the host may regenerate
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+oldCode+"/regenerate-code", nil, token2)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+oldCode+"/regenerate-code", nil, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var moved response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &moved))
+	assert.NotEqual(t, oldCode, moved.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+oldCode, nil, token1)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+moved.Code, nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	require.Len(t, lobbyResp.Members, 2)
+	assert.Equal(t, "Alice", lobbyResp.Members[0].DisplayName)
+	assert.Equal(t, "Bob", lobbyResp.Members[1].DisplayName)
+	assert.Equal(t, 3, lobbyResp.Config.GridSize)
+
+	// Not while a game is running
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+moved.Code+"/game", nil, token1)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+moved.Code+"/regenerate-code", nil, token1)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestFullGameFlow(t *testing.T) {
+	ts := newTestServer(t)
+
+	// Create two players
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+
+	// Create lobby and have Bob join
+	lobbyCode := createLobby(t, ts, token1, 3) // 3x3 = 9 turns
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Start game
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var gameResp response.GameState
+	err := json.Unmarshal(rr.Body.Bytes(), &gameResp)
+	require.NoError(t, err)
+	assert.Equal(t, "announcing", gameResp.State)
+	assert.Len(t, gameResp.Players, 2)
+
+	// Get game state
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Play one turn - determine who is announcer
+	announcer := gameResp.CurrentAnnouncer
+	announcerToken := token1
+	otherToken := token2
+	if announcer != gameResp.Players[0] {
+		announcerToken = token2
+		otherToken = token1
+	}
+
+	// Non-letters are rejected before reaching the game
+	for _, bad := range []string{"", "AB", "7"} {
+		rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": bad}, announcerToken)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, bad)
+		var errResp apierr.ErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+		assert.Equal(t, apierr.CodeInvalidLetter, errResp.Error.Code, bad)
+	}
+
+	// Announce letter
+	announceBody := map[string]string{"letter": "A"}
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", announceBody, announcerToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var announceResp response.AnnounceResponse
+	err = json.Unmarshal(rr.Body.Bytes(), &announceResp)
+	require.NoError(t, err)
+	assert.Equal(t, "placing", announceResp.State)
+	assert.Equal(t, "A", announceResp.CurrentLetter)
+
+	// Both players place
+	placeBody := map[string]int{"row": 0, "col": 0}
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", placeBody, announcerToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var placeResp response.PlaceResponse
+	err = json.Unmarshal(rr.Body.Bytes(), &placeResp)
+	require.NoError(t, err)
+	assert.True(t, placeResp.Placed)
+	assert.False(t, placeResp.TurnComplete) // Other player hasn't placed yet
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", placeBody, otherToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	err = json.Unmarshal(rr.Body.Bytes(), &placeResp)
+	require.NoError(t, err)
+	assert.True(t, placeResp.TurnComplete) // All players placed
+}
+
+func TestStartGameWithClientID(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
+
+	rr := ts.request(http.MethodPost, gamePath, map[string]string{"game_id": "not.valid"}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// A body that doesn't decode is refused rather than given a random ID
+	rr = ts.request(http.MethodPost, gamePath, map[string]any{"game_id": 123}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodPost, gamePath, map[string]string{"game_id": "alice-game-1"}, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+	assert.Equal(t, "alice-game-1", gameResp.ID)
+
+	// Retrying the same start is reported as a duplicate
+	rr = ts.request(http.MethodPost, gamePath, map[string]string{"game_id": "alice-game-1"}, token)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodeGameExists, errResp.Error.Code)
+}
+
+func TestStartGameRequiresReadyPlayers(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token1, 3)
+	lobbyPath := "/api/v1/lobbies/" + lobbyCode
+
+	rr := ts.request(http.MethodPost, lobbyPath+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPatch, lobbyPath+"/config", map[string]any{"grid_size": 3, "require_ready": true}, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = ts.request(http.MethodPost, lobbyPath+"/game", nil, token1)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodePlayersNotReady, errResp.Error.Code)
+
+	rr = ts.request(http.MethodPost, lobbyPath+"/ready", map[string]bool{"ready": true}, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	assert.True(t, lobbyResp.Members[0].Ready)
+	assert.False(t, lobbyResp.Members[1].Ready)
+
+	rr = ts.request(http.MethodPost, lobbyPath+"/ready", map[string]bool{"ready": true}, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = ts.request(http.MethodPost, lobbyPath+"/game", nil, token1)
+	assert.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestPlacementConfirmationFlow(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+
+	// Enable placement confirmation
+	configBody := map[string]any{"grid_size": 3, "require_confirm": true}
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", configBody, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var configResp response.LobbyConfig
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &configResp))
+	assert.True(t, configResp.RequireConfirm)
+	assert.Equal(t, 3, configResp.GridSize)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Confirming before staging fails
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place/confirm", nil, token)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	// Placing only stages the letter
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 1, "col": 1}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var placeResp response.PlaceResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &placeResp))
+	assert.True(t, placeResp.Pending)
+	assert.False(t, placeResp.Placed)
+	require.NotNil(t, placeResp.PendingRow)
+	assert.Equal(t, 1, *placeResp.PendingRow)
+
+	// Confirming commits the placement and completes the turn
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place/confirm", nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	placeResp = response.PlaceResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &placeResp))
+	assert.True(t, placeResp.Placed)
+	assert.True(t, placeResp.TurnComplete)
+	assert.Equal(t, "A", placeResp.Board.Cells[1][1])
+}
+
+func TestPlaceReportsTurnCompleteInSimultaneousMode(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 3, "mode": "simultaneous"}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	// The letter is drawn, so placing straight away completes the turn
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": 0}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var placeResp response.PlaceResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &placeResp))
+	assert.True(t, placeResp.Placed)
+	assert.True(t, placeResp.TurnComplete)
+	assert.False(t, placeResp.GameComplete)
+	assert.Empty(t, placeResp.NextAnnouncer)
+}
+
+func TestSpectateByToken(t *testing.T) {
+	ts := newTestServer(t)
+
+	token1 := createGuestPlayer(t, ts, "Alice")
+	token2 := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token1, 3)
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var memberView response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &memberView))
+	require.NotEmpty(t, memberView.SpectateToken)
+	spectatePath := "/api/v1/spectate/" + memberView.SpectateToken
+
+	// Anyone with the link sees every board without joining the lobby
+	rr = ts.request(http.MethodGet, spectatePath, nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var spectatorView response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &spectatorView))
+	assert.Equal(t, memberView.ID, spectatorView.ID)
+	assert.Len(t, spectatorView.AllBoards, 2)
+	assert.Nil(t, spectatorView.MyBoard)
+	assert.Empty(t, spectatorView.SpectateToken)
+
+	// Only the host is given the link
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token2)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var playerView response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &playerView))
+	assert.Empty(t, playerView.SpectateToken)
+
+	// The token is not a credential for acting on the game
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, memberView.SpectateToken)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// Unknown and tampered tokens are rejected
+	rr = ts.request(http.MethodGet, "/api/v1/spectate/not-a-token", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr = ts.request(http.MethodGet, "/api/v1/spectate/"+memberView.ID+".wrong", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// The link stops working once the game ends
+	rr = ts.request(http.MethodDelete, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	rr = ts.request(http.MethodGet, spectatePath, nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestSpectateByTokenHonoursHiddenBoards(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 3, "spectators_see_boards": false}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var hostView response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &hostView))
+	require.NotEmpty(t, hostView.SpectateToken)
+
+	rr = ts.request(http.MethodGet, "/api/v1/spectate/"+hostView.SpectateToken, nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var spectatorView response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &spectatorView))
+	assert.Empty(t, spectatorView.AllBoards)
+}
+
+func TestSpectatorBoardsHiddenUntilScored(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	spectatorToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token, 2)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
+
+	// Delay the reveal so the scored game stays current in the lobby
+	configBody := map[string]any{"grid_size": 2, "max_players": 1, "delayed_reveal": true, "spectators_see_boards": false}
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", configBody, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var configResp response.LobbyConfig
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &configResp))
+	assert.False(t, configResp.SpectatorsSeeBoards)
+
+	// The lobby is full, so Bob joins as a spectator
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, spectatorToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": "A"}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": 0, "col": 0}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Mid-game the spectator sees turn status but no boards
+	rr = ts.request(http.MethodGet, gamePath, nil, spectatorToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var midGame response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &midGame))
+	assert.Equal(t, "announcing", midGame.State)
+	assert.Equal(t, 1, midGame.CurrentTurn)
+	assert.Empty(t, midGame.AllBoards)
+	assert.Nil(t, midGame.MyBoard)
+
+	for turn := 1; turn < 4; turn++ {
+		rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": "A"}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": turn / 2, "col": turn % 2}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	// Once the game is scored the boards are shown
+	rr = ts.request(http.MethodGet, gamePath, nil, spectatorToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var scored response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &scored))
+	assert.Equal(t, "scoring", scored.State)
+	require.Len(t, scored.AllBoards, 1)
+	for _, board := range scored.AllBoards {
+		assert.Equal(t, "A", board.Cells[0][0])
+	}
+}
+
+func TestBoardImage(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	otherToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token, 2) // 2x2 = 4 turns
+
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+	imagePath := "/api/v1/games/" + gameResp.ID + "/boards/" + gameResp.Players[0] + ".png"
+
+	// Before the game completes only the owner can view the board
+	rr = ts.request(http.MethodGet, imagePath, nil, otherToken)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 
-	assert.Equal(t, http.StatusCreated, rr.Code)
+	rr = ts.request(http.MethodGet, imagePath, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "image/png", rr.Header().Get("Content-Type"))
+
+	// Play out the game
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+			rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": row, "col": col}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
+	}
 
-	var resp response.AuthResponse
-	err := json.Unmarshal(rr.Body.Bytes(), &resp)
-	require.NoError(t, err)
+	// Once scored the board is public
+	rr = ts.request(http.MethodGet, imagePath, nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
 
-	assert.Equal(t, "Alice", resp.Player.DisplayName)
-	assert.True(t, resp.Player.IsGuest)
-	assert.NotEmpty(t, resp.SessionToken)
+	img, err := png.Decode(rr.Body)
+	require.NoError(t, err)
+	assert.Equal(t, boardimage.ImageSize(2), img.Bounds().Dx())
+	assert.Equal(t, boardimage.ImageSize(2), img.Bounds().Dy())
 }
 
-func TestRegisterAndLogin(t *testing.T) {
+func TestGameWordStatistics(t *testing.T) {
 	ts := newTestServer(t)
 
-	// Register
-	registerBody := map[string]string{
-		"username":     "alice",
-		"password":     "secret123",
-		"display_name": "Alice",
+	hostToken := createGuestPlayer(t, ts, "Alice")
+	guestToken := createGuestPlayer(t, ts, "Bob")
+	tokens := map[string]string{}
+	for _, token := range []string{hostToken, guestToken} {
+		rr := ts.request(http.MethodGet, "/api/v1/players/me", nil, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var me response.Player
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &me))
+		tokens[me.ID] = token
 	}
-	rr := ts.request(http.MethodPost, "/api/v1/players/register", registerBody, "")
-	assert.Equal(t, http.StatusCreated, rr.Code)
 
-	var registerResp response.AuthResponse
-	err := json.Unmarshal(rr.Body.Bytes(), &registerResp)
-	require.NoError(t, err)
-	assert.False(t, registerResp.Player.IsGuest)
+	lobbyCode := createLobby(t, ts, hostToken, 3)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, guestToken)
+	require.Equal(t, http.StatusOK, rr.Code)
 
-	// Login
-	loginBody := map[string]string{
-		"username": "alice",
-		"password": "secret123",
+	rr = ts.request(http.MethodPost, gamePath, nil, hostToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+	wordsPath := "/api/v1/games/" + gameResp.ID + "/words"
+
+	// Not available until the game is scored
+	rr = ts.request(http.MethodGet, wordsPath, nil, hostToken)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	// Both players spell CAT across the top row; Q fills the rest without
+	// forming any words
+	letters := "CATQQQQQQ"
+	for i, letter := range letters {
+		rr = ts.request(http.MethodGet, gamePath, nil, hostToken)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+
+		rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": string(letter)}, tokens[gameResp.CurrentAnnouncer])
+		require.Equal(t, http.StatusOK, rr.Code)
+		for _, token := range tokens {
+			rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": i / 3, "col": i % 3}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
 	}
-	rr = ts.request(http.MethodPost, "/api/v1/players/login", loginBody, "")
-	assert.Equal(t, http.StatusOK, rr.Code)
 
-	var loginResp response.AuthResponse
-	err = json.Unmarshal(rr.Body.Bytes(), &loginResp)
-	require.NoError(t, err)
-	assert.Equal(t, registerResp.Player.ID, loginResp.Player.ID)
+	// The finished game's word statistics are public
+	rr = ts.request(http.MethodGet, wordsPath, nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var wordsResp response.GameWords
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &wordsResp))
+	assert.Equal(t, gameResp.ID, wordsResp.GameID)
+	require.Contains(t, wordsResp.Words, "CAT")
+	assert.Equal(t, 2, wordsResp.Words["CAT"].Count)
+	assert.Positive(t, wordsResp.Words["CAT"].Score)
+	assert.ElementsMatch(t, gameResp.Players, wordsResp.Words["CAT"].Players)
+
+	rr = ts.request(http.MethodGet, "/api/v1/games/no-such-game/words", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 }
 
-func TestGetMe(t *testing.T) {
+func TestGameBundle(t *testing.T) {
 	ts := newTestServer(t)
 
-	// Create guest first
-	body := map[string]string{"display_name": "Bob"}
-	rr := ts.request(http.MethodPost, "/api/v1/players/guest", body, "")
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
+
+	rr := ts.request(http.MethodPost, gamePath, nil, token)
 	require.Equal(t, http.StatusCreated, rr.Code)
+	var gameResp response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gameResp))
+	bundlePath := "/api/v1/games/" + gameResp.ID + "/bundle"
 
-	var authResp response.AuthResponse
-	err := json.Unmarshal(rr.Body.Bytes(), &authResp)
-	require.NoError(t, err)
+	// Not available until the game is scored
+	rr = ts.request(http.MethodGet, bundlePath, nil, token)
+	assert.Equal(t, http.StatusConflict, rr.Code)
 
-	// Get me
-	rr = ts.request(http.MethodGet, "/api/v1/players/me", nil, authResp.SessionToken)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	for i, letter := range "CATQQQQQQ" {
+		rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": string(letter)}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": i / 3, "col": i % 3}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
 
-	var meResp response.Player
-	err = json.Unmarshal(rr.Body.Bytes(), &meResp)
-	require.NoError(t, err)
-	assert.Equal(t, "Bob", meResp.DisplayName)
+	rr = ts.request(http.MethodGet, bundlePath, nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `attachment; filename="game-`+gameResp.ID+`.json"`, rr.Header().Get("Content-Disposition"))
+
+	var bundle response.GameBundle
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &bundle))
+	assert.Equal(t, gameResp.ID, bundle.ID)
+	require.NotNil(t, bundle.Summary.Winner)
+	assert.Equal(t, gameResp.Players[0], *bundle.Summary.Winner)
+	require.Len(t, bundle.Scores, 1)
+	assert.Positive(t, bundle.Scores[0].TotalScore)
+	assert.NotEmpty(t, bundle.Moves)
+
+	// The encoded board decodes back to the played letters
+	var decoded model.Board
+	require.NoError(t, decoded.UnmarshalBinary(bundle.Boards[gameResp.Players[0]]))
+	assert.Equal(t, model.GameID(gameResp.ID), decoded.GameID)
+	assert.Equal(t, []rune{'C', 'A', 'T'}, decoded.Cells[0])
+
+	rr = ts.request(http.MethodGet, "/api/v1/games/no-such-game/bundle", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 }
 
-func TestUnauthorizedWithoutToken(t *testing.T) {
+func TestExplainScore(t *testing.T) {
 	ts := newTestServer(t)
 
-	// Try to get /me without token
-	rr := ts.request(http.MethodGet, "/api/v1/players/me", nil, "")
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	body := map[string]any{"cells": [][]string{{"C", "A", "T"}, {"", "", ""}, {"", "", ""}}}
+	rr := ts.request(http.MethodPost, "/api/v1/scoring/explain", body, "")
+	require.Equal(t, http.StatusOK, rr.Code)
 
-	// Try to create lobby without token
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies", nil, "")
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	var resp response.ScoreExplanation
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, 6, resp.Score.TotalScore)
+
+	var at *response.WordCandidate
+	for i, c := range resp.Candidates {
+		if c.Word == "AT" && c.Row == 0 {
+			at = &resp.Candidates[i]
+		}
+	}
+	require.NotNil(t, at)
+	assert.Equal(t, "subsumed", at.Outcome)
+	assert.Equal(t, "CAT", at.SubsumedBy)
+	assert.Equal(t, []response.Cell{{Row: 0, Col: 1}, {Row: 0, Col: 2}}, at.Cells)
+	require.NotEmpty(t, resp.Steps)
+	assert.Equal(t, 6, resp.Steps[len(resp.Steps)-1].Total)
+
+	// Boards must be square
+	body = map[string]any{"cells": [][]string{{"C", "A", "T"}}}
+	rr = ts.request(http.MethodPost, "/api/v1/scoring/explain", body, "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
 }
 
-func TestCreateAndJoinLobby(t *testing.T) {
+func TestStreamLobbyEvents(t *testing.T) {
 	ts := newTestServer(t)
 
-	// Create two players
-	token1 := createGuestPlayer(t, ts, "Alice")
-	token2 := createGuestPlayer(t, ts, "Bob")
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 2)
+	streamPath := "/api/v1/lobbies/" + lobbyCode + "/events/stream"
 
-	// Alice creates a lobby
-	body := map[string]int{"grid_size": 5}
-	rr := ts.request(http.MethodPost, "/api/v1/lobbies", body, token1)
-	assert.Equal(t, http.StatusCreated, rr.Code)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+			rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": row, "col": col}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
+	}
 
-	var lobbyResp response.Lobby
-	err := json.Unmarshal(rr.Body.Bytes(), &lobbyResp)
-	require.NoError(t, err)
+	rr = ts.request(http.MethodGet, streamPath, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
 
-	assert.Equal(t, "waiting", lobbyResp.State)
-	assert.Equal(t, 5, lobbyResp.Config.GridSize)
-	assert.Len(t, lobbyResp.Members, 1)
-	assert.True(t, lobbyResp.Members[0].IsHost)
+	lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+	events := make([]response.Event, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &events[i]))
+	}
 
-	// Bob joins the lobby
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyResp.Code+"/join", nil, token2)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	// Lifecycle events appear in the order they happened
+	indexOf := func(eventType string) int {
+		for i, e := range events {
+			if e.Type == eventType {
+				return i
+			}
+		}
+		return -1
+	}
+	created, started, completed := indexOf("lobby_created"), indexOf("game_started"), indexOf("game_complete")
+	require.Equal(t, 0, created)
+	require.Greater(t, started, created)
+	require.Greater(t, completed, started)
+	assert.Equal(t, lobbyCode, events[completed].LobbyCode)
+	assert.NotEmpty(t, events[completed].GameID)
+
+	// Filtering past the last event leaves nothing to stream
+	since := events[len(events)-1].Timestamp.Add(time.Second).Format(time.RFC3339Nano)
+	rr = ts.request(http.MethodGet, streamPath+"?since="+url.QueryEscape(since), nil, token)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 
-	var joinResp response.Lobby
-	err = json.Unmarshal(rr.Body.Bytes(), &joinResp)
-	require.NoError(t, err)
-	assert.Len(t, joinResp.Members, 2)
+	rr = ts.request(http.MethodGet, streamPath+"?since=yesterday", nil, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/NOPE99/events/stream", nil, token)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 }
 
-func TestLobbyHostActions(t *testing.T) {
+func TestStreamLobbyEventsHidesPrivateEvents(t *testing.T) {
 	ts := newTestServer(t)
 
-	token1 := createGuestPlayer(t, ts, "Alice")
-	token2 := createGuestPlayer(t, ts, "Bob")
+	aliceToken := createGuestPlayer(t, ts, "Alice")
+	bobToken := createGuestPlayer(t, ts, "Bob")
+	eveToken := createGuestPlayer(t, ts, "Eve")
+	lobbyCode := createLobby(t, ts, aliceToken, 2)
+	streamPath := "/api/v1/lobbies/" + lobbyCode + "/events/stream"
 
-	// Create lobby
-	lobbyCode := createLobby(t, ts, token1, 5)
+	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, bobToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, aliceToken)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", map[string]string{"letter": "A"}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", map[string]int{"row": 0, "col": 0}, aliceToken)
+	require.Equal(t, http.StatusOK, rr.Code)
 
-	// Bob joins
-	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	// Non-members can't read the history
+	rr = ts.request(http.MethodGet, streamPath, nil, eveToken)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	placements := func(token string) int {
+		rr := ts.request(http.MethodGet, streamPath, nil, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		count := 0
+		for _, line := range strings.Split(strings.TrimSpace(rr.Body.String()), "\n") {
+			var event response.Event
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			if event.Type == "letter_placed" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Alice sees her own placement; Bob can't see Alice's board mid-game
+	assert.Equal(t, 1, placements(aliceToken))
+	assert.Equal(t, 0, placements(bobToken))
+}
+
+func TestDelayedScoreReveal(t *testing.T) {
+	ts := newTestServer(t)
+
+	token := createGuestPlayer(t, ts, "Alice")
+	otherToken := createGuestPlayer(t, ts, "Bob")
+	lobbyCode := createLobby(t, ts, token, 2)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
+
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 2, "delayed_reveal": true}, token)
 	require.Equal(t, http.StatusOK, rr.Code)
 
-	// Bob tries to update config (should fail - not host)
-	body := map[string]int{"grid_size": 7}
-	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", body, token2)
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	// Revealing before the game finishes is rejected
+	rr = ts.request(http.MethodPost, gamePath+"/reveal", nil, token)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	var placeResp response.PlaceResponse
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": "A"}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+			rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": row, "col": col}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &placeResp))
+	assert.True(t, placeResp.GameComplete)
+	assert.Empty(t, placeResp.Scores)
+	assert.Nil(t, placeResp.Winner)
+
+	// Before the reveal, boards are visible but scores are withheld
+	rr = ts.request(http.MethodGet, gamePath, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var hidden response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &hidden))
+	assert.Equal(t, "scoring", hidden.State)
+	assert.NotEmpty(t, hidden.AllBoards)
+	assert.Empty(t, hidden.Scores)
+	assert.Nil(t, hidden.Winner)
+
+	rr = ts.request(http.MethodPost, gamePath+"/reveal", nil, otherToken)
 	assert.Equal(t, http.StatusForbidden, rr.Code)
 
-	// Alice updates config (should succeed)
-	rr = ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", body, token1)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, gamePath+"/reveal", nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var revealed response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &revealed))
+	require.Len(t, revealed.Scores, 1)
+	assert.NotNil(t, revealed.Winner)
 
-	var configResp response.LobbyConfig
-	err := json.Unmarshal(rr.Body.Bytes(), &configResp)
-	require.NoError(t, err)
-	assert.Equal(t, 7, configResp.GridSize)
+	// The lobby records the true summary once scores are revealed
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	assert.Nil(t, lobbyResp.CurrentGame)
+	require.Len(t, lobbyResp.GameHistory, 1)
+	assert.Equal(t, revealed.Scores[0].TotalScore, lobbyResp.GameHistory[0].FinalScores[revealed.Scores[0].PlayerID])
 }
 
-func TestFullGameFlow(t *testing.T) {
+func TestRevealNextCompletesGameAfterLastScore(t *testing.T) {
 	ts := newTestServer(t)
 
-	// Create two players
-	token1 := createGuestPlayer(t, ts, "Alice")
-	token2 := createGuestPlayer(t, ts, "Bob")
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 2)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
 
-	// Create lobby and have Bob join
-	lobbyCode := createLobby(t, ts, token1, 3) // 3x3 = 9 turns
+	rr := ts.request(http.MethodPatch, "/api/v1/lobbies/"+lobbyCode+"/config", map[string]any{"grid_size": 2, "delayed_reveal": true}, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": "A"}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+			rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": row, "col": col}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
+	}
 
-	rr := ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/join", nil, token2)
+	rr = ts.request(http.MethodPost, gamePath+"/reveal-next", nil, token)
 	require.Equal(t, http.StatusOK, rr.Code)
+	var revealed response.RevealNextResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &revealed))
+	assert.Equal(t, 0, revealed.Remaining)
+	assert.Len(t, revealed.Board.Cells, 2)
 
-	// Start game
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
-	assert.Equal(t, http.StatusCreated, rr.Code)
+	// Revealing the only score completes the game in the lobby
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	assert.Nil(t, lobbyResp.CurrentGame)
+	require.Len(t, lobbyResp.GameHistory, 1)
+}
 
-	var gameResp response.GameState
-	err := json.Unmarshal(rr.Body.Bytes(), &gameResp)
-	require.NoError(t, err)
-	assert.Equal(t, "announcing", gameResp.State)
-	assert.Len(t, gameResp.Players, 2)
+func TestRematch(t *testing.T) {
+	ts := newTestServer(t)
 
-	// Get game state
-	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode+"/game", nil, token1)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 2)
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
 
-	// Play one turn - determine who is announcer
-	announcer := gameResp.CurrentAnnouncer
-	announcerToken := token1
-	otherToken := token2
-	if announcer != gameResp.Players[0] {
-		announcerToken = token2
-		otherToken = token1
+	// A rematch needs a finished game
+	rr := ts.request(http.MethodPost, gamePath+"/rematch", nil, token)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var first response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &first))
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": "A"}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+			rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": row, "col": col}, token)
+			require.Equal(t, http.StatusOK, rr.Code)
+		}
 	}
 
-	// Announce letter
-	announceBody := map[string]string{"letter": "A"}
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/announce", announceBody, announcerToken)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = ts.request(http.MethodPost, gamePath+"/rematch", nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var second response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &second))
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, "announcing", second.State)
+	assert.Equal(t, first.Players, second.Players)
 
-	var announceResp response.AnnounceResponse
-	err = json.Unmarshal(rr.Body.Bytes(), &announceResp)
-	require.NoError(t, err)
-	assert.Equal(t, "placing", announceResp.State)
-	assert.Equal(t, "A", announceResp.CurrentLetter)
+	// The finished game is recorded once and the rematch is the current game
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/"+lobbyCode, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var lobbyResp response.Lobby
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &lobbyResp))
+	require.NotNil(t, lobbyResp.CurrentGame)
+	assert.Equal(t, second.ID, *lobbyResp.CurrentGame)
+	assert.Len(t, lobbyResp.GameHistory, 1)
+}
 
-	// Both players place
-	placeBody := map[string]int{"row": 0, "col": 0}
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", placeBody, announcerToken)
-	assert.Equal(t, http.StatusOK, rr.Code)
+func TestListLobbyGames(t *testing.T) {
+	ts := newTestServer(t)
 
-	var placeResp response.PlaceResponse
-	err = json.Unmarshal(rr.Body.Bytes(), &placeResp)
-	require.NoError(t, err)
-	assert.True(t, placeResp.Placed)
-	assert.False(t, placeResp.TurnComplete) // Other player hasn't placed yet
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 2)
+	gamesPath := "/api/v1/lobbies/" + lobbyCode + "/games"
+	gamePath := "/api/v1/lobbies/" + lobbyCode + "/game"
 
-	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/game/place", placeBody, otherToken)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	// A new lobby has no games at all
+	rr := ts.request(http.MethodGet, gamesPath, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var games response.LobbyGames
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &games))
+	assert.Nil(t, games.Current)
+	assert.Empty(t, games.History)
 
-	err = json.Unmarshal(rr.Body.Bytes(), &placeResp)
-	require.NoError(t, err)
-	assert.True(t, placeResp.TurnComplete) // All players placed
+	// Play a 2x2 game to completion
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var first response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &first))
+	for i, letter := range []string{"C", "A", "T", "S"} {
+		rr = ts.request(http.MethodPost, gamePath+"/announce", map[string]string{"letter": letter}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = ts.request(http.MethodPost, gamePath+"/place", map[string]int{"row": i / 2, "col": i % 2}, token)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	rr = ts.request(http.MethodPost, gamePath, nil, token)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var second response.GameState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &second))
+
+	rr = ts.request(http.MethodGet, gamesPath, nil, token)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &games))
+	assert.Equal(t, lobbyCode, games.Code)
+	require.NotNil(t, games.Current)
+	assert.Equal(t, second.ID, games.Current.ID)
+	assert.Equal(t, "announcing", games.Current.State)
+	assert.Equal(t, 4, games.Current.TotalTurns)
+	require.Len(t, games.History, 1)
+	assert.Equal(t, first.ID, games.History[0].ID)
+
+	rr = ts.request(http.MethodGet, "/api/v1/lobbies/NOPE99/games", nil, token)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
 }
 
 func TestAbandonGame(t *testing.T) {
@@ -413,6 +1948,27 @@ func TestRemoveBotFromLobby(t *testing.T) {
 	assert.Len(t, lobbyResp.Members, 1) // Only host
 }
 
+func TestBotStrategies(t *testing.T) {
+	ts := newTestServer(t)
+
+	rr := ts.request(http.MethodGet, "/api/v1/bots/strategies", nil, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var strategiesResp response.BotStrategiesResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &strategiesResp))
+	assert.Contains(t, strategiesResp.Strategies, response.BotStrategy{ID: "random", Name: "Random"})
+
+	// Adding a bot with a strategy that isn't listed is rejected
+	token := createGuestPlayer(t, ts, "Alice")
+	lobbyCode := createLobby(t, ts, token, 3)
+	rr = ts.request(http.MethodPost, "/api/v1/lobbies/"+lobbyCode+"/bots", map[string]string{"strategy": "telepathic"}, token)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodeUnknownBotStrategy, errResp.Error.Code)
+}
+
 func TestGameWithBot(t *testing.T) {
 	ts := newTestServer(t)
 
@@ -517,3 +2073,14 @@ func createLobby(t *testing.T, ts *testServer, token string, gridSize int) strin
 
 	return resp.Code
 }
+
+func TestUnknownRouteReturnsJSONError(t *testing.T) {
+	ts := newTestServer(t)
+
+	rr := ts.request(http.MethodGet, "/api/v1/no-such-endpoint", nil, "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var errResp apierr.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierr.CodeRouteNotFound, errResp.Error.Code)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
 )
 
 // APIError represents an API error response
@@ -22,25 +23,59 @@ type ErrorResponse struct {
 
 // Common error codes
 const (
-	CodeInvalidRequest      = "INVALID_REQUEST"
-	CodeInvalidLetter       = "INVALID_LETTER"
-	CodeInvalidPosition     = "INVALID_POSITION"
-	CodeUnauthorized        = "UNAUTHORIZED"
-	CodeNotHost             = "NOT_HOST"
-	CodeNotYourTurn         = "NOT_YOUR_TURN"
-	CodeAlreadyPlaced       = "ALREADY_PLACED"
-	CodePlayerNotFound      = "PLAYER_NOT_FOUND"
-	CodeLobbyNotFound       = "LOBBY_NOT_FOUND"
-	CodeGameNotFound        = "GAME_NOT_FOUND"
-	CodeAlreadyInLobby      = "ALREADY_IN_LOBBY"
-	CodeNotInLobby          = "NOT_IN_LOBBY"
-	CodeGameInProgress      = "GAME_IN_PROGRESS"
-	CodeNoGameInProgress    = "NO_GAME_IN_PROGRESS"
-	CodeCellOccupied        = "CELL_OCCUPIED"
-	CodeInsufficientPlayers = "INSUFFICIENT_PLAYERS"
-	CodeUsernameExists      = "USERNAME_EXISTS"
-	CodeInvalidCredentials  = "INVALID_CREDENTIALS"
-	CodeInternalError       = "INTERNAL_ERROR"
+	CodeInvalidRequest       = "INVALID_REQUEST"
+	CodeInvalidLetter        = "INVALID_LETTER"
+	CodeLetterNotInRack      = "LETTER_NOT_IN_RACK"
+	CodeInvalidPosition      = "INVALID_POSITION"
+	CodePositionNotAllowed   = "POSITION_NOT_ALLOWED"
+	CodePlacementNotAdjacent = "PLACEMENT_NOT_ADJACENT"
+	CodeInvalidDisplayName   = "INVALID_DISPLAY_NAME"
+	CodeDisplayNameBlocked   = "DISPLAY_NAME_NOT_ALLOWED"
+	CodeNotRegistered        = "NOT_REGISTERED"
+	CodeInvalidGridSize      = "INVALID_GRID_SIZE"
+	CodeUnauthorized         = "UNAUTHORIZED"
+	CodeAdminRequired        = "ADMIN_REQUIRED"
+	CodeNotHost              = "NOT_HOST"
+	CodeHostPresent          = "HOST_PRESENT"
+	CodeNotYourTurn          = "NOT_YOUR_TURN"
+	CodeAlreadyPlaced        = "ALREADY_PLACED"
+	CodePlayerNotFound       = "PLAYER_NOT_FOUND"
+	CodeLobbyNotFound        = "LOBBY_NOT_FOUND"
+	CodeLobbyFull            = "LOBBY_FULL"
+	CodeGameNotFound         = "GAME_NOT_FOUND"
+	CodeGameExists           = "GAME_EXISTS"
+	CodeInvalidGameID        = "INVALID_GAME_ID"
+	CodeSpectateTokenInvalid = "SPECTATE_TOKEN_INVALID"
+	CodeBoardNotFound        = "BOARD_NOT_FOUND"
+	CodeDictionaryNotFound   = "DICTIONARY_NOT_FOUND"
+	CodeHintLimitReached     = "HINT_LIMIT_REACHED"
+	CodeNoLobbyEvents        = "NO_LOBBY_EVENTS"
+	CodeRouteNotFound        = "ROUTE_NOT_FOUND"
+	CodeAlreadyInLobby       = "ALREADY_IN_LOBBY"
+	CodeNotInLobby           = "NOT_IN_LOBBY"
+	CodeGameInProgress       = "GAME_IN_PROGRESS"
+	CodeNoGameInProgress     = "NO_GAME_IN_PROGRESS"
+	CodeCellOccupied         = "CELL_OCCUPIED"
+	CodeCellBlocked          = "CELL_BLOCKED"
+	CodeNoPendingPlacement   = "NO_PENDING_PLACEMENT"
+	CodeGameNotComplete      = "GAME_NOT_COMPLETE"
+	CodeGameOver             = "GAME_OVER"
+	CodeAllRevealed          = "ALL_REVEALED"
+	CodeInsufficientPlayers  = "INSUFFICIENT_PLAYERS"
+	CodeInsufficientHumans   = "INSUFFICIENT_HUMAN_PLAYERS"
+	CodePlayersNotReady      = "PLAYERS_NOT_READY"
+	CodeLobbyOnCooldown      = "LOBBY_ON_COOLDOWN"
+	CodeNotAllReady          = "NOT_ALL_READY"
+	CodeDuplicatePlayer      = "DUPLICATE_PLAYER"
+	CodeTooManyBots          = "TOO_MANY_BOTS"
+	CodeUnknownBotStrategy   = "UNKNOWN_BOT_STRATEGY"
+	CodeScoringUnavailable   = "SCORING_UNAVAILABLE"
+	CodeServerAtCapacity     = "SERVER_AT_CAPACITY"
+	CodeStorageUnavailable   = "STORAGE_UNAVAILABLE"
+	CodeUsernameExists       = "USERNAME_EXISTS"
+	CodeInvalidCredentials   = "INVALID_CREDENTIALS"
+	CodeTooManyAttempts      = "TOO_MANY_ATTEMPTS"
+	CodeInternalError        = "INTERNAL_ERROR"
 )
 
 // httpError combines an HTTP status code with an APIError
@@ -74,40 +109,112 @@ func toHTTPError(err error) *httpError {
 	switch {
 	case errors.Is(err, model.ErrPlayerNotFound):
 		return &httpError{http.StatusNotFound, APIError{CodePlayerNotFound, "Player not found"}}
+	case errors.Is(err, model.ErrInvalidDisplayName):
+		return &httpError{http.StatusBadRequest, APIError{CodeInvalidDisplayName, "Display name must be 1-20 characters"}}
+	case errors.Is(err, model.ErrDisplayNameNotAllowed):
+		return &httpError{http.StatusBadRequest, APIError{CodeDisplayNameBlocked, "Display name contains a word that is not allowed"}}
+	case errors.Is(err, model.ErrNotRegistered):
+		return &httpError{http.StatusForbidden, APIError{CodeNotRegistered, "Only registered players can do this"}}
+	case errors.Is(err, model.ErrInvalidGridSize):
+		return &httpError{http.StatusBadRequest, APIError{CodeInvalidGridSize, err.Error()}}
+	case errors.Is(err, model.ErrInvalidLobbyExport):
+		return &httpError{http.StatusBadRequest, APIError{CodeInvalidRequest, err.Error()}}
+	case errors.Is(err, model.ErrInvalidLobbyConfig):
+		return &httpError{http.StatusBadRequest, APIError{CodeInvalidRequest, err.Error()}}
 	case errors.Is(err, model.ErrLobbyNotFound):
 		return &httpError{http.StatusNotFound, APIError{CodeLobbyNotFound, "Lobby not found"}}
 	case errors.Is(err, model.ErrGameNotFound):
 		return &httpError{http.StatusNotFound, APIError{CodeGameNotFound, "Game not found"}}
+	case errors.Is(err, model.ErrGameExists):
+		return &httpError{http.StatusConflict, APIError{CodeGameExists, "A game with this ID already exists"}}
+	case errors.Is(err, model.ErrInvalidGameID):
+		return &httpError{http.StatusBadRequest, APIError{CodeInvalidGameID, "Game ID must be 1-64 letters, digits, '-' or '_'"}}
+	case errors.Is(err, model.ErrInvalidSpectateToken):
+		return &httpError{http.StatusNotFound, APIError{CodeSpectateTokenInvalid, "Spectate link is invalid or the game has ended"}}
+	case errors.Is(err, model.ErrBoardNotFound):
+		return &httpError{http.StatusNotFound, APIError{CodeBoardNotFound, "Board not found"}}
+	case errors.Is(err, model.ErrDictionaryNotFound):
+		return &httpError{http.StatusNotFound, APIError{CodeDictionaryNotFound, "No dictionary with that name is loaded"}}
+	case errors.Is(err, model.ErrHintLimitReached):
+		return &httpError{http.StatusTooManyRequests, APIError{CodeHintLimitReached, "No placement hints left this turn"}}
+	case errors.Is(err, model.ErrNoLobbyEvents):
+		return &httpError{http.StatusNotFound, APIError{CodeNoLobbyEvents, "No events recorded for this lobby"}}
 	case errors.Is(err, model.ErrAlreadyInLobby):
 		return &httpError{http.StatusConflict, APIError{CodeAlreadyInLobby, "Already in this lobby"}}
 	case errors.Is(err, model.ErrNotInLobby):
 		return &httpError{http.StatusNotFound, APIError{CodeNotInLobby, "Not in this lobby"}}
 	case errors.Is(err, model.ErrNotHost):
 		return &httpError{http.StatusForbidden, APIError{CodeNotHost, "Only the host can perform this action"}}
+	case errors.Is(err, model.ErrHostPresent):
+		return &httpError{http.StatusConflict, APIError{CodeHostPresent, "The host is still active"}}
 	case errors.Is(err, model.ErrGameInProgress):
 		return &httpError{http.StatusConflict, APIError{CodeGameInProgress, "Game is in progress"}}
 	case errors.Is(err, model.ErrNoGameInProgress):
 		return &httpError{http.StatusNotFound, APIError{CodeNoGameInProgress, "No game in progress"}}
+	case errors.Is(err, model.ErrServerAtCapacity):
+		return &httpError{http.StatusServiceUnavailable, APIError{CodeServerAtCapacity, "Server is at capacity, try again later"}}
+	case errors.Is(err, model.ErrInsufficientHumans):
+		return &httpError{http.StatusConflict, APIError{CodeInsufficientHumans, err.Error()}}
 	case errors.Is(err, model.ErrInsufficientPlayers):
 		return &httpError{http.StatusConflict, APIError{CodeInsufficientPlayers, "Not enough players to start"}}
+	case errors.Is(err, model.ErrPlayersNotReady):
+		return &httpError{http.StatusConflict, APIError{CodePlayersNotReady, "Not all players are ready"}}
+	case errors.Is(err, model.ErrLobbyOnCooldown):
+		return &httpError{http.StatusConflict, APIError{CodeLobbyOnCooldown, err.Error()}}
+	case errors.Is(err, model.ErrNotAllReady):
+		return &httpError{http.StatusConflict, APIError{CodeNotAllReady, "Not all players have acknowledged the game start"}}
+	case errors.Is(err, model.ErrLobbyFull):
+		return &httpError{http.StatusConflict, APIError{CodeLobbyFull, "Lobby has no free player seats"}}
+	case errors.Is(err, model.ErrTooManyBots):
+		return &httpError{http.StatusConflict, APIError{CodeTooManyBots, "Lobby already has the maximum number of bots"}}
+	case errors.Is(err, model.ErrUnknownBotStrategy):
+		return &httpError{http.StatusBadRequest, APIError{CodeUnknownBotStrategy, "Unknown bot strategy; see GET /api/v1/bots/strategies"}}
+	case errors.Is(err, model.ErrDuplicatePlayer):
+		return &httpError{http.StatusConflict, APIError{CodeDuplicatePlayer, "A player appears more than once"}}
 	case errors.Is(err, model.ErrNotPlayerTurn):
 		return &httpError{http.StatusForbidden, APIError{CodeNotYourTurn, "Not your turn"}}
 	case errors.Is(err, model.ErrInvalidLetter):
 		return &httpError{http.StatusBadRequest, APIError{CodeInvalidLetter, "Letter must be A-Z"}}
+	case errors.Is(err, model.ErrLetterNotInRack):
+		return &httpError{http.StatusBadRequest, APIError{CodeLetterNotInRack, "Letter must be one from your rack"}}
 	case errors.Is(err, model.ErrLetterNotAnnounced):
 		return &httpError{http.StatusConflict, APIError{CodeNoGameInProgress, "No letter has been announced"}}
 	case errors.Is(err, model.ErrAlreadyPlaced):
 		return &httpError{http.StatusForbidden, APIError{CodeAlreadyPlaced, "Already placed this turn"}}
 	case errors.Is(err, model.ErrInvalidPosition):
 		return &httpError{http.StatusBadRequest, APIError{CodeInvalidPosition, "Invalid board position"}}
+	case errors.Is(err, model.ErrPositionNotAllowed):
+		return &httpError{http.StatusBadRequest, APIError{CodePositionNotAllowed, "Letter must be placed in this turn's cell"}}
+	case errors.Is(err, model.ErrPlacementNotAdjacent):
+		return &httpError{http.StatusBadRequest, APIError{CodePlacementNotAdjacent, "Letter must be placed next to an existing letter"}}
+	case errors.Is(err, model.ErrCellBlocked):
+		return &httpError{http.StatusBadRequest, APIError{CodeCellBlocked, "Cell is blocked"}}
 	case errors.Is(err, model.ErrCellOccupied):
 		return &httpError{http.StatusConflict, APIError{CodeCellOccupied, "Cell is already occupied"}}
+	case errors.Is(err, model.ErrNoPendingPlacement):
+		return &httpError{http.StatusConflict, APIError{CodeNoPendingPlacement, "No pending placement"}}
+	case errors.Is(err, model.ErrGameComplete):
+		return &httpError{http.StatusConflict, APIError{CodeGameOver, "Game is already complete"}}
+	case errors.Is(err, model.ErrGameAbandoned):
+		return &httpError{http.StatusConflict, APIError{CodeGameOver, "Game has been abandoned"}}
+	case errors.Is(err, model.ErrAllRevealed):
+		return &httpError{http.StatusConflict, APIError{CodeAllRevealed, "All scores have been revealed"}}
+	case errors.Is(err, model.ErrGameNotComplete):
+		return &httpError{http.StatusConflict, APIError{CodeGameNotComplete, "Game is not complete"}}
+	case errors.Is(err, model.ErrDictionaryNotLoaded):
+		return &httpError{http.StatusServiceUnavailable, APIError{CodeScoringUnavailable, "Scoring is unavailable: no dictionary is loaded"}}
+	case errors.Is(err, storage.ErrTransient):
+		return &httpError{http.StatusServiceUnavailable, APIError{CodeStorageUnavailable, "Storage is temporarily unavailable, try again later"}}
 
 	// Map auth errors
 	case errors.Is(err, auth.ErrInvalidCredentials):
 		return &httpError{http.StatusUnauthorized, APIError{CodeInvalidCredentials, "Invalid username or password"}}
 	case errors.Is(err, auth.ErrInvalidSession):
 		return &httpError{http.StatusUnauthorized, APIError{CodeUnauthorized, "Invalid or expired session"}}
+	case errors.Is(err, auth.ErrInvalidRecovery):
+		return &httpError{http.StatusUnauthorized, APIError{CodeInvalidCredentials, "Invalid username or recovery code"}}
+	case errors.Is(err, auth.ErrTooManyAttempts):
+		return &httpError{http.StatusTooManyRequests, APIError{CodeTooManyAttempts, "Too many attempts, try again later"}}
 	case errors.Is(err, auth.ErrUsernameExists):
 		return &httpError{http.StatusConflict, APIError{CodeUsernameExists, "Username already exists"}}
 
@@ -126,6 +233,16 @@ func NewUnauthorizedError() error {
 	return &httpError{http.StatusUnauthorized, APIError{CodeUnauthorized, "Authentication required"}}
 }
 
+// NewAdminRequiredError creates an error for a missing or wrong admin token
+func NewAdminRequiredError() error {
+	return &httpError{http.StatusForbidden, APIError{CodeAdminRequired, "Admin token required"}}
+}
+
+// NewRouteNotFoundError creates an error for a path with no API endpoint
+func NewRouteNotFoundError() error {
+	return &httpError{http.StatusNotFound, APIError{CodeRouteNotFound, "No such API endpoint"}}
+}
+
 // NewInternalError creates an internal server error
 func NewInternalError() error {
 	return &httpError{http.StatusInternalServerError, APIError{CodeInternalError, "Internal server error"}}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
+	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
+)
+
+// storagePingTimeout bounds how long the health check waits on storage
+const storagePingTimeout = 2 * time.Second
+
+// AdminHandler handles operator endpoints for inspecting server state
+type AdminHandler struct {
+	hubManager        *sse.HubManager
+	storage           storage.Storage
+	dictionaryService *dictionary.Service
+	lobbyController   *lobby.Controller
+	gameHandler       *GameHandler
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(hubManager *sse.HubManager, store storage.Storage, dictionaryService *dictionary.Service, lobbyController *lobby.Controller, gameHandler *GameHandler) *AdminHandler {
+	return &AdminHandler{
+		hubManager:        hubManager,
+		storage:           store,
+		dictionaryService: dictionaryService,
+		lobbyController:   lobbyController,
+		gameHandler:       gameHandler,
+	}
+}
+
+// Hubs handles GET /api/v1/admin/hubs
+func (h *AdminHandler) Hubs(w http.ResponseWriter, r *http.Request) {
+	resp := response.HubsResponse{Hubs: []response.HubStatus{}}
+	if h.hubManager != nil {
+		for _, hub := range h.hubManager.Hubs() {
+			playerClients := make(map[string]int)
+			for playerID, count := range hub.PlayerClientCounts() {
+				playerClients[string(playerID)] = count
+			}
+			resp.Hubs = append(resp.Hubs, response.HubStatus{
+				LobbyCode:     string(hub.LobbyCode()),
+				Clients:       hub.ClientCount(),
+				PlayerClients: playerClients,
+			})
+		}
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// Health handles GET /api/v1/admin/health
+// Unlike the public health check, this pings storage. Problems are reported
+// in the body rather than the status code, so the details are always readable.
+func (h *AdminHandler) Health(w http.ResponseWriter, r *http.Request) {
+	resp := response.DetailedHealth{Status: "ok"}
+
+	if h.storage == nil {
+		resp.Storage.Error = "storage not configured"
+	} else {
+		ctx, cancel := context.WithTimeout(r.Context(), storagePingTimeout)
+		defer cancel()
+		if err := h.storage.Ping(ctx); err != nil {
+			resp.Storage.Error = err.Error()
+		} else {
+			resp.Storage.Reachable = true
+		}
+	}
+
+	if h.dictionaryService != nil && h.dictionaryService.IsLoaded() {
+		resp.Dictionary.Loaded = true
+		resp.Dictionary.Words = h.dictionaryService.WordCount()
+	}
+
+	if !resp.Storage.Reachable || !resp.Dictionary.Loaded {
+		resp.Status = "unhealthy"
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// ExportLobby handles GET /api/v1/admin/lobbies/{code}/export
+// Returns the lobby, its players and its current game as stored, for
+// moving the lobby to another instance with ImportLobby
+func (h *AdminHandler) ExportLobby(w http.ResponseWriter, r *http.Request) {
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	export, err := h.lobbyController.ExportLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, export)
+}
+
+// ImportLobby handles POST /api/v1/admin/lobbies/import
+// The lobby may come back under a new code if its own is taken here
+func (h *AdminHandler) ImportLobby(w http.ResponseWriter, r *http.Request) {
+	var export model.LobbyExport
+	if err := json.NewDecoder(r.Body).Decode(&export); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	imported, err := h.lobbyController.ImportLobby(r.Context(), &export)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// The exporting instance's watchers don't come with the game
+	if imported.CurrentGame != nil && h.gameHandler != nil {
+		if g, err := h.storage.GetGame(r.Context(), *imported.CurrentGame); err == nil {
+			h.gameHandler.resumeGame(r.Context(), imported.Code, g)
+		}
+	}
+
+	response.JSON(w, http.StatusCreated, response.LobbyFromModel(imported))
+}
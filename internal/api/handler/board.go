@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/board"
+	"github.com/mcoot/crosswordgame-go2/internal/services/boardimage"
+	"github.com/mcoot/crosswordgame-go2/internal/services/game"
+)
+
+// BoardHandler handles board-related endpoints
+type BoardHandler struct {
+	gameController    *game.Controller
+	boardService      *board.Service
+	boardImageService *boardimage.Service
+}
+
+// NewBoardHandler creates a new board handler
+func NewBoardHandler(gameController *game.Controller, boardService *board.Service, boardImageService *boardimage.Service) *BoardHandler {
+	return &BoardHandler{
+		gameController:    gameController,
+		boardService:      boardService,
+		boardImageService: boardImageService,
+	}
+}
+
+// Image handles GET /api/v1/games/{id}/boards/{player_id}.png
+// Boards are public once the game has been scored; before then only the
+// board's owner may view it.
+func (h *BoardHandler) Image(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := model.GameID(vars["id"])
+	playerID := model.PlayerID(vars["player_id"])
+
+	g, err := h.gameController.GetGame(r.Context(), gameID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	requester := middleware.GetPlayer(r.Context())
+	isOwner := requester != nil && requester.ID == playerID
+	isComplete := g.State == model.GameStateScoring
+
+	if !isComplete && !isOwner {
+		WriteError(w, model.ErrBoardNotFound)
+		return
+	}
+
+	boardObj, err := h.boardService.GetBoard(r.Context(), gameID, playerID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	var data []byte
+	if isComplete {
+		data, err = h.boardImageService.RenderFinalPNG(boardObj)
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+	} else {
+		data, err = h.boardImageService.RenderPNG(boardObj)
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// Bundle handles GET /api/v1/games/{id}/bundle
+// It returns a completed game as a single downloadable JSON document. Like
+// the word statistics, it is public once the game has been scored.
+func (h *BoardHandler) Bundle(w http.ResponseWriter, r *http.Request) {
+	gameID := model.GameID(mux.Vars(r)["id"])
+
+	bundle, err := h.gameController.GetGameBundle(r.Context(), gameID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	resp, err := response.GameBundleFromModel(bundle)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="game-%s.json"`, gameID))
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// Words handles GET /api/v1/games/{id}/words
+// Like finished boards, the word statistics are public once the game has
+// been scored.
+func (h *BoardHandler) Words(w http.ResponseWriter, r *http.Request) {
+	gameID := model.GameID(mux.Vars(r)["id"])
+
+	words, err := h.gameController.GetWordFrequencies(r.Context(), gameID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.GameWordsFromModel(gameID, words))
+}
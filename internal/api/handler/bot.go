@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+)
+
+// BotHandler handles bot endpoints that aren't tied to a lobby
+type BotHandler struct {
+	botService *bot.Service
+}
+
+// NewBotHandler creates a new bot handler
+func NewBotHandler(botService *bot.Service) *BotHandler {
+	return &BotHandler{botService: botService}
+}
+
+// Strategies handles GET /api/v1/bots/strategies
+func (h *BotHandler) Strategies(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, response.BotStrategiesFromModel(h.botService.Strategies()))
+}
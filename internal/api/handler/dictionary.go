@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+)
+
+// DictionaryHandler handles endpoints describing the word list
+type DictionaryHandler struct {
+	dictionaryService *dictionary.Service
+}
+
+// NewDictionaryHandler creates a new dictionary handler
+func NewDictionaryHandler(dictionaryService *dictionary.Service) *DictionaryHandler {
+	return &DictionaryHandler{dictionaryService: dictionaryService}
+}
+
+// Info handles GET /api/v1/dictionary/info
+// Only one dictionary is loaded at a time; ?name= checks it is the expected
+// one, answering DICTIONARY_NOT_FOUND otherwise.
+func (h *DictionaryHandler) Info(w http.ResponseWriter, r *http.Request) {
+	var info dictionary.Info
+	if h.dictionaryService != nil {
+		info = h.dictionaryService.Info()
+	}
+
+	if name := r.URL.Query().Get("name"); name != "" && (!info.Loaded || name != info.Name) {
+		WriteError(w, model.ErrDictionaryNotFound)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.DictionaryInfoFromService(info))
+}
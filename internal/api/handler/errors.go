@@ -38,6 +38,11 @@ func WriteError(w http.ResponseWriter, err error) {
 	apierr.WriteError(w, err)
 }
 
+// NotFound responds to requests for paths with no API endpoint
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	WriteError(w, apierr.NewRouteNotFoundError())
+}
+
 // NewInvalidRequestError creates an invalid request error
 func NewInvalidRequestError(message string) error {
 	return apierr.NewInvalidRequestError(message)
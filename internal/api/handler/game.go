@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/board"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 	"github.com/mcoot/crosswordgame-go2/internal/services/game"
 	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
 	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
@@ -22,12 +24,14 @@ import (
 
 // GameHandler handles game-related endpoints
 type GameHandler struct {
-	lobbyController *lobby.Controller
-	gameController  *game.Controller
-	boardService    *board.Service
-	botService      *bot.Service
-	hubManager      *sse.HubManager
-	broadcaster     *sse.Broadcaster
+	lobbyController   *lobby.Controller
+	gameController    *game.Controller
+	boardService      *board.Service
+	botService        *bot.Service
+	dictionaryService *dictionary.Service
+	hubManager        *sse.HubManager
+	broadcaster       *sse.Broadcaster
+	logger            *slog.Logger
 }
 
 // NewGameHandler creates a new game handler
@@ -36,6 +40,7 @@ func NewGameHandler(
 	gameController *game.Controller,
 	boardService *board.Service,
 	botService *bot.Service,
+	dictionaryService *dictionary.Service,
 	hubManager *sse.HubManager,
 	logger *slog.Logger,
 ) *GameHandler {
@@ -44,12 +49,14 @@ func NewGameHandler(
 		broadcaster = sse.NewBroadcaster(hubManager, logger)
 	}
 	return &GameHandler{
-		lobbyController: lobbyController,
-		gameController:  gameController,
-		boardService:    boardService,
-		botService:      botService,
-		hubManager:      hubManager,
-		broadcaster:     broadcaster,
+		lobbyController:   lobbyController,
+		gameController:    gameController,
+		boardService:      boardService,
+		botService:        botService,
+		dictionaryService: dictionaryService,
+		hubManager:        hubManager,
+		broadcaster:       broadcaster,
+		logger:            logger,
 	}
 }
 
@@ -63,24 +70,56 @@ func (h *GameHandler) Start(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
 	code := model.LobbyCode(mux.Vars(r)["code"])
 
-	g, err := h.lobbyController.StartGame(r.Context(), code, player.ID)
+	// The body is optional; without a game ID one is generated
+	var req request.StartGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	g, err := h.lobbyController.StartGameWithID(r.Context(), code, player.ID, model.GameID(req.GameID))
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
 
-	// Broadcast game started to SSE clients
-	if b := h.getBroadcaster(); b != nil {
-		b.BroadcastGameStarted(code)
+	h.gameStarted(r.Context(), code, g)
+
+	resp := response.GameStateFromModel(g, nil, nil, nil, "")
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// Rematch handles POST /api/v1/lobbies/{code}/game/rematch
+func (h *GameHandler) Rematch(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	g, err := h.lobbyController.Rematch(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
 	}
 
-	// Process bot actions after game start
-	h.processBotActions(r.Context(), g.ID, code)
+	h.gameStarted(r.Context(), code, g)
 
 	resp := response.GameStateFromModel(g, nil, nil, nil, "")
 	response.JSON(w, http.StatusCreated, resp)
 }
 
+// Preview handles GET /api/v1/lobbies/{code}/game/preview
+func (h *GameHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	playerIDs, err := h.lobbyController.PreviewGame(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.GamePreviewFromPlayers(playerIDs))
+}
+
 // Get handles GET /api/v1/lobbies/{code}/game
 func (h *GameHandler) Get(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
@@ -112,8 +151,13 @@ func (h *GameHandler) Get(w http.ResponseWriter, r *http.Request) {
 	var allBoards map[model.PlayerID]*model.Board
 	var scores []model.BoardScore
 	var winner model.PlayerID
+	var summary *model.GameSummary
+
+	// Lobbies can withhold boards from spectators until the game is scored,
+	// leaving them with just turn and placement status
+	spectatorSeesBoards := isSpectator && lob.Config.SpectatorsSeeBoards()
 
-	if isSpectator || isGameComplete {
+	if spectatorSeesBoards || isGameComplete {
 		// Show all boards
 		boards, err := h.boardService.GetBoardsForGame(r.Context(), g.ID)
 		if err != nil {
@@ -133,23 +177,75 @@ func (h *GameHandler) Get(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Include scores if game is complete
-	if isGameComplete {
+	// Include scores if game is complete, unless they're awaiting reveal
+	if isGameComplete && !g.ScoresHidden() {
 		scores, err = h.gameController.GetFinalScores(r.Context(), g.ID)
 		if err != nil {
 			WriteError(w, err)
 			return
 		}
-		summary, err := h.gameController.CreateGameSummary(r.Context(), g.ID)
+		summary, err = h.gameController.CreateGameSummary(r.Context(), g.ID)
 		if err == nil {
 			winner = summary.Winner
 		}
 	}
 
 	resp := response.GameStateFromModel(g, myBoard, allBoards, scores, winner)
+	if summary != nil {
+		resp.Result = summary.Result
+	}
+
+	// Letter hints are only shown to the player choosing the next letter
+	if h.dictionaryService != nil && g.State == model.GameStateAnnouncing && g.CurrentAnnouncer() == player.ID {
+		resp.LetterScores = response.LetterScoresFromMap(h.dictionaryService.LetterScores())
+	}
+
+	// Each player sees only their own rack
+	resp.Rack = response.RackFromModel(g.Racks[player.ID])
+
+	// The host can share a read-only link while the game is running, as can
+	// spectators when the lobby lets them watch boards
+	isHost := member != nil && member.IsHost
+	if !isGameComplete && g.State != model.GameStateAbandoned && (isHost || spectatorSeesBoards) {
+		resp.SpectateToken = g.SpectateToken
+	}
+
 	response.JSON(w, http.StatusOK, resp)
 }
 
+// Spectate handles GET /api/v1/spectate/{token}
+// It returns the game with every board to anyone holding the share link,
+// without requiring lobby membership. The token grants no other access.
+// Boards are left out when the lobby hides them from spectators.
+func (h *GameHandler) Spectate(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	g, err := h.gameController.GetGameBySpectateToken(r.Context(), token)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Withhold boards unless the lobby is known to let spectators see them
+	lob, err := h.lobbyController.GetLobby(r.Context(), g.LobbyCode)
+	if err != nil || !lob.Config.SpectatorsSeeBoards() {
+		response.JSON(w, http.StatusOK, response.GameStateFromModel(g, nil, nil, nil, ""))
+		return
+	}
+
+	boards, err := h.boardService.GetBoardsForGame(r.Context(), g.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	allBoards := make(map[model.PlayerID]*model.Board, len(boards))
+	for _, b := range boards {
+		allBoards[b.PlayerID] = b
+	}
+
+	response.JSON(w, http.StatusOK, response.GameStateFromModel(g, nil, allBoards, nil, ""))
+}
+
 // Announce handles POST /api/v1/lobbies/{code}/game/announce
 func (h *GameHandler) Announce(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
@@ -161,8 +257,9 @@ func (h *GameHandler) Announce(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(req.Letter) != 1 {
-		WriteError(w, NewInvalidRequestError("letter must be a single character"))
+	letter, err := model.ParseLetter(req.Letter)
+	if err != nil {
+		WriteError(w, err)
 		return
 	}
 
@@ -177,7 +274,6 @@ func (h *GameHandler) Announce(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	letter := rune(req.Letter[0])
 	if err := h.gameController.AnnounceLetter(r.Context(), *lob.CurrentGame, player.ID, letter); err != nil {
 		WriteError(w, err)
 		return
@@ -227,52 +323,127 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 	}
 
 	pos := model.Position{Row: req.Row, Col: req.Col}
-	if err := h.gameController.PlaceLetter(r.Context(), *lob.CurrentGame, player.ID, pos); err != nil {
+	result, err := h.gameController.PlaceLetterWithResult(r.Context(), *lob.CurrentGame, player.ID, pos)
+	if err != nil {
 		WriteError(w, err)
 		return
 	}
 
-	// Get updated game state
-	g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
+	h.respondToPlacement(w, r, code, player.ID, result)
+}
+
+// ConfirmPlacement handles POST /api/v1/lobbies/{code}/game/place/confirm
+func (h *GameHandler) ConfirmPlacement(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	result, err := h.gameController.ConfirmPlacementWithResult(r.Context(), *lob.CurrentGame, player.ID)
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
 
+	h.respondToPlacement(w, r, code, player.ID, result)
+}
+
+// CancelPlacement handles POST /api/v1/lobbies/{code}/game/place/cancel
+func (h *GameHandler) CancelPlacement(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	if err := h.gameController.CancelPlacement(r.Context(), *lob.CurrentGame, player.ID); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Broadcast updated pending indicator to SSE clients
+	if b := h.getBroadcaster(); b != nil {
+		if g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame); err == nil {
+			b.BroadcastPlacementUpdate(r.Context(), g, code, player.ID)
+		}
+	}
+
+	response.NoContent(w)
+}
+
+// respondToPlacement writes the place response after a placement is staged or committed,
+// broadcasting updates and completing the game if it has finished
+func (h *GameHandler) respondToPlacement(w http.ResponseWriter, r *http.Request, code model.LobbyCode, playerID model.PlayerID, result game.PlacementResult) {
+	g := result.Game
+
 	// Get player's board
-	boardObj, err := h.boardService.GetBoard(r.Context(), g.ID, player.ID)
+	boardObj, err := h.boardService.GetBoard(r.Context(), g.ID, playerID)
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
 
+	// Staged placement - nothing committed yet
+	if g.HasPendingPlacement(playerID) {
+		if b := h.getBroadcaster(); b != nil {
+			b.BroadcastPlacementUpdate(r.Context(), g, code, playerID)
+		}
+		pos := g.PendingPlacement[playerID]
+		response.JSON(w, http.StatusOK, response.PlaceResponse{
+			Board:      response.BoardFromModel(boardObj),
+			Pending:    true,
+			PendingRow: &pos.Row,
+			PendingCol: &pos.Col,
+		})
+		return
+	}
+
 	resp := response.PlaceResponse{
 		Placed:       true,
 		Board:        response.BoardFromModel(boardObj),
-		TurnComplete: g.State == model.GameStateAnnouncing || g.State == model.GameStateScoring,
+		TurnComplete: result.TurnAdvanced,
 		GameComplete: g.State == model.GameStateScoring,
 	}
 
 	// Broadcast placement update to SSE clients
 	if b := h.getBroadcaster(); b != nil {
-		b.BroadcastPlacementUpdate(r.Context(), g, code, player.ID)
+		b.BroadcastPlacementUpdate(r.Context(), g, code, playerID)
 
 		// Broadcast turn or game completion
-		switch g.State {
-		case model.GameStateAnnouncing:
-			b.BroadcastTurnComplete(r.Context(), g, code)
-		case model.GameStateScoring:
-			b.BroadcastGameComplete(code)
+		if result.TurnAdvanced {
+			if g.State == model.GameStateScoring {
+				b.BroadcastGameComplete(code)
+			} else {
+				b.BroadcastTurnComplete(r.Context(), g, code)
+			}
 		}
 	}
 
-	// If turn advanced, include next announcer
-	if g.State == model.GameStateAnnouncing {
+	// If turn advanced, include next announcer (simultaneous games have none)
+	if result.TurnAdvanced && g.State == model.GameStateAnnouncing {
 		resp.NextAnnouncer = string(g.CurrentAnnouncer())
 	}
 
 	// If game complete, include scores and handle lobby state update
-	if g.State == model.GameStateScoring {
+	// With delayed reveal the game stays current until the host reveals it
+	if g.State == model.GameStateScoring && !g.ScoresHidden() {
 		scores, err := h.gameController.GetFinalScores(r.Context(), g.ID)
 		if err == nil {
 			resp.Scores = make([]response.BoardScore, len(scores))
@@ -282,13 +453,21 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 		}
 
 		summary, err := h.gameController.CreateGameSummary(r.Context(), g.ID)
-		if err == nil && summary.Winner != "" {
-			w := string(summary.Winner)
-			resp.Winner = &w
+		if err == nil {
+			if summary.Winner != "" {
+				w := string(summary.Winner)
+				resp.Winner = &w
+			}
+			resp.Result = summary.Result
+			if b := h.getBroadcaster(); b != nil {
+				b.BroadcastGameSummary(code, summary)
+			}
 		}
 
 		// Complete the game in the lobby
-		_ = h.lobbyController.CompleteGame(r.Context(), code)
+		h.completeGame(r.Context(), code)
+	} else if g.ScoresHidden() {
+		h.scheduleAutoDismiss(code, g.ID)
 	}
 
 	// Process bot actions after placement (only if game still active)
@@ -299,6 +478,25 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusOK, resp)
 }
 
+// gameStarted announces a newly started game, starts its watchers and lets
+// any bots act. Games started by the host, by a rematch and by a join
+// filling the lobby all go through it.
+func (h *GameHandler) gameStarted(ctx context.Context, code model.LobbyCode, g *model.Game) {
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastGameStarted(code)
+	}
+	h.resumeGame(ctx, code, g)
+}
+
+// resumeGame starts the timeout watchers for a game and lets its bots act.
+// Used for new games and for games brought in by an admin import
+func (h *GameHandler) resumeGame(ctx context.Context, code model.LobbyCode, g *model.Game) {
+	h.watchAnnounceTimeout(code, g.ID)
+	h.watchGameDuration(g.ID)
+	h.watchStartAckTimeout(code, g)
+	h.processBotActions(ctx, g.ID, code)
+}
+
 // processBotActions runs bot actions and broadcasts SSE updates
 func (h *GameHandler) processBotActions(ctx context.Context, gameID model.GameID, code model.LobbyCode) {
 	if h.botService == nil {
@@ -339,12 +537,312 @@ func (h *GameHandler) broadcastBotActions(ctx context.Context, actions []bot.Bot
 			}
 		case bot.ActionGameComplete:
 			b.BroadcastGameComplete(code)
-			// Complete the game in the lobby
-			_ = h.lobbyController.CompleteGame(ctx, code)
+			// Complete the game in the lobby, unless its scores await reveal
+			g, err := h.gameController.GetGame(ctx, gameID)
+			if err == nil && !g.ScoresHidden() {
+				h.completeGame(ctx, code)
+			} else if err == nil {
+				h.scheduleAutoDismiss(code, gameID)
+			}
 		}
 	}
 }
 
+// scheduleAutoDismiss completes a game awaiting reveal if the host never
+// reveals it within the configured auto-dismiss delay
+func (h *GameHandler) scheduleAutoDismiss(code model.LobbyCode, gameID model.GameID) {
+	h.lobbyController.ScheduleAutoDismiss(code, gameID, func() {
+		if b := h.getBroadcaster(); b != nil {
+			b.BroadcastGameDismissed(code)
+		}
+	})
+}
+
+// watchAnnounceTimeout passes the turn on whenever an announcer stalls past
+// the configured announce timeout, letting a bot that is handed it act
+func (h *GameHandler) watchAnnounceTimeout(code model.LobbyCode, gameID model.GameID) {
+	h.lobbyController.WatchAnnounceTimeout(gameID, func() {
+		if b := h.getBroadcaster(); b != nil {
+			b.BroadcastRefresh(code)
+		}
+		h.processBotActions(context.Background(), gameID, code)
+	})
+}
+
+// watchGameDuration abandons the game if it runs past the configured
+// maximum game duration
+func (h *GameHandler) watchGameDuration(gameID model.GameID) {
+	h.lobbyController.WatchGameDuration(gameID, func(code model.LobbyCode) {
+		if b := h.getBroadcaster(); b != nil {
+			b.BroadcastGameTimedOut(code)
+		}
+	})
+}
+
+// watchStartAckTimeout lets the first announce go ahead once the wait for
+// start acknowledgements times out
+func (h *GameHandler) watchStartAckTimeout(code model.LobbyCode, g *model.Game) {
+	h.gameController.WatchStartAckTimeout(g, func() {
+		h.allReady(context.Background(), code, g.ID)
+	})
+}
+
+// allReady tells clients the first letter can be announced, and lets a bot
+// announcer make it
+func (h *GameHandler) allReady(ctx context.Context, code model.LobbyCode, gameID model.GameID) {
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastAllReady(code)
+	}
+	h.processBotActions(ctx, gameID, code)
+}
+
+// AckStart handles POST /api/v1/lobbies/{code}/game/ack-start
+// Acknowledges the game start for games that wait for every player before
+// the first announce
+func (h *GameHandler) AckStart(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	allReady, err := h.gameController.AckStart(r.Context(), *lob.CurrentGame, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if allReady {
+		h.allReady(r.Context(), code, *lob.CurrentGame)
+	}
+
+	g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, response.AckStartResponseFromModel(g))
+}
+
+// MyTurn handles GET /api/v1/lobbies/{code}/game/my-turn
+// Tells the requesting player what they can do in the current game right now
+func (h *GameHandler) MyTurn(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.MyTurnFromModel(h.gameController.TurnObligations(g, player.ID)))
+}
+
+// Standings handles GET /api/v1/lobbies/{code}/game/standings
+// Ranks the players by their boards' scores so far. Unless the lobby lets
+// spectators see boards, everyone but the player themself gets only ranks,
+// so standings don't leak opponents' words mid-game.
+func (h *GameHandler) Standings(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if lob.GetMember(player.ID) == nil {
+		WriteError(w, model.ErrNotInLobby)
+		return
+	}
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	standings, err := h.gameController.GetStandings(r.Context(), g.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	showAll := lob.Config.SpectatorsSeeBoards() || g.State == model.GameStateScoring
+	response.JSON(w, http.StatusOK, response.StandingsFromModel(standings, player.ID, showAll))
+}
+
+// Hint handles GET /api/v1/lobbies/{code}/game/hint
+// Suggests the best cells on the player's own board for the announced
+// letter, spending one of their hints for the turn
+func (h *GameHandler) Hint(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if lob.CurrentGame == nil {
+		WriteError(w, model.ErrNoGameInProgress)
+		return
+	}
+
+	g, err := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	hints, err := h.botService.PlacementHints(r.Context(), g.ID, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.PlacementHintsFromRanked(g.CurrentLetter, hints))
+}
+
+// Reveal handles POST /api/v1/lobbies/{code}/game/reveal
+// Reveals the scores of a finished DelayedReveal game (host only), then
+// completes it in the lobby
+func (h *GameHandler) Reveal(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	g, err := h.lobbyController.RevealScores(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	boards, err := h.boardService.GetBoardsForGame(r.Context(), g.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	allBoards := make(map[model.PlayerID]*model.Board, len(boards))
+	for _, b := range boards {
+		allBoards[b.PlayerID] = b
+	}
+
+	scores, err := h.gameController.GetFinalScores(r.Context(), g.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	summary, err := h.completeRevealedGame(r.Context(), code, g)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var winner model.PlayerID
+	if summary != nil {
+		winner = summary.Winner
+	}
+
+	resp := response.GameStateFromModel(g, nil, allBoards, scores, winner)
+	if summary != nil {
+		resp.Result = summary.Result
+	}
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// RevealNext handles POST /api/v1/lobbies/{code}/game/reveal-next
+// Reveals the lowest remaining score of a finished DelayedReveal game (host
+// only), broadcasting that player's board and score. Revealing the last one
+// completes the game in the lobby, as Reveal does.
+func (h *GameHandler) RevealNext(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	g, score, err := h.lobbyController.RevealNext(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	board, err := h.boardService.GetBoard(r.Context(), g.ID, score.PlayerID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	remaining := len(g.Players) - len(g.RevealedPlayers)
+	if g.ScoresRevealed {
+		remaining = 0
+	}
+
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastBoardRevealed(code, g.ID, board, *score, remaining)
+	}
+
+	if g.ScoresRevealed {
+		if _, err := h.completeRevealedGame(r.Context(), code, g); err != nil {
+			WriteError(w, err)
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.RevealNextResponseFromModel(board, *score, remaining))
+}
+
+// completeGame completes the lobby's finished game. Failures are logged
+// rather than returned, since the move that finished the game has succeeded.
+func (h *GameHandler) completeGame(ctx context.Context, code model.LobbyCode) {
+	if err := h.lobbyController.CompleteGame(ctx, code); err != nil {
+		h.logger.ErrorContext(ctx, "failed to complete game",
+			slog.String("lobby_code", string(code)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// completeRevealedGame completes a game whose scores have just been revealed
+// in its lobby and broadcasts the reveal. The summary is nil if it couldn't
+// be created.
+func (h *GameHandler) completeRevealedGame(ctx context.Context, code model.LobbyCode, g *model.Game) (*model.GameSummary, error) {
+	summary, err := h.gameController.CreateGameSummary(ctx, g.ID)
+	if err != nil {
+		summary = nil
+	}
+
+	if err := h.lobbyController.CompleteGame(ctx, code); err != nil {
+		return nil, err
+	}
+
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastScoresRevealed(code)
+		if summary != nil {
+			b.BroadcastGameSummary(code, summary)
+		}
+	}
+	return summary, nil
+}
+
 // Abandon handles DELETE /api/v1/lobbies/{code}/game
 func (h *GameHandler) Abandon(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
@@ -362,3 +860,43 @@ func (h *GameHandler) Abandon(w http.ResponseWriter, r *http.Request) {
 
 	response.NoContent(w)
 }
+
+// ForceAdvance handles POST /api/v1/admin/games/{id}/force-advance
+// An operator tool for a game wedged in placing: players who haven't placed
+// skip the turn, and the game moves on as if they had
+func (h *GameHandler) ForceAdvance(w http.ResponseWriter, r *http.Request) {
+	gameID := model.GameID(mux.Vars(r)["id"])
+
+	g, skipped, err := h.gameController.ForceAdvance(r.Context(), gameID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	code := g.LobbyCode
+
+	if b := h.getBroadcaster(); b != nil {
+		if g.State == model.GameStateScoring {
+			b.BroadcastGameComplete(code)
+		} else {
+			b.BroadcastTurnComplete(r.Context(), g, code)
+		}
+	}
+
+	// Finish the game the same way a last placement would
+	if g.State == model.GameStateScoring && !g.ScoresHidden() {
+		if summary, err := h.gameController.CreateGameSummary(r.Context(), g.ID); err == nil {
+			if b := h.getBroadcaster(); b != nil {
+				b.BroadcastGameSummary(code, summary)
+			}
+		}
+		h.completeGame(r.Context(), code)
+	} else if g.ScoresHidden() {
+		h.scheduleAutoDismiss(code, g.ID)
+	}
+
+	if g.State != model.GameStateScoring {
+		h.processBotActions(r.Context(), g.ID, code)
+	}
+
+	response.JSON(w, http.StatusOK, response.ForceAdvanceResponseFromModel(g, skipped))
+}
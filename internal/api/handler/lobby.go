@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -22,10 +25,13 @@ type LobbyHandler struct {
 	botService      *bot.Service
 	hubManager      *sse.HubManager
 	broadcaster     *sse.Broadcaster
+	// games runs the games lobby changes start or hand to bots, the same way
+	// as games started through the game endpoints
+	games *GameHandler
 }
 
 // NewLobbyHandler creates a new lobby handler
-func NewLobbyHandler(lobbyController *lobby.Controller, botService *bot.Service, hubManager *sse.HubManager, logger *slog.Logger) *LobbyHandler {
+func NewLobbyHandler(lobbyController *lobby.Controller, botService *bot.Service, games *GameHandler, hubManager *sse.HubManager, logger *slog.Logger) *LobbyHandler {
 	var broadcaster *sse.Broadcaster
 	if hubManager != nil {
 		broadcaster = sse.NewBroadcaster(hubManager, logger)
@@ -35,6 +41,7 @@ func NewLobbyHandler(lobbyController *lobby.Controller, botService *bot.Service,
 		botService:      botService,
 		hubManager:      hubManager,
 		broadcaster:     broadcaster,
+		games:           games,
 	}
 }
 
@@ -60,7 +67,7 @@ func (h *LobbyHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Update config if grid size provided
-	if req.GridSize > 0 {
+	if req.GridSize != 0 {
 		config := model.LobbyConfig{GridSize: req.GridSize}
 		if err := h.lobbyController.UpdateConfig(r.Context(), lobby.Code, player.ID, config); err != nil {
 			WriteError(w, err)
@@ -85,12 +92,62 @@ func (h *LobbyHandler) Get(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
 }
 
+// Games handles GET /api/v1/lobbies/{code}/games
+// Lists the current game, if any, alongside the lobby's completed games
+func (h *LobbyHandler) Games(w http.ResponseWriter, r *http.Request) {
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	current, history, err := h.lobbyController.ListGames(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyGamesFromModel(code, current, history))
+}
+
+// StreamEvents handles GET /api/v1/lobbies/{code}/events/stream
+// Writes the lobby's recorded events in order as newline-delimited JSON,
+// optionally only those at or after the RFC 3339 ?since= timestamp.
+// Only members may read it, and it leaves out other players' placements
+// and final scores until they are visible in the game itself.
+func (h *LobbyHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	var since time.Time
+	if raw := r.URL.Query().Get("since"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			WriteError(w, NewInvalidRequestError("since must be an RFC 3339 timestamp"))
+			return
+		}
+		since = parsed
+	}
+
+	events, err := h.lobbyController.GetVisibleEvents(r.Context(), code, player.ID, since)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	result := make([]response.Event, len(events))
+	for i, e := range events {
+		result[i] = response.EventFromModel(e)
+	}
+	response.NDJSON(w, http.StatusOK, result)
+}
+
 // Join handles POST /api/v1/lobbies/{code}/join
 func (h *LobbyHandler) Join(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
 	code := model.LobbyCode(mux.Vars(r)["code"])
 
-	if err := h.lobbyController.JoinLobby(r.Context(), code, *player); err != nil {
+	// Joining a lobby the player is already in succeeds without changing
+	// anything, so a double-submitted join isn't reported as a failure
+	err := h.lobbyController.JoinLobby(r.Context(), code, *player)
+	alreadyMember := errors.Is(err, model.ErrAlreadyInLobby)
+	if err != nil && !alreadyMember {
 		WriteError(w, err)
 		return
 	}
@@ -100,15 +157,36 @@ func (h *LobbyHandler) Join(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, err)
 		return
 	}
+	if alreadyMember {
+		response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
+		return
+	}
 
 	// Broadcast member list update to SSE clients
 	if b := h.getBroadcaster(); b != nil {
 		b.BroadcastMemberListUpdate(r.Context(), lobby)
 	}
+	if lobby.StartedByJoin(player.ID) {
+		h.onAutoStart(r.Context(), code, *lobby.CurrentGame)
+	}
 
 	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
 }
 
+// onAutoStart hands a game started by a join filling the lobby to the game
+// handler, which announces and watches it as if the host had started it
+func (h *LobbyHandler) onAutoStart(ctx context.Context, code model.LobbyCode, gameID model.GameID) {
+	g, err := h.games.gameController.GetGame(ctx, gameID)
+	if err != nil {
+		h.games.logger.ErrorContext(ctx, "failed to load auto-started game",
+			slog.String("lobby_code", string(code)),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	h.games.gameStarted(ctx, code, g)
+}
+
 // Leave handles POST /api/v1/lobbies/{code}/leave
 func (h *LobbyHandler) Leave(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
@@ -120,11 +198,14 @@ func (h *LobbyHandler) Leave(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Broadcast member list update to SSE clients
-	if b := h.getBroadcaster(); b != nil {
-		lobby, _ := h.lobbyController.GetLobby(r.Context(), code)
-		if lobby != nil {
-			b.BroadcastMemberListUpdate(r.Context(), lobby)
-		}
+	lobby, _ := h.lobbyController.GetLobby(r.Context(), code)
+	if b := h.getBroadcaster(); b != nil && lobby != nil {
+		b.BroadcastMemberListUpdate(r.Context(), lobby)
+	}
+
+	// The game may now be waiting on a bot, or on one playing for the leaver
+	if lobby != nil && lobby.CurrentGame != nil {
+		h.games.processBotActions(r.Context(), *lobby.CurrentGame, code)
 	}
 
 	response.NoContent(w)
@@ -141,7 +222,13 @@ func (h *LobbyHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	config := model.LobbyConfig{GridSize: req.GridSize}
+	lobby, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	config := applyConfigRequest(lobby.Config, req)
 	if err := h.lobbyController.UpdateConfig(r.Context(), code, player.ID, config); err != nil {
 		WriteError(w, err)
 		return
@@ -155,6 +242,109 @@ func (h *LobbyHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusOK, response.LobbyConfigFromModel(config))
 }
 
+// ValidateConfig handles POST /api/v1/lobbies/{code}/config/validate
+// Checks a config update as UpdateConfig would, without applying it
+func (h *LobbyHandler) ValidateConfig(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	var req request.UpdateConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	lobby, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	fieldErrors, err := h.lobbyController.ValidateConfig(r.Context(), code, player.ID, applyConfigRequest(lobby.Config, req))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.ConfigValidationFromModel(fieldErrors))
+}
+
+// applyConfigRequest returns config with the request's settings applied;
+// settings the request leaves out keep their current values
+func applyConfigRequest(config model.LobbyConfig, req request.UpdateConfigRequest) model.LobbyConfig {
+	config.GridSize = req.GridSize
+	if req.RequireConfirm != nil {
+		config.RequireConfirm = *req.RequireConfirm
+	}
+	if req.RequireEdgeAnchored != nil {
+		config.RequireEdgeAnchored = *req.RequireEdgeAnchored
+	}
+	if req.DelayedReveal != nil {
+		config.DelayedReveal = *req.DelayedReveal
+	}
+	if req.MaxPlayers != nil {
+		config.MaxPlayers = *req.MaxPlayers
+	}
+	if req.MinHumanPlayers != nil {
+		config.MinHumanPlayers = *req.MinHumanPlayers
+	}
+	if req.AutoStart != nil {
+		config.AutoStart = *req.AutoStart
+	}
+	if req.PlacementMode != nil {
+		config.PlacementMode = model.PlacementMode(*req.PlacementMode)
+	}
+	if req.SpectatorsSeeBoards != nil {
+		config.HideSpectatorBoards = !*req.SpectatorsSeeBoards
+	}
+	if req.RequireReady != nil {
+		config.RequireReady = *req.RequireReady
+	}
+	if req.Mode != nil {
+		config.Mode = model.GameMode(*req.Mode)
+	}
+	if req.ShowLetterHistory != nil {
+		config.HideLetterHistory = !*req.ShowLetterHistory
+	}
+	if req.Seed != nil {
+		config.Seed = *req.Seed
+	}
+	if req.LateJoinRole != nil {
+		config.LateJoinRole = model.LobbyMemberRole(*req.LateJoinRole)
+	}
+	return config
+}
+
+// SetReady handles POST /api/v1/lobbies/{code}/ready
+func (h *LobbyHandler) SetReady(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	var req request.SetReadyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	if err := h.lobbyController.SetReady(r.Context(), code, player.ID, req.Ready); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	lobby, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Broadcast member list update to SSE clients
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastMemberListUpdate(r.Context(), lobby)
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
+}
+
 // SetRole handles PATCH /api/v1/lobbies/{code}/members/{player_id}/role
 func (h *LobbyHandler) SetRole(w http.ResponseWriter, r *http.Request) {
 	requestingPlayer := middleware.MustGetPlayer(r.Context())
@@ -228,6 +418,65 @@ func (h *LobbyHandler) TransferHost(w http.ResponseWriter, r *http.Request) {
 	response.NoContent(w)
 }
 
+// ClaimHost handles POST /api/v1/lobbies/{code}/claim-host
+// Any member may call it once the host has been away long enough; host passes
+// to the longest-tenured member
+func (h *LobbyHandler) ClaimHost(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lobby, err := h.lobbyController.ClaimHost(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Broadcast refresh to SSE clients
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastRefresh(code)
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
+}
+
+// ShuffleSeats handles POST /api/v1/lobbies/{code}/shuffle-seats
+func (h *LobbyHandler) ShuffleSeats(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lobby, err := h.lobbyController.ShuffleSeats(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Broadcast refresh to SSE clients
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastRefresh(code)
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
+}
+
+// RegenerateCode handles POST /api/v1/lobbies/{code}/regenerate-code
+func (h *LobbyHandler) RegenerateCode(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lobby, err := h.lobbyController.RegenerateCode(r.Context(), code, player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Clients are still connected under the old code; point them at the new one
+	if b := h.getBroadcaster(); b != nil {
+		b.BroadcastCodeChanged(code, lobby.Code)
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyFromModel(lobby))
+}
+
 // AddBot handles POST /api/v1/lobbies/{code}/bots
 func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
@@ -238,12 +487,7 @@ func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 		req = request.AddBotRequest{}
 	}
 
-	strategy := req.Strategy
-	if strategy == "" {
-		strategy = model.BotStrategyRandom
-	}
-
-	botPlayer, err := h.botService.AddBotToLobby(r.Context(), code, player.ID, strategy)
+	botPlayer, err := h.botService.AddBotToLobby(r.Context(), code, player.ID, req.Strategy, model.BotDifficulty(req.Difficulty))
 	if err != nil {
 		WriteError(w, err)
 		return
@@ -259,8 +503,10 @@ func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 	if b := h.getBroadcaster(); b != nil {
 		b.BroadcastMemberListUpdate(r.Context(), lobby)
 	}
+	if lobby.StartedByJoin(botPlayer.ID) {
+		h.onAutoStart(r.Context(), code, *lobby.CurrentGame)
+	}
 
-	_ = botPlayer // included in lobby response
 	response.JSON(w, http.StatusCreated, response.LobbyFromModel(lobby))
 }
 
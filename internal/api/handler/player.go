@@ -2,23 +2,36 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
 
 	"github.com/mcoot/crosswordgame-go2/internal/api/middleware"
 	"github.com/mcoot/crosswordgame-go2/internal/api/request"
 	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 )
 
 // PlayerHandler handles player-related endpoints
 type PlayerHandler struct {
-	authService *auth.Service
+	authService     *auth.Service
+	lobbyController *lobby.Controller
+	broadcaster     *sse.Broadcaster
 }
 
 // NewPlayerHandler creates a new player handler
-func NewPlayerHandler(authService *auth.Service) *PlayerHandler {
+func NewPlayerHandler(authService *auth.Service, lobbyController *lobby.Controller, hubManager *sse.HubManager, logger *slog.Logger) *PlayerHandler {
+	var broadcaster *sse.Broadcaster
+	if hubManager != nil {
+		broadcaster = sse.NewBroadcaster(hubManager, logger)
+	}
 	return &PlayerHandler{
-		authService: authService,
+		authService:     authService,
+		lobbyController: lobbyController,
+		broadcaster:     broadcaster,
 	}
 }
 
@@ -65,13 +78,44 @@ func (h *PlayerHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	session, err := h.authService.RegisterPlayer(r.Context(), req.Username, req.Password, req.DisplayName)
+	session, recoveryCode, err := h.authService.RegisterPlayer(r.Context(), req.Username, req.Password, req.DisplayName)
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, response.AuthResponseFromSession(session))
+	resp := response.AuthResponseFromSession(session)
+	resp.RecoveryCode = recoveryCode
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// ResetPassword handles POST /api/v1/players/reset-password
+func (h *PlayerHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req request.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	if req.Username == "" {
+		WriteError(w, NewInvalidRequestError("username is required"))
+		return
+	}
+	if req.RecoveryCode == "" {
+		WriteError(w, NewInvalidRequestError("recovery_code is required"))
+		return
+	}
+	if req.NewPassword == "" {
+		WriteError(w, NewInvalidRequestError("new_password is required"))
+		return
+	}
+
+	if err := h.authService.ResetPasswordWithCode(r.Context(), req.Username, req.RecoveryCode, req.NewPassword); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.NoContent(w)
 }
 
 // Login handles POST /api/v1/players/login
@@ -103,5 +147,105 @@ func (h *PlayerHandler) Login(w http.ResponseWriter, r *http.Request) {
 // GetMe handles GET /api/v1/players/me
 func (h *PlayerHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	player := middleware.MustGetPlayer(r.Context())
-	response.JSON(w, http.StatusOK, response.PlayerFromModel(player))
+
+	lobbyCode, gameID, err := h.lobbyController.GetActiveGame(r.Context(), player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.PlayerMeFromModel(player, lobbyCode, gameID))
+}
+
+// UpdateMe handles PATCH /api/v1/players/me
+func (h *PlayerHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+
+	var req request.UpdatePlayerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	updated, err := h.authService.UpdateDisplayName(r.Context(), player.ID, req.DisplayName)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	lob, err := h.lobbyController.UpdateMemberDisplayName(r.Context(), player.ID, updated.DisplayName)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Broadcast member list update to SSE clients
+	if lob != nil && h.broadcaster != nil {
+		h.broadcaster.BroadcastMemberListUpdate(r.Context(), lob)
+	}
+
+	lobbyCode, gameID, err := h.lobbyController.GetActiveGame(r.Context(), player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.PlayerMeFromModel(updated, lobbyCode, gameID))
+}
+
+// GetMyStats handles GET /api/v1/players/me/stats
+func (h *PlayerHandler) GetMyStats(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+
+	stats, err := h.authService.GetPlayerStats(r.Context(), player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.PlayerStatsFromModel(stats))
+}
+
+// DeleteMe handles DELETE /api/v1/players/me
+func (h *PlayerHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	player := middleware.MustGetPlayer(r.Context())
+
+	var req request.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		// Allow empty body for guests
+		req = request.DeleteAccountRequest{}
+	}
+
+	if err := h.authService.CheckDeletePassword(r.Context(), player.ID, req.Password); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Leave the current lobby before deleting, so a failure here leaves the
+	// account intact for the request to be retried
+	code, err := h.lobbyController.GetActiveLobbyCode(r.Context(), player.ID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if code != "" {
+		// The lobby index may be stale, in which case there is nothing to leave
+		err := h.lobbyController.LeaveLobby(r.Context(), code, player.ID)
+		if err != nil && !errors.Is(err, model.ErrNotInLobby) && !errors.Is(err, model.ErrLobbyNotFound) {
+			WriteError(w, err)
+			return
+		}
+		if h.broadcaster != nil {
+			if lob, _ := h.lobbyController.GetLobby(r.Context(), code); lob != nil {
+				h.broadcaster.BroadcastMemberListUpdate(r.Context(), lob)
+			}
+		}
+	}
+
+	if err := h.authService.DeleteAccount(r.Context(), player.ID, req.Password); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response.NoContent(w)
 }
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+)
+
+// RulesHandler handles the lobby rules endpoint
+type RulesHandler struct {
+	lobbyController   *lobby.Controller
+	scoringService    *scoring.Service
+	dictionaryService *dictionary.Service
+}
+
+// NewRulesHandler creates a new rules handler
+// scoringService and dictionaryService are optional; without them the
+// default scoring config is reported and the dictionary size is omitted
+func NewRulesHandler(lobbyController *lobby.Controller, scoringService *scoring.Service, dictionaryService *dictionary.Service) *RulesHandler {
+	return &RulesHandler{
+		lobbyController:   lobbyController,
+		scoringService:    scoringService,
+		dictionaryService: dictionaryService,
+	}
+}
+
+// Get handles GET /api/v1/lobbies/{code}/rules
+func (h *RulesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	code := model.LobbyCode(mux.Vars(r)["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	cfg := scoring.DefaultConfig()
+	if h.scoringService != nil {
+		cfg = h.scoringService.Config()
+	}
+	rules := cfg.Rules(scoring.Options{RequireEdgeAnchored: lob.Config.RequireEdgeAnchored})
+
+	var dictionaryWords *int
+	if h.dictionaryService != nil && h.dictionaryService.IsLoaded() {
+		count := h.dictionaryService.WordCount()
+		dictionaryWords = &count
+	}
+
+	response.JSON(w, http.StatusOK, response.LobbyRulesFromModel(lob.Config, rules, dictionaryWords))
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/request"
+	"github.com/mcoot/crosswordgame-go2/internal/api/response"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/board"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+)
+
+// maxExplainGridSize bounds the boards the explain endpoint will score,
+// since it is unauthenticated
+const maxExplainGridSize = 16
+
+// ScoringHandler handles the stateless scoring endpoints
+type ScoringHandler struct {
+	scoringService *scoring.Service
+	boardService   *board.Service
+}
+
+// NewScoringHandler creates a new scoring handler
+func NewScoringHandler(scoringService *scoring.Service, boardService *board.Service) *ScoringHandler {
+	return &ScoringHandler{
+		scoringService: scoringService,
+		boardService:   boardService,
+	}
+}
+
+// Explain handles POST /api/v1/scoring/explain
+// It scores the posted board with the server's scoring rules and explains
+// each decision, without reading or changing any game.
+func (h *ScoringHandler) Explain(w http.ResponseWriter, r *http.Request) {
+	var req request.ExplainScoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, NewInvalidRequestError("invalid request body"))
+		return
+	}
+
+	b, err := h.boardFromCells(req.Cells)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	if err := h.scoringService.CheckDictionary(); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	explanation := h.scoringService.ExplainBoard(b, scoring.Options{RequireEdgeAnchored: req.RequireEdgeAnchored})
+	response.JSON(w, http.StatusOK, response.ScoreExplanationFromModel(explanation))
+}
+
+// boardFromCells builds a board from a square grid of letters, normalizing
+// each letter into the server's alphabet
+func (h *ScoringHandler) boardFromCells(cells [][]string) (*model.Board, error) {
+	size := len(cells)
+	if size == 0 || size > maxExplainGridSize {
+		return nil, NewInvalidRequestError("cells must be a non-empty square grid")
+	}
+
+	b := model.NewBoard("", "", size)
+	for row, line := range cells {
+		if len(line) != size {
+			return nil, NewInvalidRequestError("cells must be a non-empty square grid")
+		}
+		for col, cell := range line {
+			if cell == "" {
+				continue
+			}
+			letter, err := model.ParseLetter(cell)
+			if err != nil {
+				return nil, err
+			}
+			letter, err = h.boardService.NormalizeLetter(letter)
+			if err != nil {
+				return nil, err
+			}
+			b.Set(model.Position{Row: row, Col: col}, letter)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/mcoot/crosswordgame-go2/internal/api/apierr"
+)
+
+// Admin creates middleware that only admits requests bearing the admin token
+// in the Authorization header. Session tokens are not accepted.
+func Admin(adminToken string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				apierr.WriteError(w, apierr.NewUnauthorizedError())
+				return
+			}
+
+			token := strings.TrimPrefix(authHeader, "Bearer ")
+			if adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+				apierr.WriteError(w, apierr.NewAdminRequiredError())
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
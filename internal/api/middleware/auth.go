@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/mcoot/crosswordgame-go2/internal/api/apierr"
+	"github.com/mcoot/crosswordgame-go2/internal/middleware"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 )
@@ -37,6 +38,7 @@ func Auth(authService *auth.Service) func(http.Handler) http.Handler {
 			ctx := r.Context()
 			ctx = context.WithValue(ctx, sessionContextKey, session)
 			ctx = context.WithValue(ctx, playerContextKey, &session.Player)
+			middleware.AnnotatePlayer(ctx, session.PlayerID)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -53,6 +55,7 @@ func OptionalAuth(authService *auth.Service) func(http.Handler) http.Handler {
 					ctx := r.Context()
 					ctx = context.WithValue(ctx, sessionContextKey, session)
 					ctx = context.WithValue(ctx, playerContextKey, &session.Player)
+					middleware.AnnotatePlayer(ctx, session.PlayerID)
 					r = r.WithContext(ctx)
 				}
 			}
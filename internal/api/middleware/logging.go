@@ -3,11 +3,12 @@ package middleware
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/middleware"
 )
 
 // Logging creates logging middleware for the API
-func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
-	return middleware.Logging(logger)
+func Logging(logger *slog.Logger, slowThreshold time.Duration) func(http.Handler) http.Handler {
+	return middleware.Logging(logger, slowThreshold)
 }
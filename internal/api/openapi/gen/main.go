@@ -0,0 +1,41 @@
+// Command gen converts the OpenAPI YAML document into the JSON file embedded
+// by the openapi package.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	in := flag.String("in", "", "path to the OpenAPI YAML document")
+	out := flag.String("out", "", "path to write the JSON document")
+	flag.Parse()
+
+	if *in == "" || *out == "" {
+		log.Fatal("both -in and -out are required")
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("read %s: %v", *in, err)
+	}
+
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		log.Fatalf("parse %s: %v", *in, err)
+	}
+
+	encoded, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatalf("encode JSON: %v", err)
+	}
+
+	if err := os.WriteFile(*out, append(encoded, '\n'), 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
@@ -0,0 +1,14 @@
+// Package openapi embeds the machine-readable OpenAPI document for the JSON API.
+package openapi
+
+import (
+	_ "embed"
+)
+
+//go:generate go run ./gen -in ../../../docs/api/openapi.yaml -out openapi.json
+
+// Spec is the OpenAPI document served at /api/v1/openapi.json
+// It is generated from docs/api/openapi.yaml - run `task openapi:generate` after editing it
+//
+//go:embed openapi.json
+var Spec []byte
@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSpecIsValidJSON(t *testing.T) {
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(Spec, &doc))
+
+	assert.Contains(t, doc["openapi"], "3.")
+
+	paths, ok := doc["paths"].(map[string]any)
+	require.True(t, ok, "spec should have a paths object")
+	for _, path := range []string{
+		"/players/guest",
+		"/players/me",
+		"/lobbies/{code}",
+		"/lobbies/{code}/game",
+		"/lobbies/{code}/game/announce",
+		"/lobbies/{code}/game/place",
+	} {
+		assert.Contains(t, paths, path)
+	}
+}
+
+func TestSpecMatchesYAMLSource(t *testing.T) {
+	data, err := os.ReadFile("../../../docs/api/openapi.yaml")
+	require.NoError(t, err)
+
+	var source map[string]any
+	require.NoError(t, yaml.Unmarshal(data, &source))
+
+	// Round-trip through JSON so both documents use the same types
+	sourceJSON, err := json.Marshal(source)
+	require.NoError(t, err)
+	var expected, actual map[string]any
+	require.NoError(t, json.Unmarshal(sourceJSON, &expected))
+	require.NoError(t, json.Unmarshal(Spec, &actual))
+
+	assert.Equal(t, expected, actual, "openapi.json is stale - run `task openapi:generate`")
+}
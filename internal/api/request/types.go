@@ -18,14 +18,46 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
+// ResetPasswordRequest is the request body for resetting a password with a recovery code
+type ResetPasswordRequest struct {
+	Username     string `json:"username"`
+	RecoveryCode string `json:"recovery_code"`
+	NewPassword  string `json:"new_password"`
+}
+
+// UpdatePlayerRequest is the request body for updating the current player
+type UpdatePlayerRequest struct {
+	DisplayName string `json:"display_name"`
+}
+
+// DeleteAccountRequest is the request body for deleting the current player
+// Password is required for registered players and ignored for guests
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
 // CreateLobbyRequest is the request body for creating a lobby
 type CreateLobbyRequest struct {
 	GridSize int `json:"grid_size,omitempty"`
 }
 
 // UpdateConfigRequest is the request body for updating lobby config
+// Omitted optional fields keep their current value
 type UpdateConfigRequest struct {
-	GridSize int `json:"grid_size"`
+	GridSize            int     `json:"grid_size"`
+	RequireConfirm      *bool   `json:"require_confirm,omitempty"`
+	RequireEdgeAnchored *bool   `json:"require_edge_anchored,omitempty"`
+	DelayedReveal       *bool   `json:"delayed_reveal,omitempty"`
+	MaxPlayers          *int    `json:"max_players,omitempty"`
+	MinHumanPlayers     *int    `json:"min_human_players,omitempty"`
+	AutoStart           *bool   `json:"auto_start,omitempty"`
+	PlacementMode       *string `json:"placement_mode,omitempty"`
+	SpectatorsSeeBoards *bool   `json:"spectators_see_boards,omitempty"`
+	RequireReady        *bool   `json:"require_ready,omitempty"`
+	Mode                *string `json:"mode,omitempty"`
+	ShowLetterHistory   *bool   `json:"show_letter_history,omitempty"`
+	Seed                *int64  `json:"seed,omitempty"`
+	LateJoinRole        *string `json:"late_join_role,omitempty"`
 }
 
 // SetRoleRequest is the request body for setting a member's role
@@ -33,11 +65,29 @@ type SetRoleRequest struct {
 	Role string `json:"role"`
 }
 
+// SetReadyRequest is the request body for marking yourself ready
+type SetReadyRequest struct {
+	Ready bool `json:"ready"`
+}
+
 // TransferHostRequest is the request body for transferring host
 type TransferHostRequest struct {
 	NewHostID string `json:"new_host_id"`
 }
 
+// StartGameRequest is the optional request body for starting a game
+// Supplying a GameID makes retries safe: a repeat start returns GAME_EXISTS
+type StartGameRequest struct {
+	GameID string `json:"game_id,omitempty"`
+}
+
+// ExplainScoreRequest is the request body for explaining a board's score
+// Cells is a square grid of single letters, with "" for empty cells
+type ExplainScoreRequest struct {
+	Cells               [][]string `json:"cells"`
+	RequireEdgeAnchored bool       `json:"require_edge_anchored,omitempty"`
+}
+
 // AnnounceRequest is the request body for announcing a letter
 type AnnounceRequest struct {
 	Letter string `json:"letter"`
@@ -53,4 +103,5 @@ type PlaceRequest struct {
 type AddBotRequest struct {
 	DisplayName string `json:"display_name,omitempty"`
 	Strategy    string `json:"strategy,omitempty"`
+	Difficulty  string `json:"difficulty,omitempty"`
 }
@@ -5,6 +5,9 @@ import (
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
 )
 
 // Player represents a player in API responses
@@ -25,10 +28,59 @@ func PlayerFromModel(p *model.Player) Player {
 	}
 }
 
+// PlayerMe is the current player along with any lobby/game they can resume
+type PlayerMe struct {
+	Player
+	ActiveLobby *string `json:"active_lobby"`
+	ActiveGame  *string `json:"active_game"`
+}
+
+// PlayerMeFromModel creates a PlayerMe response
+// Empty lobby code or game ID are represented as null
+func PlayerMeFromModel(p *model.Player, lobbyCode model.LobbyCode, gameID model.GameID) PlayerMe {
+	resp := PlayerMe{Player: PlayerFromModel(p)}
+	if lobbyCode != "" {
+		l := string(lobbyCode)
+		resp.ActiveLobby = &l
+	}
+	if gameID != "" {
+		g := string(gameID)
+		resp.ActiveGame = &g
+	}
+	return resp
+}
+
+// PlayerStats is a registered player's totals across completed games
+type PlayerStats struct {
+	GamesPlayed   int     `json:"games_played"`
+	GamesWon      int     `json:"games_won"`
+	AverageScore  float64 `json:"average_score"`
+	BestWord      *string `json:"best_word"`
+	BestWordScore int     `json:"best_word_score"`
+}
+
+// PlayerStatsFromModel creates a PlayerStats response
+// An empty best word is represented as null
+func PlayerStatsFromModel(s *model.PlayerStats) PlayerStats {
+	resp := PlayerStats{
+		GamesPlayed:   s.GamesPlayed,
+		GamesWon:      s.GamesWon,
+		AverageScore:  s.AverageScore(),
+		BestWordScore: s.BestWordScore,
+	}
+	if s.BestWord != "" {
+		w := s.BestWord
+		resp.BestWord = &w
+	}
+	return resp
+}
+
 // AuthResponse is the response for authentication endpoints
 type AuthResponse struct {
 	Player       Player `json:"player"`
 	SessionToken string `json:"session_token"`
+	// RecoveryCode is only returned once, at registration
+	RecoveryCode string `json:"recovery_code,omitempty"`
 }
 
 // AuthResponseFromSession creates an AuthResponse from a session
@@ -41,44 +93,112 @@ func AuthResponseFromSession(s *auth.Session) AuthResponse {
 
 // LobbyConfig represents lobby configuration
 type LobbyConfig struct {
-	GridSize int `json:"grid_size"`
+	GridSize            int    `json:"grid_size"`
+	RequireConfirm      bool   `json:"require_confirm"`
+	RequireEdgeAnchored bool   `json:"require_edge_anchored"`
+	DelayedReveal       bool   `json:"delayed_reveal"`
+	MaxPlayers          int    `json:"max_players"`
+	AutoStart           bool   `json:"auto_start"`
+	PlacementMode       string `json:"placement_mode"`
+	SpectatorsSeeBoards bool   `json:"spectators_see_boards"`
+	RequireReady        bool   `json:"require_ready"`
+	Mode                string `json:"mode"`
+	MinHumanPlayers     int    `json:"min_human_players"`
+	ShowLetterHistory   bool   `json:"show_letter_history"`
+	Seed                int64  `json:"seed,omitempty"`
+	LateJoinRole        string `json:"late_join_role"`
 }
 
 // LobbyConfigFromModel converts model.LobbyConfig
 func LobbyConfigFromModel(c model.LobbyConfig) LobbyConfig {
 	return LobbyConfig{
-		GridSize: c.GridSize,
+		GridSize:            c.GridSize,
+		RequireConfirm:      c.RequireConfirm,
+		RequireEdgeAnchored: c.RequireEdgeAnchored,
+		DelayedReveal:       c.DelayedReveal,
+		MaxPlayers:          c.MaxPlayers,
+		AutoStart:           c.AutoStart,
+		PlacementMode:       string(placementModeOrDefault(c.PlacementMode)),
+		SpectatorsSeeBoards: c.SpectatorsSeeBoards(),
+		RequireReady:        c.RequireReady,
+		Mode:                string(gameModeOrDefault(c.Mode)),
+		MinHumanPlayers:     c.MinHumanPlayers,
+		ShowLetterHistory:   c.ShowsLetterHistory(),
+		Seed:                c.Seed,
+		LateJoinRole:        string(lateJoinRoleOrDefault(c.LateJoinRole)),
+	}
+}
+
+// lateJoinRoleOrDefault reports an unset late join role as player
+func lateJoinRoleOrDefault(role model.LobbyMemberRole) model.LobbyMemberRole {
+	if role == "" {
+		return model.RolePlayer
 	}
+	return role
+}
+
+// placementModeOrDefault reports an unset placement mode as free
+func placementModeOrDefault(mode model.PlacementMode) model.PlacementMode {
+	if mode == "" {
+		return model.PlacementModeFree
+	}
+	return mode
+}
+
+// gameModeOrDefault reports an unset game mode as announcer
+func gameModeOrDefault(mode model.GameMode) model.GameMode {
+	if mode == "" {
+		return model.GameModeAnnouncer
+	}
+	return mode
 }
 
 // LobbyMember represents a lobby member
 type LobbyMember struct {
-	PlayerID    string `json:"player_id"`
-	DisplayName string `json:"display_name"`
-	Role        string `json:"role"`
-	IsHost      bool   `json:"is_host"`
-	IsBot       bool   `json:"is_bot,omitempty"`
-	BotStrategy string `json:"bot_strategy,omitempty"`
+	PlayerID      string `json:"player_id"`
+	DisplayName   string `json:"display_name"`
+	Role          string `json:"role"`
+	IsHost        bool   `json:"is_host"`
+	IsBot         bool   `json:"is_bot,omitempty"`
+	BotStrategy   string `json:"bot_strategy,omitempty"`
+	BotDifficulty string `json:"bot_difficulty,omitempty"`
+	Ready         bool   `json:"ready"`
 }
 
 // LobbyMemberFromModel converts model.LobbyMember
 func LobbyMemberFromModel(m model.LobbyMember) LobbyMember {
 	return LobbyMember{
-		PlayerID:    string(m.Player.ID),
-		DisplayName: m.Player.DisplayName,
-		Role:        string(m.Role),
-		IsHost:      m.IsHost,
-		IsBot:       m.Player.IsBot,
-		BotStrategy: m.Player.BotStrategy,
+		PlayerID:      string(m.Player.ID),
+		DisplayName:   m.Player.DisplayName,
+		Role:          string(m.Role),
+		IsHost:        m.IsHost,
+		IsBot:         m.Player.IsBot,
+		BotStrategy:   m.Player.BotStrategy,
+		BotDifficulty: string(m.Player.BotDifficulty),
+		Ready:         m.IsReady(),
 	}
 }
 
 // GameSummary represents a completed game summary
 type GameSummary struct {
-	ID          string         `json:"id"`
-	FinalScores map[string]int `json:"final_scores"`
-	Winner      *string        `json:"winner"`
-	CompletedAt time.Time      `json:"completed_at"`
+	ID                string         `json:"id"`
+	FinalScores       map[string]int `json:"final_scores"`
+	Winner            *string        `json:"winner"`
+	Tied              bool           `json:"tied,omitempty"`
+	Unscored          bool           `json:"unscored,omitempty"`
+	Result            string         `json:"result"`
+	TotalTurnTimeMs   int64          `json:"total_turn_time_ms"`
+	AverageTurnTimeMs int64          `json:"average_turn_time_ms"`
+	StealBonuses      []StealBonus   `json:"steal_bonuses,omitempty"`
+	CompletedAt       time.Time      `json:"completed_at"`
+}
+
+// StealBonus is a bonus for being first in a turn to complete a row word
+type StealBonus struct {
+	PlayerID string    `json:"player_id"`
+	Turn     int       `json:"turn"`
+	Word     string    `json:"word"`
+	At       time.Time `json:"at"`
 }
 
 // GameSummaryFromModel converts model.GameSummary
@@ -92,11 +212,21 @@ func GameSummaryFromModel(g model.GameSummary) GameSummary {
 		w := string(g.Winner)
 		winner = &w
 	}
+	var steals []StealBonus
+	for _, s := range g.StealBonuses {
+		steals = append(steals, StealBonus{PlayerID: string(s.PlayerID), Turn: s.Turn, Word: s.Word, At: s.At})
+	}
 	return GameSummary{
-		ID:          string(g.ID),
-		FinalScores: scores,
-		Winner:      winner,
-		CompletedAt: g.CompletedAt,
+		ID:                string(g.ID),
+		FinalScores:       scores,
+		Winner:            winner,
+		Tied:              g.Tied,
+		Unscored:          g.Unscored,
+		Result:            g.Result,
+		TotalTurnTimeMs:   g.TotalTurnTime.Milliseconds(),
+		AverageTurnTimeMs: g.AverageTurnTime.Milliseconds(),
+		StealBonuses:      steals,
+		CompletedAt:       g.CompletedAt,
 	}
 }
 
@@ -138,13 +268,56 @@ func LobbyFromModel(l *model.Lobby) Lobby {
 	}
 }
 
+// LobbyGames lists a lobby's current game and the games it has completed
+type LobbyGames struct {
+	Code    string        `json:"code"`
+	Current *LobbyGame    `json:"current"` // Null when no game is in progress
+	History []GameSummary `json:"history"` // Oldest first
+}
+
+// LobbyGame briefly describes a lobby's current game
+type LobbyGame struct {
+	ID          string   `json:"id"`
+	State       string   `json:"state"`
+	Players     []string `json:"players"`
+	CurrentTurn int      `json:"current_turn"`
+	TotalTurns  int      `json:"total_turns"`
+}
+
+// LobbyGamesFromModel creates a LobbyGames from the current game (if any)
+// and the lobby's game history
+func LobbyGamesFromModel(code model.LobbyCode, current *model.Game, history []model.GameSummary) LobbyGames {
+	resp := LobbyGames{
+		Code:    string(code),
+		History: make([]GameSummary, len(history)),
+	}
+	for i, g := range history {
+		resp.History[i] = GameSummaryFromModel(g)
+	}
+
+	if current != nil {
+		players := make([]string, len(current.Players))
+		for i, id := range current.Players {
+			players[i] = string(id)
+		}
+		resp.Current = &LobbyGame{
+			ID:          string(current.ID),
+			State:       string(current.State),
+			Players:     players,
+			CurrentTurn: current.CurrentTurn,
+			TotalTurns:  current.TotalTurns(),
+		}
+	}
+	return resp
+}
+
 // Board represents a game board
 type Board struct {
 	Cells [][]string `json:"cells"`
 }
 
 // BoardFromModel converts model.Board to response Board
-// Empty cells are represented as empty strings
+// Empty cells are represented as empty strings and blocked cells as "#"
 func BoardFromModel(b *model.Board) Board {
 	cells := make([][]string, b.Size)
 	for row := 0; row < b.Size; row++ {
@@ -158,6 +331,54 @@ func BoardFromModel(b *model.Board) Board {
 	return Board{Cells: cells}
 }
 
+// GameBundle is a self-contained archive of a completed game. Boards use the
+// compact binary encoding, base64 encoded and keyed by player ID.
+type GameBundle struct {
+	ID        string            `json:"id"`
+	LobbyCode string            `json:"lobby_code"`
+	GridSize  int               `json:"grid_size"`
+	Players   []string          `json:"players"`
+	Summary   GameSummary       `json:"summary"`
+	Boards    map[string][]byte `json:"boards"`
+	Scores    []BoardScore      `json:"scores"`
+	Moves     []Event           `json:"moves,omitempty"`
+}
+
+// GameBundleFromModel converts model.GameBundle
+// Returns an error if a board can't be encoded
+func GameBundleFromModel(b *model.GameBundle) (GameBundle, error) {
+	players := make([]string, len(b.Game.Players))
+	for i, p := range b.Game.Players {
+		players[i] = string(p)
+	}
+	boards := make(map[string][]byte, len(b.Boards))
+	for _, board := range b.Boards {
+		data, err := board.MarshalBinary()
+		if err != nil {
+			return GameBundle{}, err
+		}
+		boards[string(board.PlayerID)] = data
+	}
+	scores := make([]BoardScore, len(b.Scores))
+	for i, s := range b.Scores {
+		scores[i] = BoardScoreFromModel(s)
+	}
+	var moves []Event
+	for _, e := range b.Moves {
+		moves = append(moves, EventFromModel(e))
+	}
+	return GameBundle{
+		ID:        string(b.Game.ID),
+		LobbyCode: string(b.Game.LobbyCode),
+		GridSize:  b.Game.GridSize,
+		Players:   players,
+		Summary:   GameSummaryFromModel(b.Summary),
+		Boards:    boards,
+		Scores:    scores,
+		Moves:     moves,
+	}, nil
+}
+
 // WordMatch represents a word found on a board
 type WordMatch struct {
 	Word       string `json:"word"`
@@ -165,6 +386,9 @@ type WordMatch struct {
 	Row        int    `json:"row"`
 	Col        int    `json:"col"`
 	Horizontal bool   `json:"horizontal"`
+	TilePoints int    `json:"tile_points,omitempty"`
+	Deduped    bool   `json:"deduped,omitempty"`
+	Best       bool   `json:"best,omitempty"`
 }
 
 // WordMatchFromModel converts model.WordMatch
@@ -175,14 +399,63 @@ func WordMatchFromModel(w model.WordMatch) WordMatch {
 		Row:        w.StartPos.Row,
 		Col:        w.StartPos.Col,
 		Horizontal: w.Horizontal,
+		TilePoints: w.TilePoints,
+		Deduped:    w.Deduped,
+		Best:       w.Best,
+	}
+}
+
+// WordFrequency reports how many players scored a word in a game
+type WordFrequency struct {
+	Count   int      `json:"count"`
+	Score   int      `json:"score"`
+	Players []string `json:"players"`
+}
+
+// GameWords is the word frequency map for a completed game, keyed by word
+type GameWords struct {
+	GameID string                   `json:"game_id"`
+	Words  map[string]WordFrequency `json:"words"`
+}
+
+// GameWordsFromModel converts the model word frequencies for a game
+func GameWordsFromModel(gameID model.GameID, words map[string]model.WordFrequency) GameWords {
+	result := make(map[string]WordFrequency, len(words))
+	for word, freq := range words {
+		players := make([]string, len(freq.Players))
+		for i, p := range freq.Players {
+			players[i] = string(p)
+		}
+		result[word] = WordFrequency{
+			Count:   freq.Count,
+			Score:   freq.Score,
+			Players: players,
+		}
 	}
+	return GameWords{GameID: string(gameID), Words: result}
 }
 
 // BoardScore represents a player's score
 type BoardScore struct {
-	PlayerID   string      `json:"player_id"`
-	TotalScore int         `json:"total_score"`
-	Words      []WordMatch `json:"words"`
+	PlayerID      string          `json:"player_id"`
+	TotalScore    int             `json:"total_score"`
+	Words         []WordMatch     `json:"words"`
+	Highlights    []CellHighlight `json:"highlights"` // Cells of the counted words, for colouring the grid
+	IsolatedCells int             `json:"isolated_cells,omitempty"`
+	Penalty       int             `json:"penalty,omitempty"`
+	SymmetryBonus int             `json:"symmetry_bonus,omitempty"`
+	PerfectBonus  int             `json:"perfect_bonus,omitempty"`
+	StealBonus    int             `json:"steal_bonus,omitempty"`
+	UniqueWords   int             `json:"unique_words,omitempty"`
+	UniqueBonus   int             `json:"unique_bonus,omitempty"`
+}
+
+// CellHighlight marks a board cell as part of a scored word
+type CellHighlight struct {
+	Row        int    `json:"row"`
+	Col        int    `json:"col"`
+	Word       string `json:"word"`
+	Horizontal bool   `json:"horizontal"`
 }
 
 // BoardScoreFromModel converts model.BoardScore
@@ -191,27 +464,168 @@ func BoardScoreFromModel(s model.BoardScore) BoardScore {
 	for i, w := range s.Words {
 		words[i] = WordMatchFromModel(w)
 	}
+	cellWords := s.CellWords()
+	highlights := make([]CellHighlight, len(cellWords))
+	for i, c := range cellWords {
+		highlights[i] = CellHighlight{Row: c.Pos.Row, Col: c.Pos.Col, Word: c.Word, Horizontal: c.Horizontal}
+	}
 	return BoardScore{
-		PlayerID:   string(s.PlayerID),
-		TotalScore: s.TotalScore,
-		Words:      words,
+		PlayerID:      string(s.PlayerID),
+		TotalScore:    s.TotalScore,
+		Words:         words,
+		Highlights:    highlights,
+		IsolatedCells: s.IsolatedCells,
+		Penalty:       s.Penalty,
+		SymmetryBonus: s.SymmetryBonus,
+		PerfectBonus:  s.PerfectBonus,
+		StealBonus:    s.StealBonus,
+		UniqueWords:   s.UniqueWords,
+		UniqueBonus:   s.UniqueBonus,
+	}
+}
+
+// Cell is a position on a board
+type Cell struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// WordCandidate is a word found on a board and what scoring decided about it
+type WordCandidate struct {
+	Word       string `json:"word"`
+	Score      int    `json:"score"`
+	Row        int    `json:"row"`
+	Col        int    `json:"col"`
+	Horizontal bool   `json:"horizontal"`
+	Cells      []Cell `json:"cells"`
+	Outcome    string `json:"outcome"`
+	SubsumedBy string `json:"subsumed_by,omitempty"`
+}
+
+// ScoringStep is one adjustment to a board's running total
+type ScoringStep struct {
+	Description string `json:"description"`
+	Points      int    `json:"points"`
+	Total       int    `json:"total"`
+}
+
+// ScoreExplanation is a board's score with a breakdown of how it was reached
+type ScoreExplanation struct {
+	Score      BoardScore      `json:"score"`
+	Candidates []WordCandidate `json:"candidates"`
+	Steps      []ScoringStep   `json:"steps"`
+}
+
+// ScoreExplanationFromModel converts model.ScoreExplanation
+func ScoreExplanationFromModel(e *model.ScoreExplanation) ScoreExplanation {
+	candidates := make([]WordCandidate, len(e.Candidates))
+	for i, c := range e.Candidates {
+		cells := make([]Cell, len(c.Cells))
+		for j, pos := range c.Cells {
+			cells[j] = Cell{Row: pos.Row, Col: pos.Col}
+		}
+		candidates[i] = WordCandidate{
+			Word:       c.Word,
+			Score:      c.Score,
+			Row:        c.StartPos.Row,
+			Col:        c.StartPos.Col,
+			Horizontal: c.Horizontal,
+			Cells:      cells,
+			Outcome:    string(c.Outcome),
+			SubsumedBy: c.SubsumedBy,
+		}
+	}
+	steps := make([]ScoringStep, len(e.Steps))
+	for i, step := range e.Steps {
+		steps[i] = ScoringStep{Description: step.Description, Points: step.Points, Total: step.Total}
+	}
+	return ScoreExplanation{
+		Score:      BoardScoreFromModel(e.Score),
+		Candidates: candidates,
+		Steps:      steps,
 	}
 }
 
 // GameState represents the current game state
 type GameState struct {
-	ID               string            `json:"id"`
-	State            string            `json:"state"`
-	GridSize         int               `json:"grid_size"`
-	Players          []string          `json:"players"`
-	CurrentTurn      int               `json:"current_turn"`
-	CurrentAnnouncer string            `json:"current_announcer,omitempty"`
-	CurrentLetter    *string           `json:"current_letter"`
-	Placements       map[string]bool   `json:"placements,omitempty"`
-	MyBoard          *Board            `json:"my_board,omitempty"`
-	AllBoards        map[string]*Board `json:"all_boards,omitempty"`
-	Scores           []BoardScore      `json:"scores,omitempty"`
-	Winner           *string           `json:"winner,omitempty"`
+	ID                string             `json:"id"`
+	State             string             `json:"state"`
+	GridSize          int                `json:"grid_size"`
+	Players           []string           `json:"players"`
+	CurrentTurn       int                `json:"current_turn"`
+	CurrentAnnouncer  string             `json:"current_announcer,omitempty"`
+	CurrentLetter     *string            `json:"current_letter"`
+	Placements        map[string]bool    `json:"placements,omitempty"`
+	Pending           map[string]bool    `json:"pending,omitempty"`
+	RequireConfirm    bool               `json:"require_confirm,omitempty"`
+	MyBoard           *Board             `json:"my_board,omitempty"`
+	AllBoards         map[string]*Board  `json:"all_boards,omitempty"`
+	Scores            []BoardScore       `json:"scores,omitempty"`
+	Winner            *string            `json:"winner,omitempty"`
+	Result            string             `json:"result,omitempty"`
+	LetterScores      map[string]float64 `json:"letter_scores,omitempty"`
+	SpectateToken     string             `json:"spectate_token,omitempty"`
+	PlacementMode     string             `json:"placement_mode"`
+	Mode              string             `json:"mode"`
+	AdjacentPlacement bool               `json:"adjacent_placement,omitempty"`
+	RequiredRow       *int               `json:"required_row,omitempty"`
+	RequiredCol       *int               `json:"required_col,omitempty"`
+	AnnounceDeadline  *time.Time         `json:"announce_deadline,omitempty"`
+	Rack              []string           `json:"rack,omitempty"`
+	Bag               map[string]int     `json:"bag,omitempty"` // Letters left to draw in simultaneous mode
+	BotControlled     map[string]string  `json:"bot_controlled,omitempty"`
+	AnnouncedLetters  []AnnouncedLetter  `json:"announced_letters,omitempty"`
+	RevealedPlayers   []string           `json:"revealed_players,omitempty"` // Scores revealed one at a time so far
+	Seed              int64              `json:"seed,omitempty"`             // Lobby seed for reproducible games
+}
+
+// AnnouncedLetter is a letter announced earlier in the game
+type AnnouncedLetter struct {
+	Turn   int    `json:"turn"`
+	Letter string `json:"letter"`
+}
+
+// GamePreview is the turn order a game started now would have
+type GamePreview struct {
+	Players        []string `json:"players"` // In announcing order
+	FirstAnnouncer string   `json:"first_announcer"`
+}
+
+// GamePreviewFromPlayers creates a GamePreview from the ordered player IDs
+func GamePreviewFromPlayers(playerIDs []model.PlayerID) GamePreview {
+	players := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		players[i] = string(id)
+	}
+	resp := GamePreview{Players: players}
+	if len(players) > 0 {
+		resp.FirstAnnouncer = players[0]
+	}
+	return resp
+}
+
+// LetterScoresFromMap converts a rune-keyed letter score map to string keys
+func LetterScoresFromMap(scores map[rune]float64) map[string]float64 {
+	if scores == nil {
+		return nil
+	}
+	result := make(map[string]float64, len(scores))
+	for letter, score := range scores {
+		result[string(letter)] = score
+	}
+	return result
+}
+
+// RackFromModel converts a player's rack letters to strings
+func RackFromModel(rack []rune) []string {
+	if len(rack) == 0 {
+		return nil
+	}
+	letters := make([]string, len(rack))
+	for i, letter := range rack {
+		letters[i] = string(letter)
+	}
+	return letters
 }
 
 // GameStateFromModel converts model.Game to response GameState
@@ -226,6 +640,22 @@ func GameStateFromModel(g *model.Game, myBoard *model.Board, allBoards map[model
 		placements[string(pid)] = placed
 	}
 
+	var botControlled map[string]string
+	if len(g.BotControlled) > 0 {
+		botControlled = make(map[string]string, len(g.BotControlled))
+		for pid, strategy := range g.BotControlled {
+			botControlled[string(pid)] = strategy
+		}
+	}
+
+	var pending map[string]bool
+	if len(g.PendingPlacement) > 0 {
+		pending = make(map[string]bool, len(g.PendingPlacement))
+		for pid := range g.PendingPlacement {
+			pending[string(pid)] = true
+		}
+	}
+
 	var currentLetter *string
 	if g.CurrentLetter != 0 {
 		l := string(g.CurrentLetter)
@@ -261,20 +691,191 @@ func GameStateFromModel(g *model.Game, myBoard *model.Board, allBoards map[model
 		winnerResp = &w
 	}
 
+	var requiredRow, requiredCol *int
+	if pos, ok := g.RequiredPosition(); ok {
+		requiredRow, requiredCol = &pos.Row, &pos.Col
+	}
+
+	var announced []AnnouncedLetter
+	if !g.HideLetterHistory {
+		for _, a := range g.AnnouncedLetters {
+			announced = append(announced, AnnouncedLetter{Turn: a.Turn, Letter: string(a.Letter)})
+		}
+	}
+
+	var revealed []string
+	for _, pid := range g.RevealedPlayers {
+		revealed = append(revealed, string(pid))
+	}
+
+	var bag map[string]int
+	if len(g.Bag) > 0 {
+		bag = make(map[string]int, len(g.Bag))
+		for letter, count := range g.Bag {
+			bag[string(letter)] = count
+		}
+	}
+
+	var announceDeadline *time.Time
+	if g.State == model.GameStateAnnouncing && !g.AnnounceDeadline.IsZero() {
+		announceDeadline = &g.AnnounceDeadline
+	}
+
 	return GameState{
-		ID:               string(g.ID),
-		State:            string(g.State),
-		GridSize:         g.GridSize,
-		Players:          players,
-		CurrentTurn:      g.CurrentTurn,
-		CurrentAnnouncer: string(g.CurrentAnnouncer()),
-		CurrentLetter:    currentLetter,
-		Placements:       placements,
-		MyBoard:          myBoardResp,
-		AllBoards:        allBoardsResp,
-		Scores:           scoresResp,
-		Winner:           winnerResp,
+		ID:                string(g.ID),
+		State:             string(g.State),
+		GridSize:          g.GridSize,
+		Players:           players,
+		CurrentTurn:       g.CurrentTurn,
+		CurrentAnnouncer:  string(g.CurrentAnnouncer()),
+		CurrentLetter:     currentLetter,
+		Placements:        placements,
+		Pending:           pending,
+		RequireConfirm:    g.RequireConfirm,
+		MyBoard:           myBoardResp,
+		AllBoards:         allBoardsResp,
+		Scores:            scoresResp,
+		Winner:            winnerResp,
+		PlacementMode:     string(placementModeOrDefault(g.PlacementMode)),
+		Mode:              string(gameModeOrDefault(g.Mode)),
+		AdjacentPlacement: g.AdjacentPlacement,
+		RequiredRow:       requiredRow,
+		RequiredCol:       requiredCol,
+		AnnounceDeadline:  announceDeadline,
+		Bag:               bag,
+		BotControlled:     botControlled,
+		AnnouncedLetters:  announced,
+		RevealedPlayers:   revealed,
+		Seed:              g.Seed,
+	}
+}
+
+// ConfigValidation is the result of checking a lobby config update
+type ConfigValidation struct {
+	Valid  bool               `json:"valid"`
+	Errors []ConfigFieldError `json:"errors"`
+}
+
+// ConfigFieldError is a problem with one lobby config setting
+type ConfigFieldError struct {
+	Field   string `json:"field"` // Name of the setting, as in the config request
+	Message string `json:"message"`
+}
+
+// ConfigValidationFromModel converts the field errors found in a config
+func ConfigValidationFromModel(errs []model.ConfigFieldError) ConfigValidation {
+	fieldErrors := make([]ConfigFieldError, len(errs))
+	for i, e := range errs {
+		fieldErrors[i] = ConfigFieldError{Field: e.Field, Message: e.Error()}
+	}
+	return ConfigValidation{Valid: len(errs) == 0, Errors: fieldErrors}
+}
+
+// MyTurn tells a player what they can do in the current game right now
+type MyTurn struct {
+	IsMyTurn      bool    `json:"is_my_turn"` // The player is the current announcer
+	CanAnnounce   bool    `json:"can_announce"`
+	CanPlace      bool    `json:"can_place"`
+	HasPlaced     bool    `json:"has_placed"`
+	CurrentLetter *string `json:"current_letter"`
+}
+
+// MyTurnFromModel converts model.TurnObligations
+func MyTurnFromModel(o model.TurnObligations) MyTurn {
+	var currentLetter *string
+	if o.CurrentLetter != 0 {
+		l := string(o.CurrentLetter)
+		currentLetter = &l
+	}
+	return MyTurn{
+		IsMyTurn:      o.IsMyTurn,
+		CanAnnounce:   o.CanAnnounce,
+		CanPlace:      o.CanPlace,
+		HasPlaced:     o.HasPlaced,
+		CurrentLetter: currentLetter,
+	}
+}
+
+// Standing is a player's place in a game so far
+type Standing struct {
+	PlayerID string      `json:"player_id"`
+	Rank     int         `json:"rank"`
+	Score    *BoardScore `json:"score"` // Null for opponents when boards are private
+}
+
+// StandingsResponse lists a game's players, leader first
+type StandingsResponse struct {
+	Standings []Standing `json:"standings"`
+}
+
+// StandingsFromModel converts model.Standing, leaving out the scores of
+// players other than viewer unless showAll is set
+func StandingsFromModel(standings []model.Standing, viewer model.PlayerID, showAll bool) StandingsResponse {
+	resp := StandingsResponse{Standings: make([]Standing, len(standings))}
+	for i, s := range standings {
+		resp.Standings[i] = Standing{PlayerID: string(s.Score.PlayerID), Rank: s.Rank}
+		if showAll || s.Score.PlayerID == viewer {
+			score := BoardScoreFromModel(s.Score)
+			resp.Standings[i].Score = &score
+		}
+	}
+	return resp
+}
+
+// PlacementHint is a suggested cell for the announced letter
+type PlacementHint struct {
+	Row   int `json:"row"`
+	Col   int `json:"col"`
+	Score int `json:"score"` // Board score after placing there
+}
+
+// PlacementHintsResponse lists suggested cells, best first
+type PlacementHintsResponse struct {
+	Letter string          `json:"letter"`
+	Hints  []PlacementHint `json:"hints"`
+}
+
+// PlacementHintsFromRanked converts ranked bot positions
+func PlacementHintsFromRanked(letter rune, ranked []bot.RankedPosition) PlacementHintsResponse {
+	resp := PlacementHintsResponse{Letter: string(letter), Hints: make([]PlacementHint, len(ranked))}
+	for i, r := range ranked {
+		resp.Hints[i] = PlacementHint{Row: r.Position.Row, Col: r.Position.Col, Score: r.Score}
+	}
+	return resp
+}
+
+// RevealNextResponse is the response after revealing the next score
+type RevealNextResponse struct {
+	PlayerID  string     `json:"player_id"`
+	Board     Board      `json:"board"`
+	Score     BoardScore `json:"score"`
+	Remaining int        `json:"remaining"` // Scores still to reveal
+}
+
+// RevealNextResponseFromModel creates a RevealNextResponse
+func RevealNextResponseFromModel(board *model.Board, score model.BoardScore, remaining int) RevealNextResponse {
+	return RevealNextResponse{
+		PlayerID:  string(score.PlayerID),
+		Board:     BoardFromModel(board),
+		Score:     BoardScoreFromModel(score),
+		Remaining: remaining,
+	}
+}
+
+// AckStartResponse is the response after acknowledging the game start
+type AckStartResponse struct {
+	AllReady bool     `json:"all_ready"`
+	Waiting  []string `json:"waiting"` // Players yet to acknowledge
+}
+
+// AckStartResponseFromModel reports who the game's first announce is
+// still waiting on
+func AckStartResponseFromModel(g *model.Game) AckStartResponse {
+	waiting := []string{}
+	for _, pid := range g.UnackedPlayers() {
+		waiting = append(waiting, string(pid))
 	}
+	return AckStartResponse{AllReady: len(waiting) == 0, Waiting: waiting}
 }
 
 // AnnounceResponse is the response after announcing a letter
@@ -286,10 +887,294 @@ type AnnounceResponse struct {
 // PlaceResponse is the response after placing a letter
 type PlaceResponse struct {
 	Placed        bool         `json:"placed"`
+	Pending       bool         `json:"pending,omitempty"`
+	PendingRow    *int         `json:"pending_row,omitempty"`
+	PendingCol    *int         `json:"pending_col,omitempty"`
 	Board         Board        `json:"board"`
 	TurnComplete  bool         `json:"turn_complete"`
 	GameComplete  bool         `json:"game_complete,omitempty"`
 	NextAnnouncer string       `json:"next_announcer,omitempty"`
 	Scores        []BoardScore `json:"scores,omitempty"`
 	Winner        *string      `json:"winner,omitempty"`
+	Result        string       `json:"result,omitempty"`
+}
+
+// Event represents a recorded lobby event in API responses
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	LobbyCode string    `json:"lobby_code"`
+	GameID    string    `json:"game_id,omitempty"`
+	PlayerID  string    `json:"player_id,omitempty"`
+	Payload   any       `json:"payload,omitempty"`
+}
+
+// EventFromModel converts a model.Event
+func EventFromModel(e *model.Event) Event {
+	return Event{
+		Type:      string(e.Type),
+		Timestamp: e.Timestamp,
+		LobbyCode: string(e.LobbyCode),
+		GameID:    string(e.GameID),
+		PlayerID:  string(e.PlayerID),
+		Payload:   eventPayload(e.Payload),
+	}
+}
+
+// PlayerJoinedPayload is the payload of lobby_created and player_joined events
+type PlayerJoinedPayload struct {
+	Player Player `json:"player"`
+	Role   string `json:"role"`
+}
+
+// PlayerLeftPayload is the payload of player_left events
+type PlayerLeftPayload struct {
+	PlayerID    string `json:"player_id"`
+	DisplayName string `json:"display_name"`
+}
+
+// HostChangedPayload is the payload of host_changed events
+type HostChangedPayload struct {
+	OldHostID string `json:"old_host_id"`
+	NewHostID string `json:"new_host_id"`
+}
+
+// RoleChangedPayload is the payload of role_changed events
+type RoleChangedPayload struct {
+	PlayerID string `json:"player_id"`
+	OldRole  string `json:"old_role"`
+	NewRole  string `json:"new_role"`
+}
+
+// CodeChangedPayload is the payload of code_changed events
+type CodeChangedPayload struct {
+	OldCode string `json:"old_code"`
+	NewCode string `json:"new_code"`
+}
+
+// GameStartedPayload is the payload of game_started events
+type GameStartedPayload struct {
+	GameID   string   `json:"game_id"`
+	Players  []string `json:"players"`
+	GridSize int      `json:"grid_size"`
+}
+
+// LetterAnnouncedPayload is the payload of letter_announced events
+type LetterAnnouncedPayload struct {
+	Letter      string `json:"letter"`
+	AnnouncerID string `json:"announcer_id"`
+	TurnNumber  int    `json:"turn_number"`
+}
+
+// LetterPlacedPayload is the payload of letter_placed events
+type LetterPlacedPayload struct {
+	PlayerID string `json:"player_id"`
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+	Letter   string `json:"letter"`
+}
+
+// TurnCompletePayload is the payload of turn_complete events
+type TurnCompletePayload struct {
+	TurnNumber      int    `json:"turn_number"`
+	NextAnnouncerID string `json:"next_announcer_id"`
+}
+
+// AnnouncerSkippedPayload is the payload of announcer_skipped events
+type AnnouncerSkippedPayload struct {
+	SkippedID       string `json:"skipped_id"`
+	NextAnnouncerID string `json:"next_announcer_id"`
+	TurnNumber      int    `json:"turn_number"`
+}
+
+// PlacementSkippedPayload is the payload of placement_skipped events
+type PlacementSkippedPayload struct {
+	SkippedIDs []string `json:"skipped_ids"`
+	TurnNumber int      `json:"turn_number"`
+}
+
+// GameCompletePayload is the payload of game_complete events
+type GameCompletePayload struct {
+	Scores []BoardScore `json:"scores"`
+	Winner string       `json:"winner"`
+}
+
+// GameAbandonedPayload is the payload of game_abandoned events
+type GameAbandonedPayload struct {
+	Reason string `json:"reason"`
+}
+
+// eventPayload converts the known model payloads to their API shape.
+// Anything else is passed through as is.
+func eventPayload(payload any) any {
+	switch p := payload.(type) {
+	case model.PlayerJoinedPayload:
+		return PlayerJoinedPayload{Player: PlayerFromModel(&p.Player), Role: string(p.Role)}
+	case model.PlayerLeftPayload:
+		return PlayerLeftPayload{PlayerID: string(p.PlayerID), DisplayName: p.DisplayName}
+	case model.HostChangedPayload:
+		return HostChangedPayload{OldHostID: string(p.OldHostID), NewHostID: string(p.NewHostID)}
+	case model.RoleChangedPayload:
+		return RoleChangedPayload{PlayerID: string(p.PlayerID), OldRole: string(p.OldRole), NewRole: string(p.NewRole)}
+	case model.CodeChangedPayload:
+		return CodeChangedPayload{OldCode: string(p.OldCode), NewCode: string(p.NewCode)}
+	case model.GameStartedPayload:
+		players := make([]string, len(p.Players))
+		for i, id := range p.Players {
+			players[i] = string(id)
+		}
+		return GameStartedPayload{GameID: string(p.GameID), Players: players, GridSize: p.GridSize}
+	case model.LetterAnnouncedPayload:
+		return LetterAnnouncedPayload{Letter: string(p.Letter), AnnouncerID: string(p.AnnouncerID), TurnNumber: p.TurnNumber}
+	case model.LetterPlacedPayload:
+		return LetterPlacedPayload{PlayerID: string(p.PlayerID), Row: p.Position.Row, Col: p.Position.Col, Letter: string(p.Letter)}
+	case model.TurnCompletePayload:
+		return TurnCompletePayload{TurnNumber: p.TurnNumber, NextAnnouncerID: string(p.NextAnnouncerID)}
+	case model.AnnouncerSkippedPayload:
+		return AnnouncerSkippedPayload{SkippedID: string(p.SkippedID), NextAnnouncerID: string(p.NextAnnouncerID), TurnNumber: p.TurnNumber}
+	case model.PlacementSkippedPayload:
+		skipped := make([]string, len(p.SkippedIDs))
+		for i, id := range p.SkippedIDs {
+			skipped[i] = string(id)
+		}
+		return PlacementSkippedPayload{SkippedIDs: skipped, TurnNumber: p.TurnNumber}
+	case model.GameCompletePayload:
+		scores := make([]BoardScore, len(p.Scores))
+		for i, s := range p.Scores {
+			scores[i] = BoardScoreFromModel(s)
+		}
+		return GameCompletePayload{Scores: scores, Winner: string(p.Winner)}
+	case model.GameAbandonedPayload:
+		return GameAbandonedPayload{Reason: p.Reason}
+	default:
+		return payload
+	}
+}
+
+// ForceAdvanceResponse is the response after an operator forces a turn on
+type ForceAdvanceResponse struct {
+	State       string   `json:"state"`
+	CurrentTurn int      `json:"current_turn"`
+	Skipped     []string `json:"skipped"` // Players whose placement was skipped
+}
+
+// ForceAdvanceResponseFromModel creates a ForceAdvanceResponse
+func ForceAdvanceResponseFromModel(g *model.Game, skipped []model.PlayerID) ForceAdvanceResponse {
+	resp := ForceAdvanceResponse{State: string(g.State), CurrentTurn: g.CurrentTurn, Skipped: []string{}}
+	for _, id := range skipped {
+		resp.Skipped = append(resp.Skipped, string(id))
+	}
+	return resp
+}
+
+// HubStatus describes the live SSE connections for one lobby
+type HubStatus struct {
+	LobbyCode     string         `json:"lobby_code"`
+	Clients       int            `json:"clients"`
+	PlayerClients map[string]int `json:"player_clients"`
+}
+
+// HubsResponse lists the active SSE hubs
+type HubsResponse struct {
+	Hubs []HubStatus `json:"hubs"`
+}
+
+// BotStrategy describes a strategy bots can be added with
+type BotStrategy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// BotStrategiesResponse lists the available bot strategies
+type BotStrategiesResponse struct {
+	Strategies []BotStrategy `json:"strategies"`
+}
+
+// BotStrategiesFromModel converts the registered bot strategies
+func BotStrategiesFromModel(infos []bot.StrategyInfo) BotStrategiesResponse {
+	resp := BotStrategiesResponse{Strategies: make([]BotStrategy, len(infos))}
+	for i, info := range infos {
+		resp.Strategies[i] = BotStrategy{ID: info.ID, Name: info.Name}
+	}
+	return resp
+}
+
+// DictionaryInfo describes the loaded word list
+type DictionaryInfo struct {
+	Name      string     `json:"name"`
+	WordCount int        `json:"word_count"`
+	Loaded    bool       `json:"loaded"`
+	LoadedAt  *time.Time `json:"loaded_at"`
+}
+
+// DictionaryInfoFromService converts dictionary.Info
+func DictionaryInfoFromService(info dictionary.Info) DictionaryInfo {
+	resp := DictionaryInfo{Name: info.Name, WordCount: info.WordCount, Loaded: info.Loaded}
+	if info.Loaded {
+		resp.LoadedAt = &info.LoadedAt
+	}
+	return resp
+}
+
+// DetailedHealth reports the status of each backend the server depends on
+type DetailedHealth struct {
+	Status     string           `json:"status"` // "ok", or "unhealthy" if any check failed
+	Storage    StorageHealth    `json:"storage"`
+	Dictionary DictionaryHealth `json:"dictionary"`
+}
+
+// StorageHealth reports whether the storage backend answered a ping
+type StorageHealth struct {
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error,omitempty"`
+}
+
+// DictionaryHealth reports whether the dictionary has finished loading
+type DictionaryHealth struct {
+	Loaded bool `json:"loaded"`
+	Words  int  `json:"words"`
+}
+
+// LobbyRules describes the rules a lobby's next game will be played with
+type LobbyRules struct {
+	GridSize            int    `json:"grid_size"`
+	MinWordLength       int    `json:"min_word_length"`
+	FullLineMultiplier  int    `json:"full_line_multiplier"`
+	Diagonals           bool   `json:"diagonals"`
+	RequireEdgeAnchored bool   `json:"require_edge_anchored"`
+	IsolatedCellPenalty int    `json:"isolated_cell_penalty"`
+	SymmetryBonus       int    `json:"symmetry_bonus"`
+	PerfectBoardBonus   int    `json:"perfect_board_bonus"`
+	UniqueWordBonus     int    `json:"unique_word_bonus"`
+	StealBonus          int    `json:"steal_bonus"`
+	DedupeWords         bool   `json:"dedupe_words"`
+	BestWordOnly        bool   `json:"best_word_only"`
+	TieBreak            string `json:"tie_break"`
+	WordValue           string `json:"word_value"`
+	RequireConfirm      bool   `json:"require_confirm"`
+	DelayedReveal       bool   `json:"delayed_reveal"`
+	DictionaryWords     *int   `json:"dictionary_words,omitempty"`
+}
+
+// LobbyRulesFromModel combines a lobby's config with the resolved scoring rules
+func LobbyRulesFromModel(cfg model.LobbyConfig, rules scoring.Rules, dictionaryWords *int) LobbyRules {
+	return LobbyRules{
+		GridSize:            cfg.GridSize,
+		MinWordLength:       rules.MinWordLength,
+		FullLineMultiplier:  rules.FullLineMultiplier,
+		Diagonals:           rules.Diagonals,
+		RequireEdgeAnchored: rules.RequireEdgeAnchored,
+		IsolatedCellPenalty: rules.IsolatedCellPenalty,
+		SymmetryBonus:       rules.SymmetryBonus,
+		PerfectBoardBonus:   rules.PerfectBoardBonus,
+		UniqueWordBonus:     rules.UniqueWordBonus,
+		StealBonus:          rules.StealBonus,
+		DedupeWords:         rules.DedupeWords,
+		BestWordOnly:        rules.BestWordOnly,
+		TieBreak:            rules.TieBreak,
+		WordValue:           rules.WordValue,
+		RequireConfirm:      cfg.RequireConfirm,
+		DelayedReveal:       cfg.DelayedReveal,
+		DictionaryWords:     dictionaryWords,
+	}
 }
@@ -18,3 +18,15 @@ func JSON(w http.ResponseWriter, status int, data any) {
 func NoContent(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// NDJSON writes a newline-delimited JSON response, one item per line
+func NDJSON[T any](w http.ResponseWriter, status int, items []T) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			return
+		}
+	}
+}
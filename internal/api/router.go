@@ -1,30 +1,49 @@
 package api
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/mcoot/crosswordgame-go2/internal/api/handler"
 	"github.com/mcoot/crosswordgame-go2/internal/api/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/api/openapi"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 	"github.com/mcoot/crosswordgame-go2/internal/services/board"
+	"github.com/mcoot/crosswordgame-go2/internal/services/boardimage"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 	"github.com/mcoot/crosswordgame-go2/internal/services/game"
 	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
 	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 )
 
 // RouterConfig holds configuration for the API router
 type RouterConfig struct {
-	Logger          *slog.Logger
-	AuthService     *auth.Service
-	LobbyController *lobby.Controller
-	GameController  *game.Controller
-	BoardService    *board.Service
-	BotService      *bot.Service
-	HubManager      *sse.HubManager // Optional: for SSE broadcast support
+	Logger            *slog.Logger
+	AuthService       *auth.Service
+	LobbyController   *lobby.Controller
+	GameController    *game.Controller
+	BoardService      *board.Service
+	BoardImageService *boardimage.Service
+	BotService        *bot.Service
+	DictionaryService *dictionary.Service // Optional: for announcer letter hints and health status
+	ScoringService    *scoring.Service    // Optional: for the lobby rules and scoring explain endpoints
+	HubManager        *sse.HubManager     // Optional: for SSE broadcast support
+	Storage           storage.Storage     // Optional: for the admin health check
+
+	// SlowRequestThreshold is the duration above which requests are logged at WARN
+	// Optional: defaults to 500ms
+	SlowRequestThreshold time.Duration
+
+	// AdminToken authorizes the /admin endpoints
+	// Optional: if empty, the admin endpoints are not registered
+	AdminToken string
 }
 
 // NewRouter creates a new API router with all routes configured
@@ -32,18 +51,22 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	r := mux.NewRouter()
 
 	// Create handlers
-	playerHandler := handler.NewPlayerHandler(cfg.AuthService)
-	lobbyHandler := handler.NewLobbyHandler(cfg.LobbyController, cfg.BotService, cfg.HubManager, cfg.Logger)
-	gameHandler := handler.NewGameHandler(cfg.LobbyController, cfg.GameController, cfg.BoardService, cfg.BotService, cfg.HubManager, cfg.Logger)
+	playerHandler := handler.NewPlayerHandler(cfg.AuthService, cfg.LobbyController, cfg.HubManager, cfg.Logger)
+	gameHandler := handler.NewGameHandler(cfg.LobbyController, cfg.GameController, cfg.BoardService, cfg.BotService, cfg.DictionaryService, cfg.HubManager, cfg.Logger)
+	lobbyHandler := handler.NewLobbyHandler(cfg.LobbyController, cfg.BotService, gameHandler, cfg.HubManager, cfg.Logger)
+	rulesHandler := handler.NewRulesHandler(cfg.LobbyController, cfg.ScoringService, cfg.DictionaryService)
+	boardHandler := handler.NewBoardHandler(cfg.GameController, cfg.BoardService, cfg.BoardImageService)
 
 	// Create middleware
 	authMiddleware := middleware.Auth(cfg.AuthService)
 	optionalAuthMiddleware := middleware.OptionalAuth(cfg.AuthService)
-	loggingMiddleware := middleware.Logging(cfg.Logger)
+	loggingMiddleware := middleware.Logging(cfg.Logger, cfg.SlowRequestThreshold)
 	recoveryMiddleware := middleware.Recovery(cfg.Logger)
+	requestIDMiddleware := middleware.RequestID()
 
 	// API subrouter with common middleware
 	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(requestIDMiddleware)
 	api.Use(recoveryMiddleware)
 	api.Use(loggingMiddleware)
 
@@ -51,22 +74,34 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	api.HandleFunc("/players/guest", playerHandler.CreateGuest).Methods(http.MethodPost)
 	api.HandleFunc("/players/register", playerHandler.Register).Methods(http.MethodPost)
 	api.HandleFunc("/players/login", playerHandler.Login).Methods(http.MethodPost)
+	api.HandleFunc("/players/reset-password", playerHandler.ResetPassword).Methods(http.MethodPost)
 
 	// Protected player routes
 	playerProtected := api.PathPrefix("/players").Subrouter()
 	playerProtected.Use(authMiddleware)
 	playerProtected.HandleFunc("/me", playerHandler.GetMe).Methods(http.MethodGet)
+	playerProtected.HandleFunc("/me", playerHandler.UpdateMe).Methods(http.MethodPatch)
+	playerProtected.HandleFunc("/me", playerHandler.DeleteMe).Methods(http.MethodDelete)
+	playerProtected.HandleFunc("/me/stats", playerHandler.GetMyStats).Methods(http.MethodGet)
 
 	// Lobby routes (all require auth)
 	lobbies := api.PathPrefix("/lobbies").Subrouter()
 	lobbies.Use(authMiddleware)
 	lobbies.HandleFunc("", lobbyHandler.Create).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}", lobbyHandler.Get).Methods(http.MethodGet)
+	lobbies.HandleFunc("/{code}/games", lobbyHandler.Games).Methods(http.MethodGet)
 	lobbies.HandleFunc("/{code}/join", lobbyHandler.Join).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/leave", lobbyHandler.Leave).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/config", lobbyHandler.UpdateConfig).Methods(http.MethodPatch)
+	lobbies.HandleFunc("/{code}/config/validate", lobbyHandler.ValidateConfig).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/rules", rulesHandler.Get).Methods(http.MethodGet)
 	lobbies.HandleFunc("/{code}/members/{player_id}/role", lobbyHandler.SetRole).Methods(http.MethodPatch)
+	lobbies.HandleFunc("/{code}/ready", lobbyHandler.SetReady).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/transfer-host", lobbyHandler.TransferHost).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/claim-host", lobbyHandler.ClaimHost).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/shuffle-seats", lobbyHandler.ShuffleSeats).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/regenerate-code", lobbyHandler.RegenerateCode).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/events/stream", lobbyHandler.StreamEvents).Methods(http.MethodGet)
 
 	// Bot routes (all require auth)
 	lobbies.HandleFunc("/{code}/bots", lobbyHandler.AddBot).Methods(http.MethodPost)
@@ -76,20 +111,81 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	lobbies.HandleFunc("/{code}/game", gameHandler.Start).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/game", gameHandler.Get).Methods(http.MethodGet)
 	lobbies.HandleFunc("/{code}/game", gameHandler.Abandon).Methods(http.MethodDelete)
+	lobbies.HandleFunc("/{code}/game/rematch", gameHandler.Rematch).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/game/preview", gameHandler.Preview).Methods(http.MethodGet)
+	lobbies.HandleFunc("/{code}/game/ack-start", gameHandler.AckStart).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/game/announce", gameHandler.Announce).Methods(http.MethodPost)
 	lobbies.HandleFunc("/{code}/game/place", gameHandler.Place).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/game/place/confirm", gameHandler.ConfirmPlacement).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/game/place/cancel", gameHandler.CancelPlacement).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/game/my-turn", gameHandler.MyTurn).Methods(http.MethodGet)
+	lobbies.HandleFunc("/{code}/game/hint", gameHandler.Hint).Methods(http.MethodGet)
+	lobbies.HandleFunc("/{code}/game/standings", gameHandler.Standings).Methods(http.MethodGet)
+	lobbies.HandleFunc("/{code}/game/reveal", gameHandler.Reveal).Methods(http.MethodPost)
+	lobbies.HandleFunc("/{code}/game/reveal-next", gameHandler.RevealNext).Methods(http.MethodPost)
+
+	// Game board routes (optional auth - finished boards are public for sharing)
+	games := api.PathPrefix("/games").Subrouter()
+	games.Use(optionalAuthMiddleware)
+	games.HandleFunc("/{id}/boards/{player_id}.png", boardHandler.Image).Methods(http.MethodGet)
+	games.HandleFunc("/{id}/words", boardHandler.Words).Methods(http.MethodGet)
+	games.HandleFunc("/{id}/bundle", boardHandler.Bundle).Methods(http.MethodGet)
+
+	// Scoring explanation (no auth - stateless, touches no game)
+	if cfg.ScoringService != nil {
+		scoringHandler := handler.NewScoringHandler(cfg.ScoringService, cfg.BoardService)
+		api.HandleFunc("/scoring/explain", scoringHandler.Explain).Methods(http.MethodPost)
+	}
+
+	// Bot strategies (no auth - lets clients build a strategy picker)
+	botHandler := handler.NewBotHandler(cfg.BotService)
+	api.HandleFunc("/bots/strategies", botHandler.Strategies).Methods(http.MethodGet)
+
+	// Dictionary metadata (no auth - like the health check, for confirming
+	// which word list is loaded)
+	dictionaryHandler := handler.NewDictionaryHandler(cfg.DictionaryService)
+	api.HandleFunc("/dictionary/info", dictionaryHandler.Info).Methods(http.MethodGet)
+
+	// Spectate route (no auth - the share token grants read-only access)
+	api.HandleFunc("/spectate/{token}", gameHandler.Spectate).Methods(http.MethodGet)
+
+	// Admin routes (admin token required)
+	if cfg.AdminToken != "" {
+		adminHandler := handler.NewAdminHandler(cfg.HubManager, cfg.Storage, cfg.DictionaryService, cfg.LobbyController, gameHandler)
+		admin := api.PathPrefix("/admin").Subrouter()
+		admin.Use(middleware.Admin(cfg.AdminToken))
+		admin.HandleFunc("/hubs", adminHandler.Hubs).Methods(http.MethodGet)
+		admin.HandleFunc("/health", adminHandler.Health).Methods(http.MethodGet)
+		admin.HandleFunc("/lobbies/{code}/export", adminHandler.ExportLobby).Methods(http.MethodGet)
+		admin.HandleFunc("/lobbies/import", adminHandler.ImportLobby).Methods(http.MethodPost)
+		admin.HandleFunc("/games/{id}/force-advance", gameHandler.ForceAdvance).Methods(http.MethodPost)
+	}
 
 	// Health check endpoint (no auth)
-	api.HandleFunc("/health", healthHandler).Methods(http.MethodGet)
+	api.HandleFunc("/health", healthHandler(cfg.DictionaryService)).Methods(http.MethodGet)
 
-	// Allow optional auth for lobby viewing (spectators without accounts)
-	_ = optionalAuthMiddleware // Reserved for future use
+	// API specification (no auth)
+	api.HandleFunc("/openapi.json", openAPIHandler).Methods(http.MethodGet)
+
+	// Unmatched paths get a JSON error rather than mux's plain text 404
+	r.NotFoundHandler = requestIDMiddleware(loggingMiddleware(http.HandlerFunc(handler.NotFound)))
 
 	return r
 }
 
-func healthHandler(w http.ResponseWriter, r *http.Request) {
+// healthHandler reports the server is up, and whether the dictionary has
+// finished loading (games can't be scored until it has)
+func healthHandler(dictionaryService *dictionary.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		loaded := dictionaryService != nil && dictionaryService.IsLoaded()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"status":"ok","dictionary_loaded":%t}`, loaded)
+	}
+}
+
+func openAPIHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(`{"status":"ok"}`))
+	_, _ = w.Write(openapi.Spec)
 }
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func newAdminCmd() *cobra.Command {
+	var adminToken string
+
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Server administration commands (require the server's admin token)",
+	}
+
+	cmd.PersistentFlags().StringVar(&adminToken, "admin-token", os.Getenv("CWGAME_ADMIN_TOKEN"), "Server admin token (env: CWGAME_ADMIN_TOKEN)")
+
+	cmd.AddCommand(newAdminHealthcheckCmd(&adminToken))
+
+	return cmd
+}
+
+func newAdminHealthcheckCmd(adminToken *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "healthcheck",
+		Short: "Check storage and dictionary health, exiting non-zero if unhealthy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if *adminToken == "" {
+				return errors.New("an admin token is required (--admin-token or CWGAME_ADMIN_TOKEN)")
+			}
+
+			var result DetailedHealthResult
+
+			adminClient := NewClient(cfg.ServerURL, *adminToken)
+			if err := adminClient.Get("/api/v1/admin/health", &result); err != nil {
+				return err
+			}
+
+			out := NewOutput(cfg.Output)
+			out.Print(result)
+
+			if result.Status != "ok" {
+				return errors.New("server is unhealthy")
+			}
+			return nil
+		},
+	}
+}
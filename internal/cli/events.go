@@ -4,10 +4,14 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
 	"strings"
 	"syscall"
 	"time"
@@ -54,11 +58,61 @@ type SSEEvent struct {
 }
 
 func streamEvents(lobbyCode string, jsonOutput bool) error {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	connected := false
+	err := readEventStream(ctx, lobbyCode, func(event, data string) bool {
+		if !connected && !jsonOutput {
+			fmt.Printf("Connected to lobby %s\n", lobbyCode)
+		}
+		connected = true
+		printEvent(event, data, jsonOutput)
+		return true
+	})
+	if err != nil && !errors.Is(err, io.EOF) && ctx.Err() == nil {
+		return err
+	}
+
+	if !jsonOutput {
+		if ctx.Err() != nil {
+			fmt.Println()
+		}
+		fmt.Println("Disconnected")
+	}
+	return nil
+}
+
+// errStreamRejected is returned when the server refuses the SSE connection,
+// which reconnecting won't fix
+var errStreamRejected = errors.New("event stream rejected")
+
+// interruptContext returns a context cancelled on SIGINT or SIGTERM
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(sigCh)
+	}()
+
+	return ctx, cancel
+}
+
+// readEventStream connects to a lobby's SSE endpoint and calls onEvent for
+// each event until the stream ends or onEvent returns false.
+// Returns nil if onEvent stopped the stream.
+func readEventStream(ctx context.Context, lobbyCode string, onEvent func(event, data string) bool) error {
 	// Build SSE URL - note: SSE is on the web router, not the API router
 	url := strings.TrimSuffix(cfg.ServerURL, "/") + "/lobby/" + lobbyCode + "/events"
 
-	// Create request
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -75,21 +129,6 @@ func streamEvents(lobbyCode string, jsonOutput bool) error {
 		})
 	}
 
-	// Set up cancellation
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Handle interrupt
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-	go func() {
-		<-sigCh
-		cancel()
-	}()
-
-	req = req.WithContext(ctx)
-
-	// Make request
 	httpClient := &http.Client{
 		Timeout: 0, // No timeout for SSE
 	}
@@ -101,15 +140,16 @@ func streamEvents(lobbyCode string, jsonOutput bool) error {
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status: %d", resp.StatusCode)
+		return fmt.Errorf("%w: unexpected status %d", errStreamRejected, resp.StatusCode)
 	}
-
-	if !jsonOutput {
-		fmt.Printf("Connected to lobby %s\n", lobbyCode)
+	// Unauthenticated requests are redirected to the login page
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		return fmt.Errorf("%w: not signed in or not a member of lobby %s", errStreamRejected, lobbyCode)
 	}
 
 	// Parse SSE stream
 	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
 	var currentEvent string
 	var dataLines []string
 
@@ -123,8 +163,9 @@ func streamEvents(lobbyCode string, jsonOutput bool) error {
 		} else if line == "" {
 			// End of event
 			if currentEvent != "" {
-				data := strings.Join(dataLines, "\n")
-				printEvent(currentEvent, data, jsonOutput)
+				if !onEvent(currentEvent, strings.Join(dataLines, "\n")) {
+					return nil
+				}
 			}
 			currentEvent = ""
 			dataLines = nil
@@ -132,20 +173,9 @@ func streamEvents(lobbyCode string, jsonOutput bool) error {
 	}
 
 	if err := scanner.Err(); err != nil {
-		// Context cancellation is expected
-		if ctx.Err() != nil {
-			if !jsonOutput {
-				fmt.Println("\nDisconnected")
-			}
-			return nil
-		}
 		return fmt.Errorf("stream error: %w", err)
 	}
-
-	if !jsonOutput {
-		fmt.Println("Disconnected")
-	}
-	return nil
+	return io.EOF
 }
 
 func printEvent(event, data string, jsonOutput bool) {
@@ -171,3 +201,105 @@ func printEvent(event, data string, jsonOutput bool) {
 		fmt.Printf("[%s] %s: %s\n", timestamp, event, displayData)
 	}
 }
+
+const (
+	watchRetryMin = time.Second
+	watchRetryMax = 30 * time.Second
+)
+
+// watchEventLabels gives each SSE event a readable description
+var watchEventLabels = map[string]string{
+	"connected":         "Connected",
+	"member-update":     "Members updated",
+	"controls-update":   "Lobby controls updated",
+	"game-started":      "Game started",
+	"game-update":       "Game updated",
+	"letter-announced":  "Letter announced",
+	"placement-update":  "Placements updated",
+	"turn-complete":     "Turn complete",
+	"scoreboard-update": "Scoreboard updated",
+	"game-complete":     "Game complete",
+	"game-summary":      "Final scores",
+	"scores-revealed":   "Scores revealed",
+	"game-abandoned":    "Game abandoned",
+	"game-dismissed":    "Results dismissed",
+	"refresh":           "Refresh",
+}
+
+// watchEventDetails lists events whose data is worth showing alongside the label
+var watchEventDetails = map[string]bool{
+	"member-update":    true,
+	"letter-announced": true,
+	"placement-update": true,
+	"turn-complete":    true,
+	"game-summary":     true,
+}
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// watchLobby tails a lobby's events, reconnecting with backoff if the
+// connection drops. If exitOnEnd is set it returns once the game finishes.
+func watchLobby(lobbyCode string, exitOnEnd bool) error {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	jsonOutput := cfg.Output == "json"
+	backoff := watchRetryMin
+
+	for {
+		ended := false
+		err := readEventStream(ctx, lobbyCode, func(event, data string) bool {
+			backoff = watchRetryMin
+			if jsonOutput {
+				printEvent(event, data, true)
+			} else {
+				fmt.Println(describeEvent(event, data))
+			}
+			if exitOnEnd && (event == "game-complete" || event == "game-abandoned") {
+				ended = true
+				return false
+			}
+			return true
+		})
+		if ended || ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, errStreamRejected) {
+			return err
+		}
+
+		if !jsonOutput {
+			fmt.Fprintf(os.Stderr, "Connection lost (%v), reconnecting in %s\n", err, backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, watchRetryMax)
+	}
+}
+
+// describeEvent formats an SSE event as a single readable line
+func describeEvent(event, data string) string {
+	timestamp := time.Now().Format("15:04:05")
+
+	label, ok := watchEventLabels[event]
+	if !ok {
+		label = event
+	}
+	if !watchEventDetails[event] {
+		return fmt.Sprintf("[%s] %s", timestamp, label)
+	}
+
+	// Broadcasts carry HTML fragments; reduce them to their text
+	detail := htmlTagPattern.ReplaceAllString(data, " ")
+	detail = strings.Join(strings.Fields(html.UnescapeString(detail)), " ")
+	if runes := []rune(detail); len(runes) > 100 {
+		detail = string(runes[:100]) + "..."
+	}
+	if detail == "" {
+		return fmt.Sprintf("[%s] %s", timestamp, label)
+	}
+	return fmt.Sprintf("[%s] %s: %s", timestamp, label, detail)
+}
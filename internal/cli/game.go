@@ -3,9 +3,10 @@ package cli
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 )
 
 func newGameCmd() *cobra.Command {
@@ -72,13 +73,12 @@ func newGameAnnounceCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			code := args[0]
-			letter := strings.ToUpper(args[1])
-
-			if len(letter) != 1 || letter[0] < 'A' || letter[0] > 'Z' {
-				return fmt.Errorf("letter must be a single character A-Z")
+			letter, err := model.ParseLetter(args[1])
+			if err != nil {
+				return fmt.Errorf("letter must be a single letter")
 			}
 
-			req := map[string]string{"letter": letter}
+			req := map[string]string{"letter": string(letter)}
 			var result AnnounceResult
 
 			if err := client.Post(fmt.Sprintf("/api/v1/lobbies/%s/game/announce", code), req, &result); err != nil {
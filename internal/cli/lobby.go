@@ -17,6 +17,7 @@ func newLobbyCmd() *cobra.Command {
 	cmd.AddCommand(newLobbyJoinCmd())
 	cmd.AddCommand(newLobbyLeaveCmd())
 	cmd.AddCommand(newLobbyConfigCmd())
+	cmd.AddCommand(newLobbyWatchCmd())
 
 	return cmd
 }
@@ -143,3 +144,26 @@ func newLobbyConfigCmd() *cobra.Command {
 
 	return cmd
 }
+
+func newLobbyWatchCmd() *cobra.Command {
+	var exitOnEnd bool
+
+	cmd := &cobra.Command{
+		Use:   "watch <code>",
+		Short: "Print a lobby's events as they happen",
+		Long: `Connect to the lobby's SSE endpoint and print each event as a readable line.
+
+Reconnects automatically if the connection drops. With --output json each
+event is printed as a JSON line instead.
+
+Press Ctrl+C to stop watching.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return watchLobby(args[0], exitOnEnd)
+		},
+	}
+
+	cmd.Flags().BoolVar(&exitOnEnd, "exit-on-end", false, "Exit once the game completes or is abandoned")
+
+	return cmd
+}
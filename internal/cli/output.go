@@ -75,6 +75,8 @@ func (o *Output) printText(data any) {
 		o.printPlaceResult(v)
 	case HealthResult:
 		o.printHealthResult(v)
+	case DetailedHealthResult:
+		o.printDetailedHealthResult(v)
 	default:
 		// Fallback to JSON for unknown types
 		o.printJSON(data)
@@ -130,6 +132,7 @@ type GameState struct {
 	AllBoards        map[string]*Board `json:"all_boards,omitempty"`
 	Scores           []BoardScore      `json:"scores,omitempty"`
 	Winner           *string           `json:"winner,omitempty"`
+	Result           string            `json:"result,omitempty"`
 }
 
 // Board response type
@@ -151,6 +154,8 @@ type WordMatch struct {
 	Row        int    `json:"row"`
 	Col        int    `json:"col"`
 	Horizontal bool   `json:"horizontal"`
+	Deduped    bool   `json:"deduped,omitempty"`
+	Best       bool   `json:"best,omitempty"`
 }
 
 // AnnounceResult response type
@@ -168,6 +173,7 @@ type PlaceResult struct {
 	NextAnnouncer string       `json:"next_announcer,omitempty"`
 	Scores        []BoardScore `json:"scores,omitempty"`
 	Winner        *string      `json:"winner,omitempty"`
+	Result        string       `json:"result,omitempty"`
 }
 
 // HealthResult response type
@@ -175,6 +181,25 @@ type HealthResult struct {
 	Status string `json:"status"`
 }
 
+// DetailedHealthResult response type
+type DetailedHealthResult struct {
+	Status     string           `json:"status"`
+	Storage    StorageHealth    `json:"storage"`
+	Dictionary DictionaryHealth `json:"dictionary"`
+}
+
+// StorageHealth response type
+type StorageHealth struct {
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error,omitempty"`
+}
+
+// DictionaryHealth response type
+type DictionaryHealth struct {
+	Loaded bool `json:"loaded"`
+	Words  int  `json:"words"`
+}
+
 func (o *Output) printPlayer(p Player) {
 	guestStr := "no"
 	if p.IsGuest {
@@ -258,12 +283,20 @@ func (o *Output) printGameState(g GameState) {
 		for _, s := range g.Scores {
 			fmt.Printf("  %s: %d points\n", s.PlayerID, s.TotalScore)
 			for _, w := range s.Words {
-				fmt.Printf("    - %s (%d pts)\n", w.Word, w.Score)
+				if w.Deduped {
+					fmt.Printf("    - %s (repeat, not scored)\n", w.Word)
+				} else if w.Best {
+					fmt.Printf("    - %s (%d pts, best word)\n", w.Word, w.Score)
+				} else {
+					fmt.Printf("    - %s (%d pts)\n", w.Word, w.Score)
+				}
 			}
 		}
 	}
 
-	if g.Winner != nil {
+	if g.Result != "" {
+		fmt.Printf("\nResult: %s\n", g.Result)
+	} else if g.Winner != nil {
 		fmt.Printf("\nWinner: %s\n", *g.Winner)
 	}
 }
@@ -330,7 +363,9 @@ func (o *Output) printPlaceResult(p PlaceResult) {
 
 	if p.GameComplete {
 		fmt.Println("Game complete!")
-		if p.Winner != nil {
+		if p.Result != "" {
+			fmt.Printf("Result: %s\n", p.Result)
+		} else if p.Winner != nil {
 			fmt.Printf("Winner: %s\n", *p.Winner)
 		}
 		if len(p.Scores) > 0 {
@@ -345,3 +380,17 @@ func (o *Output) printPlaceResult(p PlaceResult) {
 func (o *Output) printHealthResult(h HealthResult) {
 	fmt.Printf("Status: %s\n", h.Status)
 }
+
+func (o *Output) printDetailedHealthResult(h DetailedHealthResult) {
+	fmt.Printf("Status: %s\n", h.Status)
+	if h.Storage.Reachable {
+		fmt.Println("Storage: reachable")
+	} else {
+		fmt.Printf("Storage: unreachable (%s)\n", h.Storage.Error)
+	}
+	if h.Dictionary.Loaded {
+		fmt.Printf("Dictionary: loaded (%d words)\n", h.Dictionary.Words)
+	} else {
+		fmt.Println("Dictionary: not loaded")
+	}
+}
@@ -48,6 +48,7 @@ game actions, and real-time SSE event streaming.`,
 	rootCmd.AddCommand(newGameCmd())
 	rootCmd.AddCommand(newEventsCmd())
 	rootCmd.AddCommand(newHealthCmd())
+	rootCmd.AddCommand(newAdminCmd())
 
 	return rootCmd
 }
@@ -5,6 +5,8 @@ import "time"
 // Clock provides time operations that can be mocked for testing
 type Clock interface {
 	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time
+	After(d time.Duration) <-chan time.Time
 }
 
 // RealClock implements Clock using the system clock
@@ -19,3 +21,8 @@ func New() *RealClock {
 func (c *RealClock) Now() time.Time {
 	return time.Now()
 }
+
+// After waits for the duration to elapse and then sends the current time
+func (c *RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
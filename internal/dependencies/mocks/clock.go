@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"sync"
 	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
@@ -8,7 +9,15 @@ import (
 
 // MockClock is a mock implementation of Clock for testing
 type MockClock struct {
+	mu          sync.Mutex
 	CurrentTime time.Time
+	waiters     []mockWaiter
+}
+
+// mockWaiter is a pending After call that fires once the clock reaches deadline
+type mockWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
 }
 
 // Ensure MockClock implements Clock
@@ -21,15 +30,58 @@ func NewMockClock(t time.Time) *MockClock {
 
 // Now returns the mocked current time
 func (c *MockClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.CurrentTime
 }
 
+// After returns a channel that fires once the clock is advanced past d
+// A non-positive duration fires immediately
+func (c *MockClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.CurrentTime
+		return ch
+	}
+	c.waiters = append(c.waiters, mockWaiter{deadline: c.CurrentTime.Add(d), ch: ch})
+	return ch
+}
+
+// Waiters returns the number of After calls still waiting to fire
+func (c *MockClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
 // Advance moves the clock forward by the given duration
 func (c *MockClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.CurrentTime = c.CurrentTime.Add(d)
+	c.fireWaiters()
 }
 
 // Set sets the clock to the given time
 func (c *MockClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.CurrentTime = t
+	c.fireWaiters()
+}
+
+// fireWaiters notifies any waiters whose deadline has passed (caller holds mu)
+func (c *MockClock) fireWaiters() {
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.deadline.After(c.CurrentTime) {
+			w.ch <- c.CurrentTime
+			continue
+		}
+		remaining = append(remaining, w)
+	}
+	c.waiters = remaining
 }
@@ -2,7 +2,9 @@ package random
 
 import (
 	"crypto/rand"
+	"hash/fnv"
 	"math/big"
+	mrand "math/rand/v2"
 )
 
 // Random provides random number generation that can be mocked for testing
@@ -47,3 +49,47 @@ func (r *CryptoRandom) String(length int, alphabet string) string {
 	}
 	return string(result)
 }
+
+// SeededRandom implements Random deterministically from a seed, for games
+// that must be reproducible. It is not safe for concurrent use.
+type SeededRandom struct {
+	rng *mrand.Rand
+}
+
+// NewSeeded creates a SeededRandom producing the sequence for seed and stream
+func NewSeeded(seed, stream uint64) *SeededRandom {
+	return &SeededRandom{rng: mrand.New(mrand.NewPCG(seed, stream))}
+}
+
+// Intn returns a deterministic int in [0, n)
+func (r *SeededRandom) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return r.rng.IntN(n)
+}
+
+// String generates a deterministic string of the given length from the given alphabet
+func (r *SeededRandom) String(length int, alphabet string) string {
+	if length <= 0 || len(alphabet) == 0 {
+		return ""
+	}
+	result := make([]byte, length)
+	for i := 0; i < length; i++ {
+		result[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return string(result)
+}
+
+// ForSeed returns fallback when seed is 0, and otherwise a SeededRandom for
+// seed whose stream is picked by key. Giving each decision in a seeded game
+// its own key (e.g. the turn it is for) makes the game replayable without
+// keeping generator state between requests.
+func ForSeed(fallback Random, seed int64, key string) Random {
+	if seed == 0 {
+		return fallback
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return NewSeeded(uint64(seed), h.Sum64())
+}
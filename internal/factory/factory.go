@@ -1,15 +1,19 @@
 package factory
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 	"github.com/mcoot/crosswordgame-go2/internal/services/board"
+	"github.com/mcoot/crosswordgame-go2/internal/services/boardimage"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
 	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 	"github.com/mcoot/crosswordgame-go2/internal/services/game"
@@ -39,12 +43,41 @@ type App struct {
 	// Services
 	DictionaryService *dictionary.Service
 	BoardService      *board.Service
+	BoardImageService *boardimage.Service
 	ScoringService    *scoring.Service
 	GameController    *game.Controller
 	LobbyController   *lobby.Controller
 	AuthService       *auth.Service
 	BotService        *bot.Service
 	HubManager        *sse.HubManager
+
+	// SlowRequestThreshold is passed to the routers' logging middleware
+	SlowRequestThreshold time.Duration
+	// AdminToken is passed to the API router to authorize admin endpoints
+	AdminToken string
+
+	// Memory storage snapshots, if configured (see Close)
+	memoryStore   *memory.Storage
+	snapshotCfg   memory.SnapshotConfig
+	stopSnapshots context.CancelFunc
+
+	stopHubCleanup context.CancelFunc
+}
+
+// Close stops background snapshots and SSE hub cleanup and, if memory
+// storage snapshots are configured, saves a final snapshot. Call it on
+// graceful shutdown.
+func (a *App) Close() error {
+	if a.stopSnapshots != nil {
+		a.stopSnapshots()
+	}
+	if a.stopHubCleanup != nil {
+		a.stopHubCleanup()
+	}
+	if a.memoryStore == nil || a.snapshotCfg.Path == "" {
+		return nil
+	}
+	return a.memoryStore.SaveSnapshot(a.snapshotCfg.Path)
 }
 
 // Config holds configuration for the application factory
@@ -53,8 +86,45 @@ type Config struct {
 	// If empty, dictionary must be loaded manually
 	DictionaryPath string
 	// AuthConfig holds configuration for the auth service (optional)
-	// If zero value, defaults to auth.DefaultConfig()
+	// Zero-valued fields fall back to auth.DefaultConfig()
 	AuthConfig auth.Config
+	// NameBlocklistPath is a file of words not allowed in display names (optional)
+	// See auth.LoadNameFilter for the format. If empty, names are not filtered
+	NameBlocklistPath string
+	// LobbyConfig holds configuration for the lobby controller (optional)
+	// Zero-valued fields fall back to lobby.DefaultConfig()
+	LobbyConfig lobby.Config
+	// GameConfig holds configuration for the game controller (optional)
+	// Zero-valued fields fall back to game.DefaultConfig(). StealBonus is
+	// ignored; it is turned on by ScoringConfig.StealBonus instead
+	GameConfig game.Config
+	// BotConfig holds configuration for the bot service (optional)
+	// Zero value runs bots synchronously with no think time, and zero
+	// MaxBots falls back to bot.DefaultConfig()
+	BotConfig bot.Config
+	// ScoringConfig holds configuration for the scoring service (optional)
+	// Zero value applies no penalties
+	ScoringConfig scoring.Config
+	// SSEConfig holds configuration for the SSE hubs (optional)
+	// Zero-valued fields fall back to sse.DefaultHubConfig()
+	SSEConfig sse.HubConfig
+	// Alphabet controls which letters may be played and how accented input
+	// and dictionary words are normalized (optional)
+	// Zero value accepts A-Z only
+	Alphabet model.Alphabet
+	// SlowRequestThreshold is the request duration above which requests are
+	// logged at WARN level (optional)
+	// If zero, defaults to middleware.DefaultSlowRequestThreshold
+	SlowRequestThreshold time.Duration
+	// MaxLobbies caps the number of concurrent lobbies (optional)
+	// If zero, lobby creation is unlimited
+	MaxLobbies int
+	// AllowedGridSizes restricts lobbies to these grid sizes (optional)
+	// If empty, any size within the lobby config's bounds is allowed
+	AllowedGridSizes []int
+	// AdminToken authorizes the API admin endpoints (optional)
+	// If empty, the admin endpoints are disabled
+	AdminToken string
 	// Logger is the application logger (optional)
 	// If nil, a no-op logger is used
 	Logger *slog.Logger
@@ -63,6 +133,12 @@ type Config struct {
 	StorageType string
 	// RedisConfig holds Redis connection settings (required if StorageType is "redis")
 	RedisConfig *redisstorage.Config
+	// StorageRetry retries Redis operations that fail transiently (optional)
+	// If Attempts is zero, defaults to storage.DefaultRetryConfig()
+	StorageRetry storage.RetryConfig
+	// MemorySnapshot persists memory storage to a file across restarts (optional)
+	// Ignored for Redis. If Path is empty, memory storage is not persisted
+	MemorySnapshot memory.SnapshotConfig
 }
 
 // New creates a new application with all dependencies wired
@@ -75,6 +151,7 @@ func New(cfg Config) (*App, error) {
 
 	// Create storage based on type
 	var store storage.Storage
+	var memoryStore *memory.Storage
 	storageType := cfg.StorageType
 	if storageType == "" {
 		storageType = StorageTypeMemory
@@ -82,7 +159,13 @@ func New(cfg Config) (*App, error) {
 
 	switch storageType {
 	case StorageTypeMemory:
-		store = memory.New()
+		memoryStore = memory.New()
+		if cfg.MemorySnapshot.Path != "" {
+			if err := memoryStore.LoadSnapshot(cfg.MemorySnapshot.Path, cfg.MemorySnapshot, time.Now()); err != nil {
+				return nil, fmt.Errorf("failed to load memory snapshot: %w", err)
+			}
+		}
+		store = memoryStore
 	case StorageTypeRedis:
 		if cfg.RedisConfig == nil {
 			return nil, errors.New("RedisConfig required when StorageType is redis")
@@ -91,7 +174,12 @@ func New(cfg Config) (*App, error) {
 		if err != nil {
 			return nil, err
 		}
-		store = redisStore
+		retryCfg := cfg.StorageRetry
+		if retryCfg.Attempts == 0 {
+			retryCfg = storage.DefaultRetryConfig()
+		}
+		// Memory storage never fails transiently, so only Redis is wrapped
+		store = storage.WithRetry(redisStore, retryCfg)
 	default:
 		return nil, errors.New("invalid StorageType: must be 'memory' or 'redis'")
 	}
@@ -100,30 +188,63 @@ func New(cfg Config) (*App, error) {
 	clk := clock.New()
 	rnd := random.New()
 
-	// Use default auth config if not provided
+	// Unset auth config fields fall back to auth.DefaultConfig()
 	authCfg := cfg.AuthConfig
-	if authCfg.SessionDuration == 0 {
-		authCfg = auth.DefaultConfig()
+	if cfg.NameBlocklistPath != "" {
+		nameFilter, err := auth.LoadNameFilter(cfg.NameBlocklistPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load name blocklist: %w", err)
+		}
+		authCfg.NameFilter = nameFilter
+	}
+
+	lobbyCfg := cfg.LobbyConfig
+	if cfg.MaxLobbies != 0 {
+		lobbyCfg.MaxLobbies = cfg.MaxLobbies
+	}
+	if len(cfg.AllowedGridSizes) > 0 {
+		lobbyCfg.GridSizeBounds.Allowed = cfg.AllowedGridSizes
 	}
 
-	return newWithDependencies(store, clk, rnd, authCfg, logger), nil
+	app := newWithDependencies(store, clk, rnd, authCfg, lobbyCfg, cfg.GameConfig, cfg.ScoringConfig, cfg.BotConfig, cfg.SSEConfig, cfg.Alphabet, logger)
+	app.SlowRequestThreshold = cfg.SlowRequestThreshold
+	app.AdminToken = cfg.AdminToken
+
+	cleanupCtx, stopHubCleanup := context.WithCancel(context.Background())
+	app.stopHubCleanup = stopHubCleanup
+	go app.HubManager.RunCleanup(cleanupCtx)
+
+	if memoryStore != nil && cfg.MemorySnapshot.Path != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		app.memoryStore = memoryStore
+		app.snapshotCfg = cfg.MemorySnapshot
+		app.stopSnapshots = cancel
+		go memoryStore.RunSnapshots(ctx, cfg.MemorySnapshot, logger)
+	}
+	return app, nil
 }
 
 // newWithDependencies creates an App with the given dependencies (useful for testing)
-func newWithDependencies(store storage.Storage, clk clock.Clock, rnd random.Random, authCfg auth.Config, logger *slog.Logger) *App {
+func newWithDependencies(store storage.Storage, clk clock.Clock, rnd random.Random, authCfg auth.Config, lobbyCfg lobby.Config, gameCfg game.Config, scoringCfg scoring.Config, botCfg bot.Config, hubCfg sse.HubConfig, alphabet model.Alphabet, logger *slog.Logger) *App {
 	// Create services
-	dictService := dictionary.New(store, logger)
-	boardService := board.New(store, logger)
-	scoringService := scoring.New(dictService)
-	gameController := game.NewController(store, boardService, scoringService, clk, rnd, logger)
-	lobbyController := lobby.NewController(store, gameController, clk, rnd, logger)
+	dictService := dictionary.New(store, alphabet, logger)
+	boardService := board.New(store, alphabet, logger)
+	boardImageService := boardimage.New(logger)
+	scoringService := scoring.New(dictService, scoringCfg)
+	// Steals are only worth tracking if they score
+	gameCfg.StealBonus = scoringCfg.StealBonus > 0
+	gameController := game.NewController(store, boardService, scoringService, clk, rnd, gameCfg, logger)
+	hubManager := sse.NewHubManager(hubCfg, clk, logger)
+	if lobbyCfg.Presence == nil {
+		lobbyCfg.Presence = hubManager
+	}
+	lobbyController := lobby.NewController(store, gameController, clk, rnd, lobbyCfg, logger)
 	authService := auth.New(store, clk, authCfg, logger)
-	hubManager := sse.NewHubManager(logger)
 
-	botStrategies := map[string]bot.Strategy{
-		model.BotStrategyRandom: bot.NewRandomStrategy(rnd),
-	}
-	botService := bot.NewService(store, lobbyController, gameController, boardService, botStrategies, clk, rnd, logger)
+	botStrategies := bot.NewRegistry()
+	botStrategies.Register(model.BotStrategyRandom, model.BotStrategyDisplayName(model.BotStrategyRandom), bot.NewRandomStrategy(rnd))
+	botStrategies.Register(model.BotStrategyGreedy, model.BotStrategyDisplayName(model.BotStrategyGreedy), bot.NewGreedyStrategy(dictService, scoringService, rnd))
+	botService := bot.NewService(store, lobbyController, gameController, boardService, botStrategies, clk, rnd, botCfg, logger)
 
 	return &App{
 		Storage:           store,
@@ -131,6 +252,7 @@ func newWithDependencies(store storage.Storage, clk clock.Clock, rnd random.Rand
 		Random:            rnd,
 		DictionaryService: dictService,
 		BoardService:      boardService,
+		BoardImageService: boardImageService,
 		ScoringService:    scoringService,
 		GameController:    gameController,
 		LobbyController:   lobbyController,
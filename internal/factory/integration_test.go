@@ -2,11 +2,20 @@ package factory
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/game"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
+	"github.com/mcoot/crosswordgame-go2/internal/testutil"
+	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 )
 
 type IntegrationSuite struct {
@@ -34,6 +43,19 @@ func (s *IntegrationSuite) createPlayer(id, name string) model.Player {
 	}
 }
 
+// Test: Steal bonus points turn steals on without a separate game setting
+func (s *IntegrationSuite) TestStealBonusPointsEnableSteals() {
+	store := memory.New()
+	for points, enabled := range map[int]bool{0: false, 5: true} {
+		app := newWithDependencies(store, s.app.MockClock, s.app.MockRandom, auth.DefaultConfig(), lobby.DefaultConfig(),
+			game.DefaultConfig(), scoring.Config{StealBonus: points}, bot.DefaultConfig(), sse.DefaultHubConfig(), model.DefaultAlphabet(), testutil.NopLogger())
+		s.app.MockRandom.QueueString(fmt.Sprintf("GAME%d", points))
+		g, err := app.GameController.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player1"}, 2)
+		s.Require().NoError(err)
+		s.Equal(enabled, g.StealBonus, "points %d", points)
+	}
+}
+
 // Test: Complete game flow from lobby creation to game completion
 func (s *IntegrationSuite) TestCompleteGameFlow() {
 	// Setup: Queue random values
@@ -302,7 +324,7 @@ func (s *IntegrationSuite) TestBotGameFlow() {
 
 	// Add a bot to the lobby
 	s.app.MockRandom.QueueString("botplayer1abcdef")
-	botPlayer, err := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, err := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom, "")
 	s.Require().NoError(err)
 	s.True(botPlayer.IsBot)
 
@@ -360,10 +382,10 @@ func (s *IntegrationSuite) TestAllBotsGame() {
 	lobby, _ := s.app.LobbyController.CreateLobby(s.ctx, host)
 
 	s.app.MockRandom.QueueString("bot1abcdefghijkl")
-	_, _ = s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom)
+	_, _ = s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom, "")
 
 	s.app.MockRandom.QueueString("bot2abcdefghijkl")
-	_, _ = s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom)
+	_, _ = s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.app.LobbyController.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	s.app.MockRandom.QueueString("GAME01")
@@ -405,7 +427,7 @@ func (s *IntegrationSuite) TestAddRemoveBot() {
 
 	// Add bot
 	s.app.MockRandom.QueueString("bot1abcdefghijkl")
-	botPlayer, err := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, err := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom, "")
 	s.Require().NoError(err)
 
 	updatedLobby, _ := s.app.LobbyController.GetLobby(s.ctx, lobby.Code)
@@ -426,7 +448,7 @@ func (s *IntegrationSuite) TestBotInLobbyStartsGame() {
 	lobby, _ := s.app.LobbyController.CreateLobby(s.ctx, host)
 
 	s.app.MockRandom.QueueString("bot1abcdefghijkl")
-	botPlayer, _ := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, _ := s.app.BotService.AddBotToLobby(s.ctx, lobby.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.app.LobbyController.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	s.app.MockRandom.QueueString("GAME01")
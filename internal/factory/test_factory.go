@@ -4,9 +4,15 @@ import (
 	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/mocks"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
+	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/game"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
 	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
 	"github.com/mcoot/crosswordgame-go2/internal/testutil"
+	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 )
 
 // TestApp extends App with test-specific helpers
@@ -25,7 +31,7 @@ func NewTestApp() *TestApp {
 	mockRandom := mocks.NewMockRandom()
 	logger := testutil.NopLogger()
 
-	app := newWithDependencies(store, mockClock, mockRandom, auth.DefaultConfig(), logger)
+	app := newWithDependencies(store, mockClock, mockRandom, auth.DefaultConfig(), lobby.DefaultConfig(), game.DefaultConfig(), scoring.DefaultConfig(), bot.DefaultConfig(), sse.DefaultHubConfig(), model.DefaultAlphabet(), logger)
 
 	return &TestApp{
 		App:        app,
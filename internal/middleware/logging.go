@@ -1,11 +1,45 @@
 package middleware
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 )
 
+// DefaultSlowRequestThreshold is the request duration above which requests
+// are logged at WARN level
+const DefaultSlowRequestThreshold = 500 * time.Millisecond
+
+type contextKey string
+
+const requestInfoContextKey contextKey = "requestInfo"
+
+// requestInfo collects attributes discovered by inner middleware so that the
+// logging middleware can include them once the request completes
+type requestInfo struct {
+	mu       sync.Mutex
+	playerID model.PlayerID
+}
+
+// AnnotatePlayer records the authenticated player for the request log
+// It is a no-op if the logging middleware is not applied
+func AnnotatePlayer(ctx context.Context, playerID model.PlayerID) {
+	info, ok := ctx.Value(requestInfoContextKey).(*requestInfo)
+	if !ok {
+		return
+	}
+	info.mu.Lock()
+	info.playerID = playerID
+	info.mu.Unlock()
+}
+
 // ResponseWriter wraps http.ResponseWriter to capture the status code and size
 type ResponseWriter struct {
 	http.ResponseWriter
@@ -44,24 +78,56 @@ func (rw *ResponseWriter) Flush() {
 }
 
 // Logging creates logging middleware that logs HTTP requests
-func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
+// Requests taking longer than slowThreshold are logged at WARN level;
+// a zero threshold uses DefaultSlowRequestThreshold. Streaming (SSE)
+// responses stay open for the life of the connection, so they are never
+// treated as slow
+func Logging(logger *slog.Logger, slowThreshold time.Duration) func(http.Handler) http.Handler {
+	if slowThreshold <= 0 {
+		slowThreshold = DefaultSlowRequestThreshold
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 
+			info := &requestInfo{}
+			r = r.WithContext(context.WithValue(r.Context(), requestInfoContextKey, info))
 			wrapped := &ResponseWriter{ResponseWriter: w, status: http.StatusOK}
 
 			next.ServeHTTP(wrapped, r)
 
 			duration := time.Since(start)
 
-			logger.Info("http request",
+			attrs := []any{
 				slog.String("method", r.Method),
 				slog.String("path", r.URL.Path),
 				slog.Int("status", wrapped.status),
 				slog.Int("size", wrapped.size),
 				slog.Duration("duration", duration),
-			)
+			}
+			if id := RequestIDFromContext(r.Context()); id != "" {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+			if code := mux.Vars(r)["code"]; code != "" {
+				attrs = append(attrs, slog.String("lobby_code", code))
+			}
+			info.mu.Lock()
+			if info.playerID != "" {
+				attrs = append(attrs, slog.String("player_id", string(info.playerID)))
+			}
+			info.mu.Unlock()
+
+			if duration > slowThreshold && !isStreaming(wrapped) {
+				logger.Warn("slow http request", attrs...)
+				return
+			}
+			logger.Info("http request", attrs...)
 		})
 	}
 }
+
+// isStreaming reports whether the response is a server-sent event stream
+func isStreaming(w http.ResponseWriter) bool {
+	return strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream")
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordingLogger returns a logger that writes JSON records to the returned buffer
+func newRecordingLogger() (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return slog.New(slog.NewJSONHandler(&buf, nil)), &buf
+}
+
+func decodeRecord(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return record
+}
+
+func TestLoggingWarnsOnSlowRequest(t *testing.T) {
+	logger, buf := newRecordingLogger()
+
+	r := mux.NewRouter()
+	r.Use(Logging(logger, 10*time.Millisecond))
+	r.HandleFunc("/lobby/{code}", func(w http.ResponseWriter, r *http.Request) {
+		AnnotatePlayer(r.Context(), "player-1")
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/lobby/ABC123", nil))
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, "slow http request", record["msg"])
+	assert.Equal(t, http.MethodGet, record["method"])
+	assert.Equal(t, "/lobby/ABC123", record["path"])
+	assert.EqualValues(t, http.StatusTeapot, record["status"])
+	assert.Equal(t, "ABC123", record["lobby_code"])
+	assert.Equal(t, "player-1", record["player_id"])
+}
+
+func TestLoggingInfoForFastRequest(t *testing.T) {
+	logger, buf := newRecordingLogger()
+
+	handler := Logging(logger, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "http request", record["msg"])
+	assert.NotContains(t, record, "lobby_code")
+	assert.NotContains(t, record, "player_id")
+}
+
+func TestLoggingInfoForLongLivedStream(t *testing.T) {
+	logger, buf := newRecordingLogger()
+
+	handler := Logging(logger, 10*time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		time.Sleep(20 * time.Millisecond)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/lobby/ABC123/events", nil))
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "http request", record["msg"])
+}
@@ -15,12 +15,16 @@ func Recovery(logger *slog.Logger, handler PanicHandler) func(http.Handler) http
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
 				if err := recover(); err != nil {
-					logger.Error("panic recovered",
+					attrs := []any{
 						slog.Any("error", err),
 						slog.String("stack", string(debug.Stack())),
 						slog.String("method", r.Method),
 						slog.String("path", r.URL.Path),
-					)
+					}
+					if id := RequestIDFromContext(r.Context()); id != "" {
+						attrs = append(attrs, slog.String("request_id", id))
+					}
+					logger.Error("panic recovered", attrs...)
 
 					handler(w, r, err)
 				}
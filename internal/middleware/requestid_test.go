@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDEchoesProvidedID(t *testing.T) {
+	logger, buf := newRecordingLogger()
+
+	var seen string
+	handler := RequestID()(Logging(logger, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set(RequestIDHeader, "trace-abc-123")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, "trace-abc-123", rr.Header().Get(RequestIDHeader))
+	assert.Equal(t, "trace-abc-123", seen)
+	assert.Equal(t, "trace-abc-123", decodeRecord(t, buf)["request_id"])
+}
+
+func TestRequestIDGeneratedWhenAbsentOrInvalid(t *testing.T) {
+	var seen string
+	handler := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+	generated := rr.Header().Get(RequestIDHeader)
+	assert.Len(t, generated, 32)
+	assert.Equal(t, generated, seen)
+
+	// IDs that could corrupt logs are replaced
+	for _, bad := range []string{"has space", "line\nbreak", strings.Repeat("x", maxRequestIDLength+1)} {
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		req.Header.Set(RequestIDHeader, bad)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.NotEqual(t, bad, rr.Header().Get(RequestIDHeader))
+		assert.Len(t, rr.Header().Get(RequestIDHeader), 32)
+	}
+}
+
+func TestRequestIDHandlerTagsContextLogs(t *testing.T) {
+	base, buf := newRecordingLogger()
+	logger := slog.New(NewRequestIDHandler(base.Handler())).With(slog.String("component", "lobby"))
+
+	logger.InfoContext(WithRequestID(t.Context(), "req-1"), "player joined lobby")
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, "lobby", record["component"])
+
+	// Logs outside a request are left alone
+	buf.Reset()
+	logger.Info("lobby created")
+	assert.NotContains(t, decodeRecord(t, buf), "request_id")
+}
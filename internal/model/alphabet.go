@@ -0,0 +1,117 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Alphabet describes which letters may be announced and placed, and how
+// player input is normalized before it reaches a board
+type Alphabet struct {
+	// FoldAccents maps accented Latin letters to their unaccented base letter
+	// (e.g. é -> E) instead of rejecting them
+	FoldAccents bool
+	// ExtraLetters are letters permitted in addition to A-Z (e.g. "ÉÈÇ" for a
+	// French word list played without folding). Case-insensitive.
+	ExtraLetters string
+}
+
+// DefaultAlphabet returns the plain A-Z alphabet
+func DefaultAlphabet() Alphabet {
+	return Alphabet{}
+}
+
+// ParseLetter parses player input naming a single letter, upper-cased
+// Surrounding whitespace is ignored. Returns ErrInvalidLetter for empty
+// input, more than one character, or a character that isn't a letter.
+// Whether the letter is in the game's alphabet is checked by NormalizeLetter.
+func ParseLetter(s string) (rune, error) {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) != 1 {
+		return 0, ErrInvalidLetter
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	if !unicode.IsLetter(r) {
+		return 0, ErrInvalidLetter
+	}
+	return unicode.ToUpper(r), nil
+}
+
+// NormalizeLetter upper-cases a letter, folding accents if configured
+// Returns ErrInvalidLetter if the result is not in the alphabet
+func (a Alphabet) NormalizeLetter(letter rune) (rune, error) {
+	upper := unicode.ToUpper(letter)
+	if upper >= 'A' && upper <= 'Z' {
+		return upper, nil
+	}
+	if a.ExtraLetters != "" && strings.ContainsRune(strings.ToUpper(a.ExtraLetters), upper) {
+		return upper, nil
+	}
+	if a.FoldAccents {
+		if base, ok := accentFolds[upper]; ok {
+			return base, nil
+		}
+	}
+	return 0, ErrInvalidLetter
+}
+
+// NormalizeWord lower-cases a dictionary word, folding accents if configured,
+// so it matches words spelled out from normalized board letters
+func (a Alphabet) NormalizeWord(word string) string {
+	if !a.FoldAccents {
+		return strings.ToLower(word)
+	}
+	return strings.Map(func(r rune) rune {
+		if base, ok := accentFolds[unicode.ToUpper(r)]; ok {
+			return unicode.ToLower(base)
+		}
+		return unicode.ToLower(r)
+	}, word)
+}
+
+// Letters returns every letter in the alphabet, A-Z first
+func (a Alphabet) Letters() []rune {
+	letters := make([]rune, 0, 26+len(a.ExtraLetters))
+	for r := 'A'; r <= 'Z'; r++ {
+		letters = append(letters, r)
+	}
+	for _, r := range strings.ToUpper(a.ExtraLetters) {
+		if r < 'A' || r > 'Z' {
+			letters = append(letters, r)
+		}
+	}
+	return letters
+}
+
+// accentFolds maps upper-case accented Latin letters to their base letter
+var accentFolds = func() map[rune]rune {
+	groups := map[rune]string{
+		'A': "ÀÁÂÃÄÅĀĂĄ",
+		'C': "ÇĆĈĊČ",
+		'D': "ĎĐ",
+		'E': "ÈÉÊËĒĔĖĘĚ",
+		'G': "ĜĞĠĢ",
+		'H': "ĤĦ",
+		'I': "ÌÍÎÏĨĪĬĮİ",
+		'J': "Ĵ",
+		'K': "Ķ",
+		'L': "ĹĻĽĿŁ",
+		'N': "ÑŃŅŇ",
+		'O': "ÒÓÔÕÖØŌŎŐ",
+		'R': "ŔŖŘ",
+		'S': "ŚŜŞŠ",
+		'T': "ŢŤŦ",
+		'U': "ÙÚÛÜŨŪŬŮŰŲ",
+		'W': "Ŵ",
+		'Y': "ÝŶŸ",
+		'Z': "ŹŻŽ",
+	}
+	folds := make(map[rune]rune)
+	for base, accented := range groups {
+		for _, r := range accented {
+			folds[r] = base
+		}
+	}
+	return folds
+}()
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLetter(t *testing.T) {
+	valid := []struct {
+		input string
+		want  rune
+	}{
+		{"A", 'A'},
+		{"q", 'Q'},
+		{" z ", 'Z'},
+		{"é", 'É'},
+	}
+	for _, tc := range valid {
+		got, err := ParseLetter(tc.input)
+		require.NoError(t, err, tc.input)
+		assert.Equal(t, tc.want, got, tc.input)
+	}
+
+	invalid := []string{"", "   ", "AB", "éé", "7", "@"}
+	for _, input := range invalid {
+		_, err := ParseLetter(input)
+		assert.ErrorIs(t, err, ErrInvalidLetter, input)
+	}
+}
@@ -1,17 +1,23 @@
 package model
 
+import "slices"
+
 // Position identifies a cell on the board
 type Position struct {
 	Row int // 0-indexed from top
 	Col int // 0-indexed from left
 }
 
+// BlockedCell marks a cell that can't be placed on. It is never a word
+// letter, so words stop at it.
+const BlockedCell rune = '#'
+
 // Board represents a player's grid for a specific game
 type Board struct {
 	GameID   GameID
 	PlayerID PlayerID
 	Size     int      // Grid dimension (e.g., 5 for 5x5)
-	Cells    [][]rune // Row-major: Cells[row][col], 0 means empty
+	Cells    [][]rune // Row-major: Cells[row][col], 0 means empty, BlockedCell means blocked
 }
 
 // NewBoard creates an empty board of the given size
@@ -28,6 +34,18 @@ func NewBoard(gameID GameID, playerID PlayerID, size int) *Board {
 	}
 }
 
+// Block marks the given cells as blocked, ignoring any out of bounds
+func (b *Board) Block(positions []Position) {
+	for _, pos := range positions {
+		b.Set(pos, BlockedCell)
+	}
+}
+
+// IsBlocked returns true if the cell at the given position is blocked
+func (b *Board) IsBlocked(pos Position) bool {
+	return b.Get(pos) == BlockedCell
+}
+
 // Get returns the letter at the given position, or 0 if empty
 func (b *Board) Get(pos Position) rune {
 	if !b.IsValidPosition(pos) {
@@ -53,7 +71,7 @@ func (b *Board) IsValidPosition(pos Position) bool {
 	return pos.Row >= 0 && pos.Row < b.Size && pos.Col >= 0 && pos.Col < b.Size
 }
 
-// IsFull returns true if all cells are filled
+// IsFull returns true if all cells are filled (or blocked)
 func (b *Board) IsFull() bool {
 	for row := 0; row < b.Size; row++ {
 		for col := 0; col < b.Size; col++ {
@@ -65,7 +83,35 @@ func (b *Board) IsFull() bool {
 	return true
 }
 
-// EmptyCount returns the number of empty cells
+// HasLetters returns true if any cell holds a letter
+func (b *Board) HasLetters() bool {
+	for row := 0; row < b.Size; row++ {
+		for col := 0; col < b.Size; col++ {
+			if c := b.Cells[row][col]; c != 0 && c != BlockedCell {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TouchesLetter returns true if a cell orthogonally adjacent to pos is filled
+func (b *Board) TouchesLetter(pos Position) bool {
+	neighbors := []Position{
+		{Row: pos.Row - 1, Col: pos.Col},
+		{Row: pos.Row + 1, Col: pos.Col},
+		{Row: pos.Row, Col: pos.Col - 1},
+		{Row: pos.Row, Col: pos.Col + 1},
+	}
+	for _, n := range neighbors {
+		if c := b.Get(n); c != 0 && c != BlockedCell {
+			return true
+		}
+	}
+	return false
+}
+
+// EmptyCount returns the number of empty cells, which excludes blocked ones
 func (b *Board) EmptyCount() int {
 	count := 0
 	for row := 0; row < b.Size; row++ {
@@ -78,6 +124,16 @@ func (b *Board) EmptyCount() int {
 	return count
 }
 
+// CompletedRow returns the letters of the given row as a word if every cell
+// in it holds a letter
+func (b *Board) CompletedRow(row int) (string, bool) {
+	letters := b.GetRow(row)
+	if letters == nil || slices.ContainsFunc(letters, func(c rune) bool { return c == 0 || c == BlockedCell }) {
+		return "", false
+	}
+	return string(letters), true
+}
+
 // GetRow returns all letters in the given row
 func (b *Board) GetRow(row int) []rune {
 	if row < 0 || row >= b.Size {
@@ -106,12 +162,132 @@ type WordMatch struct {
 	StartPos   Position
 	Horizontal bool // true = left-to-right, false = top-to-bottom
 	Length     int
-	Score      int // Calculated score for this word
+	Score      int  // Calculated score for this word
+	TilePoints int  // Sum of the letters' tile values, when words are valued by tile
+	Deduped    bool // Repeat of a word scored elsewhere on the board, so not counted
+	Best       bool // The one word counted when only the best word scores
+}
+
+// Cells returns the cells the word covers, in reading order
+func (w WordMatch) Cells() []Position {
+	cells := make([]Position, w.Length)
+	for i := range cells {
+		if w.Horizontal {
+			cells[i] = Position{Row: w.StartPos.Row, Col: w.StartPos.Col + i}
+		} else {
+			cells[i] = Position{Row: w.StartPos.Row + i, Col: w.StartPos.Col}
+		}
+	}
+	return cells
+}
+
+// CellWord links a board cell to a scored word covering it
+type CellWord struct {
+	Pos        Position
+	Word       string
+	Horizontal bool
+}
+
+// WordFrequency is how many players scored a word across a game's boards
+type WordFrequency struct {
+	Word    string
+	Count   int        // Number of boards the word scored on
+	Score   int        // Highest score the word earned on any board
+	Players []PlayerID // Players who scored the word, in scoring order
+}
+
+// Standing is a player's score so far and place in a game
+type Standing struct {
+	Rank  int // 1 is leading; tied players share a rank
+	Score BoardScore
+}
+
+// RankStandings orders scores highest first, keeping the given order among
+// ties, and ranks them so tied players share a place (1, 1, 3, ...)
+func RankStandings(scores []BoardScore) []Standing {
+	standings := make([]Standing, len(scores))
+	for i, s := range scores {
+		standings[i] = Standing{Score: s}
+	}
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		return b.Score.TotalScore - a.Score.TotalScore
+	})
+	for i := range standings {
+		if i > 0 && standings[i].Score.TotalScore == standings[i-1].Score.TotalScore {
+			standings[i].Rank = standings[i-1].Rank
+		} else {
+			standings[i].Rank = i + 1
+		}
+	}
+	return standings
 }
 
 // BoardScore is the complete scoring result for a board
 type BoardScore struct {
-	PlayerID   PlayerID
-	Words      []WordMatch
-	TotalScore int
+	PlayerID      PlayerID
+	Words         []WordMatch
+	TotalScore    int // Non-deduped word scores (or the Best word's alone) minus Penalty plus SymmetryBonus, PerfectBonus, StealBonus and UniqueBonus
+	IsolatedCells int // Letters not part of any scored word
+	Penalty       int // Points deducted for isolated cells
+	SymmetryBonus int // Points awarded for a board whose rows are all palindromes
+	PerfectBonus  int // Points awarded for a board whose every row and column is a word
+	StealBonus    int // Points awarded for the steal bonuses the player earned
+	UniqueWords   int // Distinct words no other player found
+	UniqueBonus   int // Points awarded for UniqueWords
+}
+
+// CellWords lists the cells of every word counted towards the score, so
+// the UI can highlight them. A cell where a row and a column word cross
+// appears once for each.
+func (s *BoardScore) CellWords() []CellWord {
+	bestOnly := false
+	for _, w := range s.Words {
+		bestOnly = bestOnly || w.Best
+	}
+
+	cells := []CellWord{}
+	for _, w := range s.Words {
+		if w.Deduped || (bestOnly && !w.Best) {
+			continue
+		}
+		for _, pos := range w.Cells() {
+			cells = append(cells, CellWord{Pos: pos, Word: w.Word, Horizontal: w.Horizontal})
+		}
+	}
+	return cells
+}
+
+// CandidateOutcome records what scoring decided about a word found in a line
+type CandidateOutcome string
+
+const (
+	CandidateScored      CandidateOutcome = "scored"       // Chosen for the line
+	CandidateSubsumed    CandidateOutcome = "subsumed"     // Overlaps a longer word already chosen for the line
+	CandidateNotAnchored CandidateOutcome = "not_anchored" // Doesn't touch the board edge when edge anchoring is required
+)
+
+// WordCandidate is a dictionary word found in a row or column, whether or
+// not it ended up being scored
+type WordCandidate struct {
+	Word       string
+	StartPos   Position
+	Horizontal bool
+	Cells      []Position // Cells the word covers, in reading order
+	Score      int        // What the word would score if chosen
+	Outcome    CandidateOutcome
+	SubsumedBy string // The chosen word it overlaps, when Subsumed
+}
+
+// ScoringStep is one adjustment to a board's running total
+type ScoringStep struct {
+	Description string
+	Points      int // Points added (negative for deductions)
+	Total       int // Running total after this step
+}
+
+// ScoreExplanation breaks down how a board's score was reached
+type ScoreExplanation struct {
+	Score      BoardScore
+	Candidates []WordCandidate // Every word found, in row then column order
+	Steps      []ScoringStep   // Ends at Score.TotalScore
 }
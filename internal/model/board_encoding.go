@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
+)
+
+// boardEncodingVersion is the first byte of a binary-encoded board. It can
+// never be '{', so binary boards are distinguishable from JSON ones.
+const boardEncodingVersion = 1
+
+// MarshalBinary encodes the board compactly: a version byte, a size byte,
+// the length-prefixed game and player IDs, then every cell in row-major
+// order as UTF-8, with empty cells as a single zero byte.
+func (b *Board) MarshalBinary() ([]byte, error) {
+	if b.Size < 0 || b.Size > 255 {
+		return nil, fmt.Errorf("%w: size %d does not fit in a byte", ErrInvalidBoardEncoding, b.Size)
+	}
+
+	buf := make([]byte, 0, 2+2*binary.MaxVarintLen64+len(b.GameID)+len(b.PlayerID)+b.Size*b.Size)
+	buf = append(buf, boardEncodingVersion, byte(b.Size))
+	buf = binary.AppendUvarint(buf, uint64(len(b.GameID)))
+	buf = append(buf, b.GameID...)
+	buf = binary.AppendUvarint(buf, uint64(len(b.PlayerID)))
+	buf = append(buf, b.PlayerID...)
+	for row := 0; row < b.Size; row++ {
+		for col := 0; col < b.Size; col++ {
+			buf = utf8.AppendRune(buf, b.Cells[row][col])
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a board written by MarshalBinary
+func (b *Board) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != boardEncodingVersion {
+		return ErrInvalidBoardEncoding
+	}
+	size := int(data[1])
+	data = data[2:]
+
+	gameID, data, err := readLengthPrefixed(data)
+	if err != nil {
+		return err
+	}
+	playerID, data, err := readLengthPrefixed(data)
+	if err != nil {
+		return err
+	}
+
+	decoded := NewBoard(GameID(gameID), PlayerID(playerID), size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			r, n := utf8.DecodeRune(data)
+			if n == 0 || r == utf8.RuneError {
+				return ErrInvalidBoardEncoding
+			}
+			decoded.Cells[row][col] = r
+			data = data[n:]
+		}
+	}
+	if len(data) != 0 {
+		return ErrInvalidBoardEncoding
+	}
+
+	*b = *decoded
+	return nil
+}
+
+// readLengthPrefixed splits a uvarint-prefixed string off the front of data
+func readLengthPrefixed(data []byte) (string, []byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return "", nil, ErrInvalidBoardEncoding
+	}
+	end := n + int(length)
+	return string(data[n:end]), data[end:], nil
+}
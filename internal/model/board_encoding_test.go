@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardBinaryRoundTrip(t *testing.T) {
+	board := NewBoard("GAME12345678", "player-1", 5)
+	board.Set(Position{Row: 0, Col: 0}, 'A')
+	board.Set(Position{Row: 2, Col: 3}, 'É')
+	board.Set(Position{Row: 4, Col: 4}, 'Z')
+
+	data, err := board.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Board
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, board, &decoded)
+	assert.True(t, decoded.IsEmpty(Position{Row: 1, Col: 1}))
+}
+
+func TestBoardBinaryRejectsBadData(t *testing.T) {
+	board := NewBoard("game-1", "player-1", 3)
+	data, err := board.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Board
+	assert.ErrorIs(t, decoded.UnmarshalBinary(nil), ErrInvalidBoardEncoding)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-1]), ErrInvalidBoardEncoding)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(append(data, 0)), ErrInvalidBoardEncoding)
+	assert.ErrorIs(t, decoded.UnmarshalBinary([]byte(`{"Size":3}`)), ErrInvalidBoardEncoding)
+
+	_, err = NewBoard("game-1", "player-1", 256).MarshalBinary()
+	assert.ErrorIs(t, err, ErrInvalidBoardEncoding)
+}
+
+// BenchmarkBoardEncoding compares the stored size of a full 7x7 board
+func BenchmarkBoardEncoding(b *testing.B) {
+	board := NewBoard("GAME12345678", "0b7c1e2a-5d4f-4c3b-9a8e-1f2d3c4b5a69", 7)
+	for row := 0; row < board.Size; row++ {
+		for col := 0; col < board.Size; col++ {
+			board.Cells[row][col] = rune('A' + (row*board.Size+col)%26)
+		}
+	}
+
+	b.Run("json", func(b *testing.B) {
+		var size int
+		for b.Loop() {
+			data, _ := json.Marshal(board)
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes/board")
+	})
+	b.Run("binary", func(b *testing.B) {
+		var size int
+		for b.Loop() {
+			data, _ := board.MarshalBinary()
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes/board")
+	})
+}
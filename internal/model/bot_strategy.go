@@ -3,6 +3,7 @@ package model
 // Bot strategy constants
 const (
 	BotStrategyRandom = "random"
+	BotStrategyGreedy = "greedy"
 )
 
 // BotStrategyDisplayName returns a human-readable label for a strategy
@@ -10,6 +11,8 @@ func BotStrategyDisplayName(strategy string) string {
 	switch strategy {
 	case BotStrategyRandom:
 		return "Random"
+	case BotStrategyGreedy:
+		return "Greedy"
 	default:
 		return strategy
 	}
@@ -17,5 +20,43 @@ func BotStrategyDisplayName(strategy string) string {
 
 // ValidBotStrategies returns all valid bot strategy names
 func ValidBotStrategies() []string {
-	return []string{BotStrategyRandom}
+	return []string{BotStrategyRandom, BotStrategyGreedy}
+}
+
+// BotDifficulty controls how strongly a bot plays
+type BotDifficulty string
+
+const (
+	BotDifficultyEasy BotDifficulty = "easy"
+	BotDifficultyHard BotDifficulty = "hard"
+)
+
+// ValidBotDifficulties returns all valid bot difficulties
+func ValidBotDifficulties() []BotDifficulty {
+	return []BotDifficulty{BotDifficultyEasy, BotDifficultyHard}
+}
+
+// IsValid returns true if the difficulty is a known value
+func (d BotDifficulty) IsValid() bool {
+	return d == BotDifficultyEasy || d == BotDifficultyHard
+}
+
+// Strategy returns the strategy a bot of this difficulty uses
+func (d BotDifficulty) Strategy() string {
+	if d == BotDifficultyHard {
+		return BotStrategyGreedy
+	}
+	return BotStrategyRandom
+}
+
+// DisplayName returns a human-readable label for the difficulty
+func (d BotDifficulty) DisplayName() string {
+	switch d {
+	case BotDifficultyEasy:
+		return "Easy"
+	case BotDifficultyHard:
+		return "Hard"
+	default:
+		return string(d)
+	}
 }
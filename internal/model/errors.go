@@ -1,11 +1,17 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Common errors used across the application
 var (
 	// Player errors
-	ErrPlayerNotFound = errors.New("player not found")
+	ErrPlayerNotFound        = errors.New("player not found")
+	ErrInvalidDisplayName    = errors.New("invalid display name")
+	ErrDisplayNameNotAllowed = errors.New("display name is not allowed")
+	ErrNotRegistered         = errors.New("player is not registered")
 
 	// Lobby errors
 	ErrLobbyNotFound       = errors.New("lobby not found")
@@ -13,27 +19,60 @@ var (
 	ErrAlreadyInLobby      = errors.New("player is already in lobby")
 	ErrNotInLobby          = errors.New("player is not in lobby")
 	ErrNotHost             = errors.New("player is not the host")
+	ErrHostPresent         = errors.New("host is still active")
 	ErrGameInProgress      = errors.New("game is in progress")
 	ErrNoGameInProgress    = errors.New("no game in progress")
 	ErrInsufficientPlayers = errors.New("insufficient players to start game")
+	ErrInsufficientHumans  = errors.New("not enough human players to start game")
+	ErrPlayersNotReady     = errors.New("not all players are ready")
+	ErrNoLobbyEvents       = errors.New("no events recorded for lobby")
+	ErrInvalidGridSize     = errors.New("invalid grid size")
+	ErrGridSizeNotAllowed  = fmt.Errorf("%w: size not allowed", ErrInvalidGridSize)
+	ErrInvalidLobbyConfig  = errors.New("invalid lobby config")
+	ErrServerAtCapacity    = errors.New("server is at capacity")
+	ErrLobbyOnCooldown     = errors.New("lobby is cooling down between games")
+	ErrInvalidLobbyExport  = errors.New("invalid lobby export")
+	ErrNotInSpectatorChat  = errors.New("only spectators and the host can use spectator chat")
+	ErrInvalidChatMessage  = errors.New("invalid chat message")
+	ErrLobbyCodeTaken      = errors.New("lobby code is already in use")
 
 	// Game errors
-	ErrGameNotFound       = errors.New("game not found")
-	ErrNotPlayerTurn      = errors.New("not this player's turn")
-	ErrInvalidLetter      = errors.New("invalid letter")
-	ErrLetterNotAnnounced = errors.New("no letter has been announced")
-	ErrAlreadyPlaced      = errors.New("player has already placed this turn")
-	ErrInvalidPosition    = errors.New("invalid board position")
-	ErrCellOccupied       = errors.New("cell is already occupied")
-	ErrGameComplete       = errors.New("game is already complete")
-	ErrGameAbandoned      = errors.New("game has been abandoned")
+	ErrGameNotFound         = errors.New("game not found")
+	ErrGameExists           = errors.New("a game with this ID already exists")
+	ErrInvalidGameID        = errors.New("invalid game ID")
+	ErrDuplicatePlayer      = errors.New("player appears more than once in game")
+	ErrNotPlayerTurn        = errors.New("not this player's turn")
+	ErrInvalidLetter        = errors.New("invalid letter")
+	ErrLetterNotInRack      = errors.New("letter is not in the announcer's rack")
+	ErrLetterNotAnnounced   = errors.New("no letter has been announced")
+	ErrAlreadyPlaced        = errors.New("player has already placed this turn")
+	ErrInvalidPosition      = errors.New("invalid board position")
+	ErrPositionNotAllowed   = errors.New("letter must be placed in this turn's cell")
+	ErrPlacementNotAdjacent = errors.New("letter must be placed next to an existing letter")
+	ErrCellOccupied         = errors.New("cell is already occupied")
+	ErrCellBlocked          = errors.New("cell is blocked")
+	ErrGameComplete         = errors.New("game is already complete")
+	ErrGameAbandoned        = errors.New("game has been abandoned")
+	ErrNoPendingPlacement   = errors.New("no pending placement to confirm")
+	ErrGameNotComplete      = errors.New("game is not complete")
+	ErrNotAllReady          = errors.New("not all players have acknowledged the game start")
+	ErrInvalidSpectateToken = errors.New("invalid or expired spectate token")
+	ErrAllRevealed          = errors.New("all scores have been revealed")
 
 	// Bot errors
-	ErrNotBot = errors.New("player is not a bot")
+	ErrNotBot             = errors.New("player is not a bot")
+	ErrTooManyBots        = errors.New("lobby has the maximum number of bots")
+	ErrUnknownBotStrategy = errors.New("unknown bot strategy")
+	ErrBotActionsStalled  = errors.New("bot actions did not finish within the expected number of steps")
 
 	// Board errors
-	ErrBoardNotFound = errors.New("board not found")
+	ErrBoardNotFound        = errors.New("board not found")
+	ErrInvalidBoardEncoding = errors.New("invalid binary board encoding")
 
 	// Dictionary errors
 	ErrDictionaryNotLoaded = errors.New("dictionary not loaded")
+	ErrDictionaryNotFound  = errors.New("dictionary not found")
+
+	// Hint errors
+	ErrHintLimitReached = errors.New("no placement hints left this turn")
 )
@@ -1,25 +1,38 @@
 package model
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxChatMessageLength is the maximum number of characters in a chat message
+const MaxChatMessageLength = 200
 
 // EventType identifies the type of event
 type EventType string
 
 const (
 	// Lobby events
+	EventLobbyCreated EventType = "lobby_created"
 	EventPlayerJoined EventType = "player_joined"
 	EventPlayerLeft   EventType = "player_left"
 	EventHostChanged  EventType = "host_changed"
 	EventRoleChanged  EventType = "role_changed"
 	EventGameStarted  EventType = "game_started"
 	EventGameEnded    EventType = "game_ended"
+	EventCodeChanged  EventType = "code_changed"
+	// EventSpectatorChat is only shown to spectators and the host
+	EventSpectatorChat EventType = "spectator_chat"
 
 	// Game events
-	EventLetterAnnounced EventType = "letter_announced"
-	EventLetterPlaced    EventType = "letter_placed"
-	EventTurnComplete    EventType = "turn_complete"
-	EventGameComplete    EventType = "game_complete"
-	EventGameAbandoned   EventType = "game_abandoned"
+	EventLetterAnnounced  EventType = "letter_announced"
+	EventLetterPlaced     EventType = "letter_placed"
+	EventTurnComplete     EventType = "turn_complete"
+	EventAnnouncerSkipped EventType = "announcer_skipped"
+	EventPlacementSkipped EventType = "placement_skipped"
+	EventGameComplete     EventType = "game_complete"
+	EventGameAbandoned    EventType = "game_abandoned"
 )
 
 // Event is the base structure for all events
@@ -32,6 +45,60 @@ type Event struct {
 	Payload   any      // Type-specific data
 }
 
+// UnmarshalJSON decodes an event, restoring its Payload to the payload type
+// recorded for its Type, so events read back from storage match new ones
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type plain Event
+	var raw struct {
+		plain
+		Payload json.RawMessage
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*e = Event(raw.plain)
+	e.Payload = nil
+	if len(raw.Payload) == 0 || string(raw.Payload) == "null" {
+		return nil
+	}
+	decode, ok := payloadDecoders[e.Type]
+	if !ok {
+		decode = decodePayload[any]
+	}
+	payload, err := decode(raw.Payload)
+	if err != nil {
+		return err
+	}
+	e.Payload = payload
+	return nil
+}
+
+// payloadDecoders decodes the payload of each event type to its struct
+var payloadDecoders = map[EventType]func(json.RawMessage) (any, error){
+	EventLobbyCreated:     decodePayload[PlayerJoinedPayload],
+	EventPlayerJoined:     decodePayload[PlayerJoinedPayload],
+	EventPlayerLeft:       decodePayload[PlayerLeftPayload],
+	EventHostChanged:      decodePayload[HostChangedPayload],
+	EventRoleChanged:      decodePayload[RoleChangedPayload],
+	EventCodeChanged:      decodePayload[CodeChangedPayload],
+	EventSpectatorChat:    decodePayload[SpectatorChatPayload],
+	EventGameStarted:      decodePayload[GameStartedPayload],
+	EventLetterAnnounced:  decodePayload[LetterAnnouncedPayload],
+	EventLetterPlaced:     decodePayload[LetterPlacedPayload],
+	EventTurnComplete:     decodePayload[TurnCompletePayload],
+	EventAnnouncerSkipped: decodePayload[AnnouncerSkippedPayload],
+	EventPlacementSkipped: decodePayload[PlacementSkippedPayload],
+	EventGameComplete:     decodePayload[GameCompletePayload],
+	EventGameAbandoned:    decodePayload[GameAbandonedPayload],
+}
+
+func decodePayload[T any](data json.RawMessage) (any, error) {
+	var payload T
+	err := json.Unmarshal(data, &payload)
+	return payload, err
+}
+
 // PlayerJoinedPayload contains data for player joined events
 type PlayerJoinedPayload struct {
 	Player Player
@@ -57,6 +124,28 @@ type RoleChangedPayload struct {
 	NewRole  LobbyMemberRole
 }
 
+// CodeChangedPayload contains data for lobby code changed events
+type CodeChangedPayload struct {
+	OldCode LobbyCode
+	NewCode LobbyCode
+}
+
+// SpectatorChatPayload contains data for spectator chat events
+type SpectatorChatPayload struct {
+	DisplayName string
+	Message     string
+}
+
+// ValidateChatMessage checks that a chat message is between 1 and
+// MaxChatMessageLength characters
+func ValidateChatMessage(message string) error {
+	n := utf8.RuneCountInString(message)
+	if n == 0 || n > MaxChatMessageLength {
+		return ErrInvalidChatMessage
+	}
+	return nil
+}
+
 // GameStartedPayload contains data for game started events
 type GameStartedPayload struct {
 	GameID   GameID
@@ -84,6 +173,19 @@ type TurnCompletePayload struct {
 	NextAnnouncerID PlayerID
 }
 
+// AnnouncerSkippedPayload contains data for announcer skipped events
+type AnnouncerSkippedPayload struct {
+	SkippedID       PlayerID
+	NextAnnouncerID PlayerID
+	TurnNumber      int
+}
+
+// PlacementSkippedPayload contains data for placement skipped events
+type PlacementSkippedPayload struct {
+	SkippedIDs []PlayerID
+	TurnNumber int
+}
+
 // GameCompletePayload contains data for game complete events
 type GameCompletePayload struct {
 	Scores []BoardScore
@@ -1,10 +1,32 @@
 package model
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // GameID uniquely identifies a game
 type GameID string
 
+// MaxGameIDLength is the longest game ID a caller may choose
+const MaxGameIDLength = 64
+
+// ValidateGameID checks a caller-chosen game ID is 1 to MaxGameIDLength
+// ASCII letters, digits, '-' or '_'. Dots are reserved for spectate tokens.
+func ValidateGameID(id GameID) error {
+	if len(id) == 0 || len(id) > MaxGameIDLength {
+		return ErrInvalidGameID
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return ErrInvalidGameID
+		}
+	}
+	return nil
+}
+
 // GameState represents the current phase of a game
 type GameState string
 
@@ -15,6 +37,37 @@ const (
 	GameStateAbandoned  GameState = "abandoned"  // Game was cancelled
 )
 
+// PlacementMode controls which cells players may place letters in
+type PlacementMode string
+
+const (
+	PlacementModeFree       PlacementMode = "free"       // Any empty cell (default)
+	PlacementModeSequential PlacementMode = "sequential" // One fixed cell per turn, filling the board in row-major order
+)
+
+// GameMode controls how each turn's letter is chosen
+type GameMode string
+
+const (
+	GameModeAnnouncer    GameMode = "announcer"    // Players take turns announcing (default)
+	GameModeSimultaneous GameMode = "simultaneous" // A random letter is drawn for everyone each turn, with no announcer
+)
+
+// AnnouncedLetter is a letter announced (or drawn) for a turn
+type AnnouncedLetter struct {
+	Turn   int // 0-indexed turn number
+	Letter rune
+}
+
+// StealBonus records a player being first in a turn to complete a row that
+// spells a word
+type StealBonus struct {
+	PlayerID PlayerID
+	Turn     int // 0-indexed turn number
+	Word     string
+	At       time.Time
+}
+
 // Game represents a single instance of the crossword game
 type Game struct {
 	ID        GameID
@@ -30,18 +83,127 @@ type Game struct {
 	AnnouncerIdx  int  // Index into Players for current announcer
 	CurrentLetter rune // The letter announced this turn (0 if awaiting)
 
+	// AnnouncedLetters lists every letter announced so far, in turn order
+	AnnouncedLetters []AnnouncedLetter
+	// HideLetterHistory keeps AnnouncedLetters out of the game state shown
+	// to clients. Stored inverted so the zero value shows the history
+	HideLetterHistory bool
+	// Seed, when non-zero, is the lobby's seed for this game's randomness
+	Seed int64
+
 	// Placement tracking for current turn
 	Placements map[PlayerID]bool // Which players have placed this turn
 
+	// Two-phase placement (when RequireConfirm is set)
+	RequireConfirm   bool
+	PendingPlacement map[PlayerID]Position // Staged positions awaiting confirmation
+
+	// Scoring rules
+	RequireEdgeAnchored bool // Only words touching the edge of the board score
+
+	// PlacementMode restricts where letters may be placed (empty means free)
+	PlacementMode PlacementMode
+
+	// Mode controls how letters are chosen (empty means announcer)
+	Mode GameMode
+
+	// AdjacentPlacement requires each letter after a board's first to touch
+	// a letter already on it
+	AdjacentPlacement bool
+
+	// Delayed reveal (when DelayedReveal is set, scores are withheld until revealed)
+	DelayedReveal  bool
+	ScoresRevealed bool
+	// RevealedPlayers lists the players whose scores have been revealed one
+	// at a time, in reveal order
+	RevealedPlayers []PlayerID
+
+	// SpectateToken grants read-only access to the game via a share link
+	// It is only honoured while the game is in progress.
+	SpectateToken string
+
+	// AnnounceDeadline is when the current announcer will be skipped if they
+	// haven't announced (zero when there is no announce timeout)
+	AnnounceDeadline time.Time
+
+	// StartAcks records the players who have acknowledged the game start,
+	// when the first announce waits for everyone (nil when it doesn't)
+	StartAcks map[PlayerID]bool
+	// StartAckDeadline is when the first announce may go ahead without
+	// every acknowledgement (zero waits for everyone)
+	StartAckDeadline time.Time
+
+	// RackSize limits announcers to a rack of this many letters, dealt at
+	// random and replaced as they are used (0 means any letter)
+	RackSize int
+	Racks    map[PlayerID][]rune
+
+	// BlockedCells can't be placed on by anyone; every board shares them
+	BlockedCells []Position
+
+	// Bag counts the letters left to draw in simultaneous mode. It holds one
+	// letter per turn, so it can't run out (nil in announcer mode)
+	Bag map[rune]int
+
+	// StealBonus awards a bonus to the first player each turn to complete
+	// a row spelling a word; StealBonuses lists those awarded so far
+	StealBonus   bool
+	StealBonuses []StealBonus
+
+	// BotControlled maps players who left mid-game to the bot strategy that
+	// plays out their remaining turns
+	BotControlled map[PlayerID]string
+
 	// Timing
-	TurnStartedAt time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	TurnStartedAt     time.Time
+	TurnDurations     []time.Duration // Duration of each completed turn
+	LetterAnnouncedAt time.Time       // When the current letter was announced
+	// Cumulative time each player took to place after the letter was announced
+	PlacementLatency map[PlayerID]time.Duration
+	// HintsUsed counts the placement hints each player has asked for during
+	// turn HintsTurn
+	HintsUsed map[PlayerID]int
+	HintsTurn int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RecordAnnouncement sets the current turn's letter and adds it to the
+// letter history
+func (g *Game) RecordAnnouncement(letter rune) {
+	g.CurrentLetter = letter
+	g.AnnouncedLetters = append(g.AnnouncedLetters, AnnouncedLetter{Turn: g.CurrentTurn, Letter: letter})
 }
 
-// TotalTurns returns the total number of turns in the game (grid cells)
+// BagLetters returns the distinct letters left in the bag, in alphabetical
+// order
+func (g *Game) BagLetters() []rune {
+	letters := make([]rune, 0, len(g.Bag))
+	for letter := range g.Bag {
+		letters = append(letters, letter)
+	}
+	slices.Sort(letters)
+	return letters
+}
+
+// BagSize returns the number of letters left in the bag
+func (g *Game) BagSize() int {
+	total := 0
+	for _, count := range g.Bag {
+		total += count
+	}
+	return total
+}
+
+// StealClaimed reports whether someone has already earned the given turn's
+// steal bonus
+func (g *Game) StealClaimed(turn int) bool {
+	return slices.ContainsFunc(g.StealBonuses, func(s StealBonus) bool { return s.Turn == turn })
+}
+
+// TotalTurns returns the total number of turns in the game (open grid cells)
 func (g *Game) TotalTurns() int {
-	return g.GridSize * g.GridSize
+	return g.GridSize*g.GridSize - len(g.BlockedCells)
 }
 
 // IsComplete returns true if all turns have been played
@@ -49,14 +211,106 @@ func (g *Game) IsComplete() bool {
 	return g.CurrentTurn >= g.TotalTurns()
 }
 
-// CurrentAnnouncer returns the PlayerID of the current announcer
+// IsSimultaneous returns true if letters are drawn for everyone rather
+// than announced
+func (g *Game) IsSimultaneous() bool {
+	return g.Mode == GameModeSimultaneous
+}
+
+// CurrentAnnouncer returns the PlayerID of the current announcer, or ""
+// in simultaneous mode
 func (g *Game) CurrentAnnouncer() PlayerID {
-	if len(g.Players) == 0 {
+	if len(g.Players) == 0 || g.IsSimultaneous() {
 		return ""
 	}
 	return g.Players[g.AnnouncerIdx]
 }
 
+// AnnounceTimedOut returns true if the game is waiting on an announcer whose
+// announce deadline has passed
+func (g *Game) AnnounceTimedOut(now time.Time) bool {
+	return g.State == GameStateAnnouncing && !g.AnnounceDeadline.IsZero() && !now.Before(g.AnnounceDeadline) &&
+		!g.AwaitingStartAcks(now)
+}
+
+// StartAcked returns true if every player has acknowledged the game start
+func (g *Game) StartAcked() bool {
+	return len(g.UnackedPlayers()) == 0
+}
+
+// UnackedPlayers returns the players yet to acknowledge the game start, in
+// seat order. It is empty for games that don't wait for acknowledgements.
+func (g *Game) UnackedPlayers() []PlayerID {
+	if g.StartAcks == nil {
+		return nil
+	}
+	var unacked []PlayerID
+	for _, pid := range g.Players {
+		if !g.StartAcks[pid] {
+			unacked = append(unacked, pid)
+		}
+	}
+	return unacked
+}
+
+// AwaitingStartAcks returns true if the first announce is held until more
+// players acknowledge the game start
+func (g *Game) AwaitingStartAcks(now time.Time) bool {
+	if g.StartAcks == nil || g.CurrentTurn > 0 || g.State != GameStateAnnouncing {
+		return false
+	}
+	if !g.StartAckDeadline.IsZero() && !now.Before(g.StartAckDeadline) {
+		return false
+	}
+	return !g.StartAcked()
+}
+
+// TurnObligations describes what a player can do in a game right now
+type TurnObligations struct {
+	IsMyTurn      bool // The player is the current announcer; never set in simultaneous games
+	CanAnnounce   bool // The player may announce a letter now; never set in simultaneous games
+	CanPlace      bool // A letter has been announced that the player has yet to place
+	HasPlaced     bool // The player has placed this turn's letter
+	CurrentLetter rune // 0 when no letter has been announced
+}
+
+// ObligationsFor returns what playerID can do in the game at now. Anyone not
+// playing in the game can do nothing.
+func (g *Game) ObligationsFor(playerID PlayerID, now time.Time) TurnObligations {
+	obligations := TurnObligations{CurrentLetter: g.CurrentLetter}
+	if !slices.Contains(g.Players, playerID) {
+		return obligations
+	}
+
+	obligations.HasPlaced = g.Placements[playerID]
+	obligations.CanPlace = g.State == GameStatePlacing && !obligations.HasPlaced
+	if g.IsSimultaneous() {
+		// Letters are drawn, so nobody has an announcing turn
+		return obligations
+	}
+
+	obligations.IsMyTurn = g.CurrentAnnouncer() == playerID
+	obligations.CanAnnounce = obligations.IsMyTurn && g.State == GameStateAnnouncing && !g.AwaitingStartAcks(now)
+	return obligations
+}
+
+// BotStrategyFor returns the strategy playing for a player who left the
+// game, or false if the player is still playing for themselves
+func (g *Game) BotStrategyFor(playerID PlayerID) (string, bool) {
+	strategy, ok := g.BotControlled[playerID]
+	return strategy, ok
+}
+
+// RackHas returns true if letter is in the player's rack
+func (g *Game) RackHas(playerID PlayerID, letter rune) bool {
+	for _, l := range g.Racks[playerID] {
+		if l == letter {
+			return true
+		}
+	}
+	return false
+}
+
 // AllPlayersPlaced returns true if all players have placed this turn
 func (g *Game) AllPlayersPlaced() bool {
 	for _, playerID := range g.Players {
@@ -67,10 +321,88 @@ func (g *Game) AllPlayersPlaced() bool {
 	return true
 }
 
+// TotalTurnTime returns the summed duration of all completed turns
+func (g *Game) TotalTurnTime() time.Duration {
+	var total time.Duration
+	for _, d := range g.TurnDurations {
+		total += d
+	}
+	return total
+}
+
+// AverageTurnTime returns the mean duration of completed turns (0 if none)
+func (g *Game) AverageTurnTime() time.Duration {
+	if len(g.TurnDurations) == 0 {
+		return 0
+	}
+	return g.TotalTurnTime() / time.Duration(len(g.TurnDurations))
+}
+
+// RequiredPosition returns the only cell players may place in this turn
+// ok is false if players may place anywhere, or the game is complete.
+func (g *Game) RequiredPosition() (pos Position, ok bool) {
+	if g.PlacementMode != PlacementModeSequential || g.IsComplete() || g.GridSize == 0 {
+		return Position{}, false
+	}
+	// Blocked cells are skipped, so the turn's cell is the CurrentTurn'th
+	// open cell in row-major order
+	open := 0
+	for i := 0; i < g.GridSize*g.GridSize; i++ {
+		pos := Position{Row: i / g.GridSize, Col: i % g.GridSize}
+		if slices.Contains(g.BlockedCells, pos) {
+			continue
+		}
+		if open == g.CurrentTurn {
+			return pos, true
+		}
+		open++
+	}
+	return Position{}, false
+}
+
+// AllowsAdjacency returns true if placing at pos on b satisfies
+// AdjacentPlacement. The first letter on a board may go anywhere.
+func (g *Game) AllowsAdjacency(b *Board, pos Position) bool {
+	return !g.AdjacentPlacement || !b.HasLetters() || b.TouchesLetter(pos)
+}
+
+// HasPendingPlacement returns true if the player has a staged, unconfirmed placement
+func (g *Game) HasPendingPlacement(playerID PlayerID) bool {
+	_, ok := g.PendingPlacement[playerID]
+	return ok
+}
+
+// ScoresHidden returns true if the game is over but its scores are being
+// withheld until the host reveals them
+func (g *Game) ScoresHidden() bool {
+	return g.State == GameStateScoring && g.DelayedReveal && !g.ScoresRevealed
+}
+
+// ScoreRevealed returns true if the player's score has been revealed on its own
+func (g *Game) ScoreRevealed(playerID PlayerID) bool {
+	return slices.Contains(g.RevealedPlayers, playerID)
+}
+
+// GameBundle gathers everything recorded about a completed game, for
+// archiving or sharing
+type GameBundle struct {
+	Game    *Game
+	Summary GameSummary
+	Boards  []*Board
+	Scores  []BoardScore
+	Moves   []*Event // The game's events, if still recorded
+}
+
 // GameSummary is a lightweight record of a completed game
 type GameSummary struct {
-	ID          GameID
-	FinalScores map[PlayerID]int
-	Winner      PlayerID // Empty if tie
-	CompletedAt time.Time
+	ID              GameID
+	FinalScores     map[PlayerID]int
+	Winner          PlayerID // Empty if tie (and not broken by a tie-break)
+	Tied            bool     // True if the top score was shared, even if a tie-break named a winner
+	Result          string   // Human-readable outcome, e.g. "Alice wins with 24 points; runner-up Bob 20"
+	Unscored        bool     // True if there was no dictionary to score against, leaving FinalScores empty
+	TotalTurnTime   time.Duration
+	AverageTurnTime time.Duration
+	StealBonuses    []StealBonus // In the order they were earned
+	CompletedAt     time.Time
 }
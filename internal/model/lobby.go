@@ -1,6 +1,12 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // LobbyCode is a human-readable identifier for joining lobbies
 type LobbyCode string
@@ -27,11 +33,65 @@ type LobbyMember struct {
 	Role     LobbyMemberRole
 	IsHost   bool
 	JoinedAt time.Time
+	// DisplayLabel distinguishes members sharing a display name, e.g. "Alice (2)"
+	// Empty means the player's display name is used as-is
+	DisplayLabel string
+	// Ready is set when the member has said they're ready for the next game
+	Ready bool
+}
+
+// IsReady reports whether the member is ready to start. Bots are always ready.
+func (m *LobbyMember) IsReady() bool {
+	return m.Ready || m.Player.IsBot
+}
+
+// Label returns the name to show for this member in the lobby
+func (m *LobbyMember) Label() string {
+	if m.DisplayLabel != "" {
+		return m.DisplayLabel
+	}
+	return m.Player.DisplayName
 }
 
 // LobbyConfig holds configurable settings for games in this lobby
 type LobbyConfig struct {
-	GridSize int // Default 5, configurable
+	GridSize            int  // Default 5, configurable
+	RequireConfirm      bool // Placements are staged and must be confirmed before they count
+	RequireEdgeAnchored bool // Only words touching the edge of the board score
+	DelayedReveal       bool // Scores stay hidden after the game until the host reveals them
+	MaxPlayers          int  // Players (not spectators) allowed; later joiners spectate. 0 is unlimited
+	AutoStart           bool // Start a game as soon as MaxPlayers players have joined
+	// PlacementMode restricts where letters may be placed (empty means free)
+	PlacementMode PlacementMode
+	// HideSpectatorBoards keeps boards from spectators until the game is scored.
+	// Stored inverted so the zero value keeps boards visible
+	HideSpectatorBoards bool
+	// RequireReady stops a game starting until every player has said they're ready
+	RequireReady bool
+	// Mode controls how each turn's letter is chosen (empty means announcer)
+	Mode GameMode
+	// MinHumanPlayers is how many non-bot players a game needs to start
+	// (0 is no minimum)
+	MinHumanPlayers int
+	// HideLetterHistory stops players seeing the letters announced so far.
+	// Stored inverted so the zero value shows the history
+	HideLetterHistory bool
+	// Seed makes the game's letter draws, bot choices and seat shuffles
+	// reproducible. 0 leaves them random
+	Seed int64
+	// LateJoinRole is the role new joiners get while the lobby is waiting.
+	// Spectator makes every joiner wait to be promoted (empty means player)
+	LateJoinRole LobbyMemberRole
+}
+
+// SpectatorsSeeBoards reports whether spectators may watch boards mid-game
+func (c LobbyConfig) SpectatorsSeeBoards() bool {
+	return !c.HideSpectatorBoards
+}
+
+// ShowsLetterHistory reports whether games show the letters announced so far
+func (c LobbyConfig) ShowsLetterHistory() bool {
+	return !c.HideLetterHistory
 }
 
 // DefaultLobbyConfig returns the default lobby configuration
@@ -41,6 +101,141 @@ func DefaultLobbyConfig() LobbyConfig {
 	}
 }
 
+// GridSizeBounds is the inclusive range of grid sizes a lobby may choose
+type GridSizeBounds struct {
+	Min int
+	Max int
+	// Allowed, when set, lists the only sizes a lobby may choose, in place
+	// of the Min-Max range
+	Allowed []int
+}
+
+// DefaultGridSizeBounds returns the default 2x2 to 7x7 range
+func DefaultGridSizeBounds() GridSizeBounds {
+	return GridSizeBounds{Min: 2, Max: 7}
+}
+
+// Clamp returns size limited to the bounds, or the nearest allowed size
+// (the smaller on a tie) when there is an allow-list
+func (b GridSizeBounds) Clamp(size int) int {
+	if len(b.Allowed) > 0 {
+		nearest := b.Allowed[0]
+		for _, allowed := range b.Allowed[1:] {
+			d, best := abs(allowed-size), abs(nearest-size)
+			if d < best || (d == best && allowed < nearest) {
+				nearest = allowed
+			}
+		}
+		return nearest
+	}
+	return max(b.Min, min(size, b.Max))
+}
+
+// Sizes lists the grid sizes a lobby may choose, in ascending order
+func (b GridSizeBounds) Sizes() []int {
+	if len(b.Allowed) > 0 {
+		sizes := slices.Clone(b.Allowed)
+		slices.Sort(sizes)
+		return slices.Compact(sizes)
+	}
+	var sizes []int
+	for size := b.Min; size <= b.Max; size++ {
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
+
+// check returns an error wrapping ErrInvalidGridSize if size isn't allowed
+func (b GridSizeBounds) check(size int) error {
+	if len(b.Allowed) > 0 {
+		if slices.Contains(b.Allowed, size) {
+			return nil
+		}
+		allowed := make([]string, 0, len(b.Allowed))
+		for _, s := range b.Sizes() {
+			allowed = append(allowed, strconv.Itoa(s))
+		}
+		return fmt.Errorf("%w: %d (must be one of %s)", ErrGridSizeNotAllowed, size, strings.Join(allowed, ", "))
+	}
+	if size < b.Min || size > b.Max {
+		return fmt.Errorf("%w: %d (must be between %d and %d)", ErrInvalidGridSize, size, b.Min, b.Max)
+	}
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ConfigFieldError is a problem with one setting of a LobbyConfig
+type ConfigFieldError struct {
+	Field string // Form and JSON name of the setting, e.g. "grid_size"
+	Err   error  // Wraps ErrInvalidGridSize or ErrInvalidLobbyConfig
+}
+
+func (e ConfigFieldError) Error() string { return e.Err.Error() }
+func (e ConfigFieldError) Unwrap() error { return e.Err }
+
+// Validate checks the config against the allowed grid size bounds
+// Returns the first of FieldErrors: for the grid size, an error wrapping
+// ErrInvalidGridSize that names the allowed range or sizes
+func (c LobbyConfig) Validate(bounds GridSizeBounds) error {
+	if errs := c.FieldErrors(bounds); len(errs) > 0 {
+		return errs[0].Err
+	}
+	return nil
+}
+
+// FieldErrors checks the config against the allowed grid size bounds and
+// returns every problem found, or nil if the config is valid
+func (c LobbyConfig) FieldErrors(bounds GridSizeBounds) []ConfigFieldError {
+	var errs []ConfigFieldError
+	invalid := func(field, format string, args ...any) {
+		errs = append(errs, ConfigFieldError{Field: field, Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidLobbyConfig}, args...)...)})
+	}
+
+	if err := bounds.check(c.GridSize); err != nil {
+		errs = append(errs, ConfigFieldError{Field: "grid_size", Err: err})
+	}
+	if c.MaxPlayers < 0 {
+		invalid("max_players", "max players must not be negative")
+	}
+	if c.MinHumanPlayers < 0 {
+		invalid("min_human_players", "min human players must not be negative")
+	}
+	if c.MaxPlayers > 0 && c.MinHumanPlayers > c.MaxPlayers {
+		invalid("min_human_players", "min human players can't exceed max players")
+	}
+	if c.AutoStart && c.MaxPlayers == 0 {
+		invalid("auto_start", "auto-start requires max players")
+	}
+	if c.AutoStart && c.RequireReady {
+		invalid("auto_start", "auto-start can't be combined with a ready check")
+	}
+	switch c.PlacementMode {
+	case "", PlacementModeFree, PlacementModeSequential:
+	default:
+		invalid("placement_mode", "unknown placement mode %q", c.PlacementMode)
+	}
+	switch c.Mode {
+	case "", GameModeAnnouncer, GameModeSimultaneous:
+	default:
+		invalid("mode", "unknown game mode %q", c.Mode)
+	}
+	if c.Seed < 0 {
+		invalid("seed", "seed must not be negative")
+	}
+	switch c.LateJoinRole {
+	case "", RolePlayer, RoleSpectator:
+	default:
+		invalid("late_join_role", "unknown late join role %q", c.LateJoinRole)
+	}
+	return errs
+}
+
 // Lobby represents a group of players who can play games together
 type Lobby struct {
 	Code        LobbyCode
@@ -51,6 +246,18 @@ type Lobby struct {
 	CurrentGame *GameID       // nil when State is waiting
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// LastGameCompletedAt is when the lobby's last game was completed (zero
+	// if none has been), for the cooldown between games
+	LastGameCompletedAt time.Time
+}
+
+// LobbyExport is a lobby with everything needed to recreate it on another
+// instance: its members' player records and its current game and boards
+type LobbyExport struct {
+	Lobby   *Lobby
+	Players []*Player
+	Game    *Game // nil when no game is in progress
+	Boards  []*Board
 }
 
 // GetHost returns the current host member, or nil if none
@@ -73,6 +280,37 @@ func (l *Lobby) GetMember(playerID PlayerID) *LobbyMember {
 	return nil
 }
 
+// MoveMemberToEnd moves the member with the given player ID to the end of
+// Members, keeping everyone else in order. Members order is seat order, so
+// the moved member announces last.
+func (l *Lobby) MoveMemberToEnd(playerID PlayerID) {
+	for i := range l.Members {
+		if l.Members[i].Player.ID == playerID {
+			member := l.Members[i]
+			l.Members = append(l.Members[:i], l.Members[i+1:]...)
+			l.Members = append(l.Members, member)
+			return
+		}
+	}
+}
+
+// UniqueLabel returns a label for the given display name that no other member
+// is already shown as, appending " (2)", " (3)", ... on collision
+func (l *Lobby) UniqueLabel(displayName string, except PlayerID) string {
+	taken := make(map[string]bool, len(l.Members))
+	for i := range l.Members {
+		if l.Members[i].Player.ID != except {
+			taken[l.Members[i].Label()] = true
+		}
+	}
+
+	label := displayName
+	for n := 2; taken[label]; n++ {
+		label = fmt.Sprintf("%s (%d)", displayName, n)
+	}
+	return label
+}
+
 // GetPlayers returns all members with the player role
 func (l *Lobby) GetPlayers() []LobbyMember {
 	var players []LobbyMember
@@ -84,6 +322,49 @@ func (l *Lobby) GetPlayers() []LobbyMember {
 	return players
 }
 
+// HumanPlayerCount returns the number of players (not spectators) who
+// aren't bots
+func (l *Lobby) HumanPlayerCount() int {
+	count := 0
+	for _, m := range l.GetPlayers() {
+		if !m.Player.IsBot {
+			count++
+		}
+	}
+	return count
+}
+
+// IsFull reports whether the lobby has reached its player cap
+func (l *Lobby) IsFull() bool {
+	return l.Config.MaxPlayers > 0 && len(l.GetPlayers()) >= l.Config.MaxPlayers
+}
+
+// AllPlayersReady reports whether every player (not spectator) is ready
+func (l *Lobby) AllPlayersReady() bool {
+	for i := range l.Members {
+		if l.Members[i].Role == RolePlayer && !l.Members[i].IsReady() {
+			return false
+		}
+	}
+	return true
+}
+
+// ResetReady clears every member's ready flag, ready for the next game
+func (l *Lobby) ResetReady() {
+	for i := range l.Members {
+		l.Members[i].Ready = false
+	}
+}
+
+// StartedByJoin reports whether playerID, having just joined, started the
+// current game by filling an auto-start lobby. Anyone joining a game already
+// in progress becomes a spectator, so a joiner who is a player in an in-game
+// lobby must have started it.
+func (l *Lobby) StartedByJoin(playerID PlayerID) bool {
+	member := l.GetMember(playerID)
+	return l.State == LobbyStateInGame && l.CurrentGame != nil && member != nil && member.Role == RolePlayer
+}
+
 // GetSpectators returns all members with the spectator role
 func (l *Lobby) GetSpectators() []LobbyMember {
 	var spectators []LobbyMember
@@ -94,3 +375,10 @@ func (l *Lobby) GetSpectators() []LobbyMember {
 	}
 	return spectators
 }
+
+// InSpectatorChat reports whether the player may read and write the
+// spectator chat: spectators, and the host, who moderates it
+func (l *Lobby) InSpectatorChat(playerID PlayerID) bool {
+	m := l.GetMember(playerID)
+	return m != nil && (m.Role == RoleSpectator || m.IsHost)
+}
@@ -1,18 +1,35 @@
 package model
 
-import "time"
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// MaxDisplayNameLength is the maximum number of characters in a display name
+const MaxDisplayNameLength = 20
 
 // PlayerID uniquely identifies a player across the system
 type PlayerID string
 
 // Player represents a game participant
 type Player struct {
-	ID          PlayerID
-	DisplayName string
-	IsGuest     bool   // true for unregistered players
-	IsBot       bool   // true for bot players
-	BotStrategy string // strategy name for bots (empty for non-bots)
-	CreatedAt   time.Time
+	ID            PlayerID
+	DisplayName   string
+	IsGuest       bool          // true for unregistered players
+	IsBot         bool          // true for bot players
+	BotStrategy   string        // strategy name for bots (empty for non-bots)
+	BotDifficulty BotDifficulty // difficulty for bots (empty for non-bots)
+	CreatedAt     time.Time
+}
+
+// ValidateDisplayName checks that a display name is between 1 and
+// MaxDisplayNameLength characters
+func ValidateDisplayName(name string) error {
+	n := utf8.RuneCountInString(name)
+	if n == 0 || n > MaxDisplayNameLength {
+		return ErrInvalidDisplayName
+	}
+	return nil
 }
 
 // RegisteredPlayer extends Player with authentication data
@@ -21,6 +38,53 @@ type RegisteredPlayer struct {
 	PlayerID     PlayerID
 	Username     string // login username (immutable)
 	PasswordHash string // bcrypt hash
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// RecoveryCodeHash is the bcrypt hash of the one-time password recovery
+	// code, empty if none is outstanding
+	RecoveryCodeHash string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PlayerStats is the running record of a registered player's completed games
+type PlayerStats struct {
+	PlayerID    PlayerID
+	GamesPlayed int
+	GamesWon    int
+	TotalScore  int // Sum of final scores across all games played
+	// BestWord is the highest-scoring word the player has made, empty if none
+	BestWord      string
+	BestWordScore int
+}
+
+// AverageScore returns the mean final score per game played (0 if none)
+func (s *PlayerStats) AverageScore() float64 {
+	if s.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(s.TotalScore) / float64(s.GamesPlayed)
+}
+
+// PlayerGameResult is one player's outcome from a completed game, as
+// recorded into their PlayerStats
+type PlayerGameResult struct {
+	Score         int
+	Won           bool
+	BestWord      string
+	BestWordScore int
+}
+
+// PlayerGameResultFromScore builds a player's result from their board score
+// The best word is the highest-scoring counted word, earliest first on ties.
+func PlayerGameResultFromScore(score BoardScore, won bool) PlayerGameResult {
+	result := PlayerGameResult{Score: score.TotalScore, Won: won}
+	for _, w := range score.Words {
+		if w.Deduped {
+			continue
+		}
+		if w.Score > result.BestWordScore {
+			result.BestWord = w.Word
+			result.BestWordScore = w.Score
+		}
+	}
+	return result
 }
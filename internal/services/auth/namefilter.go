@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// NameFilter rejects display names containing blocked words
+// Matching is case-insensitive and finds blocked words inside longer names,
+// except where they fall within an allowed word (e.g. a blocked "ass" inside
+// an allowed "class").
+type NameFilter struct {
+	blocked []string
+	allowed []string
+}
+
+// NewNameFilter creates a filter from blocked and allowed words
+func NewNameFilter(blocked, allowed []string) *NameFilter {
+	return &NameFilter{
+		blocked: normalizeNameList(blocked),
+		allowed: normalizeNameList(allowed),
+	}
+}
+
+// LoadNameFilter reads a blocklist file with one word per line
+// Lines starting with '!' are allowlist entries, and blank lines and lines
+// starting with '#' are ignored.
+func LoadNameFilter(path string) (*NameFilter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var blocked, allowed []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "!"):
+			allowed = append(allowed, line[1:])
+		default:
+			blocked = append(blocked, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewNameFilter(blocked, allowed), nil
+}
+
+// Allows reports whether the name contains no blocked words
+// A nil filter allows every name.
+func (f *NameFilter) Allows(name string) bool {
+	if f == nil || len(f.blocked) == 0 {
+		return true
+	}
+
+	// Blank out allowed words so blocked words inside them don't match
+	name = strings.ToLower(name)
+	for _, word := range f.allowed {
+		name = strings.ReplaceAll(name, word, strings.Repeat(" ", len(word)))
+	}
+
+	for _, word := range f.blocked {
+		if strings.Contains(name, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeNameList lowercases words and drops empty entries
+func normalizeNameList(words []string) []string {
+	result := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			result = append(result, word)
+		}
+	}
+	return result
+}
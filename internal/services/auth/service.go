@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base32"
 	"encoding/base64"
 	"errors"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +23,8 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrInvalidSession     = errors.New("invalid or expired session")
 	ErrUsernameExists     = errors.New("username already exists")
+	ErrInvalidRecovery    = errors.New("invalid username or recovery code")
+	ErrTooManyAttempts    = errors.New("too many recovery attempts")
 )
 
 // Session represents an authenticated session
@@ -41,18 +45,41 @@ type Service struct {
 	mu       sync.RWMutex
 	sessions map[string]*Session
 
+	// Failed recovery attempts per username, for rate limiting
+	recoveryMu       sync.Mutex
+	recoveryAttempts map[string]*recoveryAttempts
+
 	sessionDuration time.Duration
+	cfg             Config
+}
+
+// recoveryAttempts counts failed recovery attempts within a window
+type recoveryAttempts struct {
+	count       int
+	windowStart time.Time
 }
 
 // Config holds configuration for the auth service
 type Config struct {
 	SessionDuration time.Duration
+	// RecoveryMaxAttempts is the number of failed password recovery attempts
+	// allowed per username within RecoveryAttemptWindow
+	RecoveryMaxAttempts   int
+	RecoveryAttemptWindow time.Duration
+	// NameFilter rejects display names containing blocked words
+	// If nil, any valid display name is accepted
+	NameFilter *NameFilter
+	// ClockSkew is how long past expiry a session is still accepted, to
+	// tolerate unsynced clocks across instances. Zero means no tolerance
+	ClockSkew time.Duration
 }
 
 // DefaultConfig returns default auth configuration
 func DefaultConfig() Config {
 	return Config{
-		SessionDuration: 24 * time.Hour,
+		SessionDuration:       24 * time.Hour,
+		RecoveryMaxAttempts:   5,
+		RecoveryAttemptWindow: 15 * time.Minute,
 	}
 }
 
@@ -61,17 +88,29 @@ func New(storage storage.Storage, clock clock.Clock, cfg Config, logger *slog.Lo
 	if cfg.SessionDuration == 0 {
 		cfg.SessionDuration = DefaultConfig().SessionDuration
 	}
+	if cfg.RecoveryMaxAttempts == 0 {
+		cfg.RecoveryMaxAttempts = DefaultConfig().RecoveryMaxAttempts
+	}
+	if cfg.RecoveryAttemptWindow == 0 {
+		cfg.RecoveryAttemptWindow = DefaultConfig().RecoveryAttemptWindow
+	}
 	return &Service{
-		storage:         storage,
-		clock:           clock,
-		logger:          logger,
-		sessions:        make(map[string]*Session),
-		sessionDuration: cfg.SessionDuration,
+		storage:          storage,
+		clock:            clock,
+		logger:           logger,
+		sessions:         make(map[string]*Session),
+		recoveryAttempts: make(map[string]*recoveryAttempts),
+		sessionDuration:  cfg.SessionDuration,
+		cfg:              cfg,
 	}
 }
 
 // CreateGuestPlayer creates an anonymous player and session
 func (s *Service) CreateGuestPlayer(ctx context.Context, displayName string) (*Session, error) {
+	if err := s.checkDisplayName(displayName); err != nil {
+		return nil, err
+	}
+
 	playerID := model.PlayerID(s.generateID("p_"))
 	now := s.clock.Now()
 
@@ -83,35 +122,47 @@ func (s *Service) CreateGuestPlayer(ctx context.Context, displayName string) (*S
 	}
 
 	if err := s.storage.SavePlayer(ctx, player); err != nil {
-		s.logger.Error("failed to save guest player",
+		s.logger.ErrorContext(ctx, "failed to save guest player",
 			slog.String("player_id", string(playerID)),
 			slog.String("error", err.Error()),
 		)
 		return nil, err
 	}
 
-	s.logger.Info("guest player created",
+	s.logger.InfoContext(ctx, "guest player created",
 		slog.String("player_id", string(playerID)),
 	)
 
 	return s.createSession(player)
 }
 
-// RegisterPlayer creates a registered player account and session
-func (s *Service) RegisterPlayer(ctx context.Context, username, password, displayName string) (*Session, error) {
+// RegisterPlayer creates a registered player account and session, returning
+// the account's one-time password recovery code alongside the session.
+// Saving the account is the last step that can fail, so an error never
+// leaves behind an account the caller doesn't know about.
+func (s *Service) RegisterPlayer(ctx context.Context, username, password, displayName string) (*Session, string, error) {
+	if err := s.checkDisplayName(displayName); err != nil {
+		return nil, "", err
+	}
+
 	// Check if username exists
 	_, err := s.storage.GetRegisteredPlayerByUsername(ctx, username)
 	if err == nil {
-		return nil, ErrUsernameExists
+		return nil, "", ErrUsernameExists
 	}
 	if !errors.Is(err, model.ErrPlayerNotFound) {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Hash password
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	recoveryCode, recoveryHash, err := newRecoveryCode()
+	if err != nil {
+		return nil, "", err
 	}
 
 	playerID := model.PlayerID(s.generateID("p_"))
@@ -125,34 +176,39 @@ func (s *Service) RegisterPlayer(ctx context.Context, username, password, displa
 	}
 
 	registeredPlayer := &model.RegisteredPlayer{
-		PlayerID:     playerID,
-		Username:     username,
-		PasswordHash: string(hash),
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		PlayerID:         playerID,
+		Username:         username,
+		PasswordHash:     string(hash),
+		RecoveryCodeHash: recoveryHash,
+		CreatedAt:        now,
+		UpdatedAt:        now,
 	}
 
 	if err := s.storage.SavePlayer(ctx, player); err != nil {
-		s.logger.Error("failed to save player during registration",
+		s.logger.ErrorContext(ctx, "failed to save player during registration",
 			slog.String("player_id", string(playerID)),
 			slog.String("error", err.Error()),
 		)
-		return nil, err
+		return nil, "", err
 	}
 
 	if err := s.storage.SaveRegisteredPlayer(ctx, registeredPlayer); err != nil {
-		s.logger.Error("failed to save registered player",
+		s.logger.ErrorContext(ctx, "failed to save registered player",
 			slog.String("player_id", string(playerID)),
 			slog.String("error", err.Error()),
 		)
-		return nil, err
+		return nil, "", err
 	}
 
-	s.logger.Info("player registered",
+	s.logger.InfoContext(ctx, "player registered",
 		slog.String("player_id", string(playerID)),
 	)
 
-	return s.createSession(player)
+	session, err := s.createSession(player)
+	if err != nil {
+		return nil, "", err
+	}
+	return session, recoveryCode, nil
 }
 
 // Login authenticates a registered player and creates a session
@@ -160,7 +216,7 @@ func (s *Service) Login(ctx context.Context, username, password string) (*Sessio
 	rp, err := s.storage.GetRegisteredPlayerByUsername(ctx, username)
 	if err != nil {
 		if errors.Is(err, model.ErrPlayerNotFound) {
-			s.logger.Warn("login failed: user not found",
+			s.logger.WarnContext(ctx, "login failed: user not found",
 				slog.String("username", username),
 			)
 			return nil, ErrInvalidCredentials
@@ -169,7 +225,7 @@ func (s *Service) Login(ctx context.Context, username, password string) (*Sessio
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(rp.PasswordHash), []byte(password)); err != nil {
-		s.logger.Warn("login failed: invalid password",
+		s.logger.WarnContext(ctx, "login failed: invalid password",
 			slog.String("username", username),
 		)
 		return nil, ErrInvalidCredentials
@@ -180,13 +236,290 @@ func (s *Service) Login(ctx context.Context, username, password string) (*Sessio
 		return nil, err
 	}
 
-	s.logger.Info("login successful",
+	s.logger.InfoContext(ctx, "login successful",
 		slog.String("player_id", string(player.ID)),
 	)
 
 	return s.createSession(player)
 }
 
+// UpdateDisplayName renames a player and refreshes their active sessions
+func (s *Service) UpdateDisplayName(ctx context.Context, playerID model.PlayerID, displayName string) (*model.Player, error) {
+	if err := model.ValidateDisplayName(displayName); err != nil {
+		return nil, err
+	}
+	if err := s.checkDisplayName(displayName); err != nil {
+		return nil, err
+	}
+
+	player, err := s.storage.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	player.DisplayName = displayName
+	if err := s.storage.SavePlayer(ctx, player); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save renamed player",
+			slog.String("player_id", string(playerID)),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	// Replace rather than mutate sessions, since callers may hold references
+	s.mu.Lock()
+	for token, session := range s.sessions {
+		if session.PlayerID == playerID {
+			updated := *session
+			updated.Player.DisplayName = displayName
+			s.sessions[token] = &updated
+		}
+	}
+	s.mu.Unlock()
+
+	s.logger.InfoContext(ctx, "player renamed",
+		slog.String("player_id", string(playerID)),
+	)
+
+	return player, nil
+}
+
+// checkDisplayName returns ErrDisplayNameNotAllowed if the name is blocked
+func (s *Service) checkDisplayName(displayName string) error {
+	if !s.cfg.NameFilter.Allows(displayName) {
+		s.logger.Warn("blocked display name rejected")
+		return model.ErrDisplayNameNotAllowed
+	}
+	return nil
+}
+
+// GenerateRecoveryCode creates a new one-time password recovery code for a
+// registered player, replacing any previous code. Only a hash is stored, so
+// the returned code must be shown to the player now or it is lost.
+func (s *Service) GenerateRecoveryCode(ctx context.Context, playerID model.PlayerID) (string, error) {
+	rp, err := s.storage.GetRegisteredPlayer(ctx, playerID)
+	if err != nil {
+		return "", err
+	}
+
+	code, hash, err := newRecoveryCode()
+	if err != nil {
+		return "", err
+	}
+
+	rp.RecoveryCodeHash = hash
+	rp.UpdatedAt = s.clock.Now()
+	if err := s.storage.SaveRegisteredPlayer(ctx, rp); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save recovery code",
+			slog.String("player_id", string(playerID)),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+
+	return code, nil
+}
+
+// ResetPasswordWithCode sets a new password for a registered player who
+// presents their recovery code. The code is consumed and the player's
+// existing sessions are ended. Returns ErrInvalidRecovery for an unknown
+// username or wrong code, and ErrTooManyAttempts once the username has
+// failed too often within the attempt window.
+func (s *Service) ResetPasswordWithCode(ctx context.Context, username, code, newPassword string) error {
+	if err := s.checkRecoveryAttempts(username); err != nil {
+		return err
+	}
+
+	rp, err := s.storage.GetRegisteredPlayerByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, model.ErrPlayerNotFound) {
+			s.recordFailedRecovery(username)
+			return ErrInvalidRecovery
+		}
+		return err
+	}
+
+	if rp.RecoveryCodeHash == "" ||
+		bcrypt.CompareHashAndPassword([]byte(rp.RecoveryCodeHash), []byte(normalizeRecoveryCode(code))) != nil {
+		s.recordFailedRecovery(username)
+		s.logger.WarnContext(ctx, "password recovery failed: invalid code",
+			slog.String("username", username),
+		)
+		return ErrInvalidRecovery
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	rp.PasswordHash = string(hash)
+	rp.RecoveryCodeHash = ""
+	rp.UpdatedAt = s.clock.Now()
+	if err := s.storage.SaveRegisteredPlayer(ctx, rp); err != nil {
+		return err
+	}
+
+	s.recoveryMu.Lock()
+	delete(s.recoveryAttempts, username)
+	s.recoveryMu.Unlock()
+
+	// Anyone holding a session under the old password is signed out
+	s.mu.Lock()
+	for token, session := range s.sessions {
+		if session.PlayerID == rp.PlayerID {
+			delete(s.sessions, token)
+		}
+	}
+	s.mu.Unlock()
+
+	s.logger.InfoContext(ctx, "password reset with recovery code",
+		slog.String("player_id", string(rp.PlayerID)),
+	)
+
+	return nil
+}
+
+// CheckDeletePassword checks the password DeleteAccount will need, so
+// callers can confirm it before tidying up after the player. Returns
+// ErrInvalidCredentials if it is wrong; guests need no password.
+func (s *Service) CheckDeletePassword(ctx context.Context, playerID model.PlayerID, password string) error {
+	_, err := s.checkDeletePassword(ctx, playerID, password)
+	return err
+}
+
+// DeleteAccount permanently removes a player and ends all their sessions.
+// Registered players must confirm with their password, returning
+// ErrInvalidCredentials if it is wrong; guests need no confirmation.
+// The username becomes free to register again.
+func (s *Service) DeleteAccount(ctx context.Context, playerID model.PlayerID, password string) error {
+	rp, err := s.checkDeletePassword(ctx, playerID, password)
+	if err != nil {
+		return err
+	}
+
+	if rp != nil {
+		if err := s.storage.DeleteRegisteredPlayer(ctx, playerID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.storage.DeletePlayer(ctx, playerID); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	for token, session := range s.sessions {
+		if session.PlayerID == playerID {
+			delete(s.sessions, token)
+		}
+	}
+	s.mu.Unlock()
+
+	s.logger.InfoContext(ctx, "account deleted",
+		slog.String("player_id", string(playerID)),
+		slog.Bool("registered", rp != nil),
+	)
+
+	return nil
+}
+
+// checkDeletePassword returns the player's registration, or nil for a guest,
+// once password is confirmed to be theirs
+func (s *Service) checkDeletePassword(ctx context.Context, playerID model.PlayerID, password string) (*model.RegisteredPlayer, error) {
+	rp, err := s.storage.GetRegisteredPlayer(ctx, playerID)
+	if errors.Is(err, model.ErrPlayerNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(rp.PasswordHash), []byte(password)); err != nil {
+		s.logger.WarnContext(ctx, "account deletion failed: invalid password",
+			slog.String("player_id", string(playerID)),
+		)
+		return nil, ErrInvalidCredentials
+	}
+	return rp, nil
+}
+
+// GetPlayerStats returns a registered player's stats
+// Returns ErrNotRegistered for guests, who have no stats.
+func (s *Service) GetPlayerStats(ctx context.Context, playerID model.PlayerID) (*model.PlayerStats, error) {
+	if _, err := s.storage.GetRegisteredPlayer(ctx, playerID); err != nil {
+		if errors.Is(err, model.ErrPlayerNotFound) {
+			return nil, model.ErrNotRegistered
+		}
+		return nil, err
+	}
+	return s.storage.GetPlayerStats(ctx, playerID)
+}
+
+// checkRecoveryAttempts returns ErrTooManyAttempts if the username has used
+// up its failed attempts for the current window
+func (s *Service) checkRecoveryAttempts(username string) error {
+	s.recoveryMu.Lock()
+	defer s.recoveryMu.Unlock()
+
+	attempts, ok := s.recoveryAttempts[username]
+	if !ok {
+		return nil
+	}
+	if s.clock.Now().Sub(attempts.windowStart) >= s.cfg.RecoveryAttemptWindow {
+		delete(s.recoveryAttempts, username)
+		return nil
+	}
+	if attempts.count >= s.cfg.RecoveryMaxAttempts {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+// recordFailedRecovery counts a failed recovery attempt for a username
+// Windows that have ended are dropped first, so usernames that are never
+// tried again don't accumulate
+func (s *Service) recordFailedRecovery(username string) {
+	s.recoveryMu.Lock()
+	defer s.recoveryMu.Unlock()
+
+	now := s.clock.Now()
+	for name, attempts := range s.recoveryAttempts {
+		if now.Sub(attempts.windowStart) >= s.cfg.RecoveryAttemptWindow {
+			delete(s.recoveryAttempts, name)
+		}
+	}
+
+	attempts, ok := s.recoveryAttempts[username]
+	if !ok {
+		attempts = &recoveryAttempts{windowStart: now}
+		s.recoveryAttempts[username] = attempts
+	}
+	attempts.count++
+}
+
+// newRecoveryCode generates a recovery code and the hash to store for it
+func newRecoveryCode() (code, hash string, err error) {
+	b := make([]byte, 10)
+	_, _ = rand.Read(b)
+	raw := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+	code = raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16]
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(normalizeRecoveryCode(code)), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+	return code, string(hashed), nil
+}
+
+// normalizeRecoveryCode makes codes comparable regardless of case and the
+// separators the player typed
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
 // ValidateSession checks if a session token is valid and returns the session
 func (s *Service) ValidateSession(token string) (*Session, error) {
 	s.mu.RLock()
@@ -197,7 +530,7 @@ func (s *Service) ValidateSession(token string) (*Session, error) {
 		return nil, ErrInvalidSession
 	}
 
-	if s.clock.Now().After(session.ExpiresAt) {
+	if s.isExpired(session, s.clock.Now()) {
 		s.mu.Lock()
 		delete(s.sessions, token)
 		s.mu.Unlock()
@@ -257,8 +590,13 @@ func (s *Service) CleanExpiredSessions() {
 	defer s.mu.Unlock()
 
 	for token, session := range s.sessions {
-		if now.After(session.ExpiresAt) {
+		if s.isExpired(session, now) {
 			delete(s.sessions, token)
 		}
 	}
 }
+
+// isExpired reports whether a session has expired, allowing for clock skew
+func (s *Service) isExpired(session *Session, now time.Time) bool {
+	return now.After(session.ExpiresAt.Add(s.cfg.ClockSkew))
+}
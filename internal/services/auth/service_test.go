@@ -2,12 +2,16 @@ package auth
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/mocks"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
 	"github.com/mcoot/crosswordgame-go2/internal/testutil"
 )
@@ -62,7 +66,7 @@ func (s *ServiceSuite) TestCreateGuestPlayerSessionIsValid() {
 // RegisterPlayer tests
 
 func (s *ServiceSuite) TestRegisterPlayerSucceeds() {
-	session, err := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	session, _, err := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
 	s.Require().NoError(err)
 
 	s.NotEmpty(session.Token)
@@ -71,7 +75,7 @@ func (s *ServiceSuite) TestRegisterPlayerSucceeds() {
 }
 
 func (s *ServiceSuite) TestRegisterPlayerPersistsRegistration() {
-	_, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	_, _, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
 
 	rp, err := s.storage.GetRegisteredPlayerByUsername(s.ctx, "alice")
 	s.Require().NoError(err)
@@ -81,16 +85,16 @@ func (s *ServiceSuite) TestRegisterPlayerPersistsRegistration() {
 }
 
 func (s *ServiceSuite) TestRegisterPlayerFailsIfUsernameExists() {
-	_, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	_, _, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
 
-	_, err := s.service.RegisterPlayer(s.ctx, "alice", "different", "Alice2")
+	_, _, err := s.service.RegisterPlayer(s.ctx, "alice", "different", "Alice2")
 	s.ErrorIs(err, ErrUsernameExists)
 }
 
 // Login tests
 
 func (s *ServiceSuite) TestLoginSucceeds() {
-	_, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	_, _, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
 
 	session, err := s.service.Login(s.ctx, "alice", "password123")
 	s.Require().NoError(err)
@@ -100,7 +104,7 @@ func (s *ServiceSuite) TestLoginSucceeds() {
 }
 
 func (s *ServiceSuite) TestLoginFailsWithWrongPassword() {
-	_, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	_, _, _ = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
 
 	_, err := s.service.Login(s.ctx, "alice", "wrongpassword")
 	s.ErrorIs(err, ErrInvalidCredentials)
@@ -111,6 +115,98 @@ func (s *ServiceSuite) TestLoginFailsWithUnknownUser() {
 	s.ErrorIs(err, ErrInvalidCredentials)
 }
 
+// Password recovery tests
+
+func (s *ServiceSuite) TestResetPasswordWithCodeSucceeds() {
+	session, _, _ := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	code, err := s.service.GenerateRecoveryCode(s.ctx, session.PlayerID)
+	s.Require().NoError(err)
+
+	rp, _ := s.storage.GetRegisteredPlayerByUsername(s.ctx, "alice")
+	s.NotEmpty(rp.RecoveryCodeHash)
+	s.NotContains(rp.RecoveryCodeHash, code) // Should be hashed
+
+	// Codes are accepted regardless of case and separators
+	err = s.service.ResetPasswordWithCode(s.ctx, "alice", strings.ToLower(strings.ReplaceAll(code, "-", "")), "newpassword")
+	s.Require().NoError(err)
+
+	_, err = s.service.Login(s.ctx, "alice", "password123")
+	s.ErrorIs(err, ErrInvalidCredentials)
+	_, err = s.service.Login(s.ctx, "alice", "newpassword")
+	s.NoError(err)
+
+	// Existing sessions are ended
+	_, err = s.service.ValidateSession(session.Token)
+	s.ErrorIs(err, ErrInvalidSession)
+
+	// The code is single-use
+	err = s.service.ResetPasswordWithCode(s.ctx, "alice", code, "another")
+	s.ErrorIs(err, ErrInvalidRecovery)
+}
+
+func (s *ServiceSuite) TestResetPasswordWithCodeRejectsWrongCode() {
+	session, _, _ := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	_, _ = s.service.GenerateRecoveryCode(s.ctx, session.PlayerID)
+
+	err := s.service.ResetPasswordWithCode(s.ctx, "alice", "AAAA-BBBB-CCCC-DDDD", "newpassword")
+	s.ErrorIs(err, ErrInvalidRecovery)
+
+	_, err = s.service.Login(s.ctx, "alice", "password123")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestResetPasswordWithCodeRejectsUnknownUser() {
+	err := s.service.ResetPasswordWithCode(s.ctx, "nobody", "AAAA-BBBB-CCCC-DDDD", "newpassword")
+	s.ErrorIs(err, ErrInvalidRecovery)
+}
+
+func (s *ServiceSuite) TestResetPasswordWithCodeRateLimitsAttempts() {
+	session, _, _ := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	code, _ := s.service.GenerateRecoveryCode(s.ctx, session.PlayerID)
+
+	for range DefaultConfig().RecoveryMaxAttempts {
+		err := s.service.ResetPasswordWithCode(s.ctx, "alice", "AAAA-BBBB-CCCC-DDDD", "newpassword")
+		s.ErrorIs(err, ErrInvalidRecovery)
+	}
+
+	// Even the right code is refused until the window passes
+	err := s.service.ResetPasswordWithCode(s.ctx, "alice", code, "newpassword")
+	s.ErrorIs(err, ErrTooManyAttempts)
+
+	s.clock.Advance(DefaultConfig().RecoveryAttemptWindow)
+	err = s.service.ResetPasswordWithCode(s.ctx, "alice", code, "newpassword")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestResetPasswordWithCodeExpiresOldAttempts() {
+	_ = s.service.ResetPasswordWithCode(s.ctx, "nobody", "AAAA-BBBB-CCCC-DDDD", "newpassword")
+	s.Len(s.service.recoveryAttempts, 1)
+
+	// A later failure for another username drops the finished window
+	s.clock.Advance(DefaultConfig().RecoveryAttemptWindow)
+	_ = s.service.ResetPasswordWithCode(s.ctx, "someone", "AAAA-BBBB-CCCC-DDDD", "newpassword")
+	s.Len(s.service.recoveryAttempts, 1)
+	s.Contains(s.service.recoveryAttempts, "someone")
+}
+
+func (s *ServiceSuite) TestRegisterPlayerReturnsRecoveryCode() {
+	_, code, err := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	s.Require().NoError(err)
+	s.NotEmpty(code)
+
+	err = s.service.ResetPasswordWithCode(s.ctx, "alice", code, "newpassword")
+	s.Require().NoError(err)
+	_, err = s.service.Login(s.ctx, "alice", "newpassword")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestGenerateRecoveryCodeFailsForGuest() {
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	_, err := s.service.GenerateRecoveryCode(s.ctx, session.PlayerID)
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+}
+
 // ValidateSession tests
 
 func (s *ServiceSuite) TestValidateSessionSucceeds() {
@@ -136,6 +232,24 @@ func (s *ServiceSuite) TestValidateSessionFailsWhenExpired() {
 	s.ErrorIs(err, ErrInvalidSession)
 }
 
+func (s *ServiceSuite) TestValidateSessionToleratesClockSkew() {
+	cfg := DefaultConfig()
+	cfg.ClockSkew = time.Minute
+	s.service = New(s.storage, s.clock, cfg, testutil.NopLogger())
+
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	// Just past expiry, but within the skew
+	s.clock.Advance(cfg.SessionDuration + 30*time.Second)
+	_, err := s.service.ValidateSession(session.Token)
+	s.NoError(err)
+
+	// Beyond the skew
+	s.clock.Advance(time.Minute)
+	_, err = s.service.ValidateSession(session.Token)
+	s.ErrorIs(err, ErrInvalidSession)
+}
+
 // InvalidateSession tests
 
 func (s *ServiceSuite) TestInvalidateSessionRemovesSession() {
@@ -167,6 +281,152 @@ func (s *ServiceSuite) TestGetPlayerFailsWithInvalidToken() {
 	s.ErrorIs(err, ErrInvalidSession)
 }
 
+// UpdateDisplayName tests
+
+func (s *ServiceSuite) TestUpdateDisplayNameUpdatesStorageAndSession() {
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	player, err := s.service.UpdateDisplayName(s.ctx, session.PlayerID, "Alicia")
+	s.Require().NoError(err)
+	s.Equal("Alicia", player.DisplayName)
+
+	stored, err := s.storage.GetPlayer(s.ctx, session.PlayerID)
+	s.Require().NoError(err)
+	s.Equal("Alicia", stored.DisplayName)
+
+	fromSession, err := s.service.GetPlayer(session.Token)
+	s.Require().NoError(err)
+	s.Equal("Alicia", fromSession.DisplayName)
+}
+
+func (s *ServiceSuite) TestUpdateDisplayNameRejectsInvalidNames() {
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	_, err := s.service.UpdateDisplayName(s.ctx, session.PlayerID, "")
+	s.ErrorIs(err, model.ErrInvalidDisplayName)
+
+	_, err = s.service.UpdateDisplayName(s.ctx, session.PlayerID, "ThisNameIsWayTooLongToUse")
+	s.ErrorIs(err, model.ErrInvalidDisplayName)
+}
+
+// Display name filter tests
+
+func (s *ServiceSuite) useNameFilter(blocked, allowed []string) {
+	cfg := DefaultConfig()
+	cfg.NameFilter = NewNameFilter(blocked, allowed)
+	s.service = New(s.storage, s.clock, cfg, testutil.NopLogger())
+}
+
+func (s *ServiceSuite) TestCreateGuestPlayerRejectsBlockedName() {
+	s.useNameFilter([]string{"badword"}, nil)
+
+	_, err := s.service.CreateGuestPlayer(s.ctx, "xXBadWordXx")
+	s.ErrorIs(err, model.ErrDisplayNameNotAllowed)
+
+	session, err := s.service.CreateGuestPlayer(s.ctx, "Alice")
+	s.Require().NoError(err)
+	s.Equal("Alice", session.Player.DisplayName)
+}
+
+func (s *ServiceSuite) TestRegisterPlayerRejectsBlockedName() {
+	s.useNameFilter([]string{"badword"}, nil)
+
+	_, _, err := s.service.RegisterPlayer(s.ctx, "alice", "password123", "BADWORD")
+	s.ErrorIs(err, model.ErrDisplayNameNotAllowed)
+
+	// The username is still free
+	_, _, err = s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdateDisplayNameRejectsBlockedName() {
+	s.useNameFilter([]string{"badword"}, nil)
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	_, err := s.service.UpdateDisplayName(s.ctx, session.PlayerID, "Mr Badword")
+	s.ErrorIs(err, model.ErrDisplayNameNotAllowed)
+
+	stored, err := s.storage.GetPlayer(s.ctx, session.PlayerID)
+	s.Require().NoError(err)
+	s.Equal("Alice", stored.DisplayName)
+}
+
+func (s *ServiceSuite) TestNameFilterAllowlistPreventsFalsePositives() {
+	s.useNameFilter([]string{"ass"}, []string{"class", "grass"})
+
+	_, err := s.service.CreateGuestPlayer(s.ctx, "ClassAct")
+	s.NoError(err)
+	_, err = s.service.CreateGuestPlayer(s.ctx, "GrassClass")
+	s.NoError(err)
+	_, err = s.service.CreateGuestPlayer(s.ctx, "Class Ass")
+	s.ErrorIs(err, model.ErrDisplayNameNotAllowed)
+}
+
+func (s *ServiceSuite) TestLoadNameFilterReadsBlockAndAllowEntries() {
+	path := filepath.Join(s.T().TempDir(), "blocklist.txt")
+	contents := "# blocked words\nbadword\n\nass\n!class\n"
+	s.Require().NoError(os.WriteFile(path, []byte(contents), 0o600))
+
+	filter, err := LoadNameFilter(path)
+	s.Require().NoError(err)
+
+	s.False(filter.Allows("BadWord"))
+	s.False(filter.Allows("Bass"))
+	s.True(filter.Allows("Classy"))
+	s.True(filter.Allows("# blocked words"))
+}
+
+func (s *ServiceSuite) TestNilNameFilterAllowsEverything() {
+	var filter *NameFilter
+	s.True(filter.Allows("anything"))
+}
+
+// DeleteAccount tests
+
+func (s *ServiceSuite) TestDeleteAccountRemovesRegisteredPlayer() {
+	session, _, _ := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+
+	err := s.service.DeleteAccount(s.ctx, session.PlayerID, "password123")
+	s.Require().NoError(err)
+
+	// Session is ended and login fails
+	_, err = s.service.ValidateSession(session.Token)
+	s.ErrorIs(err, ErrInvalidSession)
+	_, err = s.service.Login(s.ctx, "alice", "password123")
+	s.ErrorIs(err, ErrInvalidCredentials)
+	_, err = s.storage.GetPlayer(s.ctx, session.PlayerID)
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+
+	// Username is free to reuse
+	_, _, err = s.service.RegisterPlayer(s.ctx, "alice", "newpassword", "Alice")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteAccountFailsWithWrongPassword() {
+	session, _, _ := s.service.RegisterPlayer(s.ctx, "alice", "password123", "Alice")
+
+	s.ErrorIs(s.service.CheckDeletePassword(s.ctx, session.PlayerID, "wrongpassword"), ErrInvalidCredentials)
+	s.NoError(s.service.CheckDeletePassword(s.ctx, session.PlayerID, "password123"))
+
+	err := s.service.DeleteAccount(s.ctx, session.PlayerID, "wrongpassword")
+	s.ErrorIs(err, ErrInvalidCredentials)
+
+	_, err = s.service.Login(s.ctx, "alice", "password123")
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteAccountRemovesGuestWithoutPassword() {
+	session, _ := s.service.CreateGuestPlayer(s.ctx, "Alice")
+
+	err := s.service.DeleteAccount(s.ctx, session.PlayerID, "")
+	s.Require().NoError(err)
+
+	_, err = s.storage.GetPlayer(s.ctx, session.PlayerID)
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+	_, err = s.service.ValidateSession(session.Token)
+	s.ErrorIs(err, ErrInvalidSession)
+}
+
 // CleanExpiredSessions tests
 
 func (s *ServiceSuite) TestCleanExpiredSessionsRemovesExpired() {
@@ -3,7 +3,7 @@ package board
 import (
 	"context"
 	"log/slog"
-	"unicode"
+	"sync"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/storage"
@@ -11,21 +11,47 @@ import (
 
 // Service provides board operations
 type Service struct {
-	storage storage.Storage
-	logger  *slog.Logger
+	storage  storage.Storage
+	alphabet model.Alphabet
+	logger   *slog.Logger
+
+	// Per-board locks serializing placements, keyed by game and player
+	locksMu sync.Mutex
+	locks   map[boardKey]*boardLock
+}
+
+// boardKey identifies a single player's board in a game
+type boardKey struct {
+	gameID   model.GameID
+	playerID model.PlayerID
+}
+
+// boardLock is a mutex shared by everyone placing on one board, freed once
+// no placement holds or waits on it
+type boardLock struct {
+	mu   sync.Mutex
+	refs int
 }
 
-// New creates a new BoardService
-func New(storage storage.Storage, logger *slog.Logger) *Service {
+// New creates a new BoardService that accepts letters from the given alphabet
+func New(storage storage.Storage, alphabet model.Alphabet, logger *slog.Logger) *Service {
 	return &Service{
-		storage: storage,
-		logger:  logger,
+		storage:  storage,
+		alphabet: alphabet,
+		logger:   logger,
+		locks:    make(map[boardKey]*boardLock),
 	}
 }
 
 // CreateBoard initializes an empty board for a player in a game
 func (s *Service) CreateBoard(ctx context.Context, gameID model.GameID, playerID model.PlayerID, size int) (*model.Board, error) {
+	return s.CreateBoardWithBlocked(ctx, gameID, playerID, size, nil)
+}
+
+// CreateBoardWithBlocked initializes a board with the given cells blocked
+func (s *Service) CreateBoardWithBlocked(ctx context.Context, gameID model.GameID, playerID model.PlayerID, size int, blocked []model.Position) (*model.Board, error) {
 	board := model.NewBoard(gameID, playerID, size)
+	board.Block(blocked)
 	if err := s.storage.SaveBoard(ctx, board); err != nil {
 		return nil, err
 	}
@@ -43,23 +69,82 @@ func (s *Service) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]
 }
 
 // PlaceLetter places a letter at the specified position on a board
+// Placements on the same board are serialized and checked against the stored
+// board, so of two concurrent placements on one cell only the first succeeds
+// and the other gets ErrCellOccupied. On success board is updated to match.
 func (s *Service) PlaceLetter(ctx context.Context, board *model.Board, letter rune, pos model.Position) error {
-	if err := s.ValidatePlacement(board, pos); err != nil {
+	unlock := s.lockBoard(board.GameID, board.PlayerID)
+	defer unlock()
+
+	// The caller's copy may predate a placement saved while we waited
+	current, err := s.storage.GetBoard(ctx, board.GameID, board.PlayerID)
+	if err != nil {
 		return err
 	}
-	if err := ValidateLetter(letter); err != nil {
+
+	if err := s.ValidatePlacement(current, pos); err != nil {
+		return err
+	}
+	normalized, err := s.NormalizeLetter(letter)
+	if err != nil {
 		return err
 	}
 
-	board.Set(pos, unicode.ToUpper(letter))
-	return s.storage.SaveBoard(ctx, board)
+	current.Set(pos, normalized)
+	if err := s.storage.SaveBoard(ctx, current); err != nil {
+		return err
+	}
+	if board != current {
+		*board = *current
+	}
+	return nil
 }
 
-// ValidatePlacement checks if a position is valid and empty
+// lockBoard acquires the placement lock for a board, returning its release func
+func (s *Service) lockBoard(gameID model.GameID, playerID model.PlayerID) func() {
+	key := boardKey{gameID: gameID, playerID: playerID}
+
+	s.locksMu.Lock()
+	lock, ok := s.locks[key]
+	if !ok {
+		lock = &boardLock{}
+		s.locks[key] = lock
+	}
+	lock.refs++
+	s.locksMu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+
+		s.locksMu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(s.locks, key)
+		}
+		s.locksMu.Unlock()
+	}
+}
+
+// NormalizeLetter upper-cases a letter and folds it into the configured alphabet
+// Returns ErrInvalidLetter if the letter cannot be played
+func (s *Service) NormalizeLetter(letter rune) (rune, error) {
+	return s.alphabet.NormalizeLetter(letter)
+}
+
+// Letters returns the letters of the configured alphabet
+func (s *Service) Letters() []rune {
+	return s.alphabet.Letters()
+}
+
+// ValidatePlacement checks if a position is valid, open and empty
 func (s *Service) ValidatePlacement(board *model.Board, pos model.Position) error {
 	if !board.IsValidPosition(pos) {
 		return model.ErrInvalidPosition
 	}
+	if board.IsBlocked(pos) {
+		return model.ErrCellBlocked
+	}
 	if !board.IsEmpty(pos) {
 		return model.ErrCellOccupied
 	}
@@ -68,11 +153,8 @@ func (s *Service) ValidatePlacement(board *model.Board, pos model.Position) erro
 
 // ValidateLetter checks if a letter is a valid A-Z character
 func ValidateLetter(letter rune) error {
-	upper := unicode.ToUpper(letter)
-	if upper < 'A' || upper > 'Z' {
-		return model.ErrInvalidLetter
-	}
-	return nil
+	_, err := model.DefaultAlphabet().NormalizeLetter(letter)
+	return err
 }
 
 // IsFull checks if all cells are filled
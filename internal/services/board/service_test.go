@@ -2,6 +2,7 @@ package board
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -24,7 +25,7 @@ func TestServiceSuite(t *testing.T) {
 
 func (s *ServiceSuite) SetupTest() {
 	s.storage = memory.New()
-	s.service = New(s.storage, testutil.NopLogger())
+	s.service = New(s.storage, model.DefaultAlphabet(), testutil.NopLogger())
 	s.ctx = context.Background()
 }
 
@@ -116,6 +117,17 @@ func (s *ServiceSuite) TestPlaceLetterCellOccupied() {
 	s.ErrorIs(err, model.ErrCellOccupied)
 }
 
+func (s *ServiceSuite) TestPlaceLetterCellBlocked() {
+	blocked := []model.Position{{Row: 2, Col: 2}}
+	board, err := s.service.CreateBoardWithBlocked(s.ctx, "game-1", "player-1", 5, blocked)
+	s.Require().NoError(err)
+	s.Equal(24, board.EmptyCount())
+	s.False(board.HasLetters())
+
+	err = s.service.PlaceLetter(s.ctx, board, 'A', model.Position{Row: 2, Col: 2})
+	s.ErrorIs(err, model.ErrCellBlocked)
+}
+
 func (s *ServiceSuite) TestPlaceLetterInvalidLetter() {
 	board, _ := s.service.CreateBoard(s.ctx, "game-1", "player-1", 5)
 
@@ -126,6 +138,39 @@ func (s *ServiceSuite) TestPlaceLetterInvalidLetter() {
 	s.ErrorIs(err, model.ErrInvalidLetter)
 }
 
+func (s *ServiceSuite) TestPlaceLetterConcurrentSameCell() {
+	_, _ = s.service.CreateBoard(s.ctx, "game-1", "player-1", 5)
+	pos := model.Position{Row: 2, Col: 2}
+
+	// Each caller works from its own fetched copy of the board, as on a double-click
+	letters := []rune{'A', 'B'}
+	errs := make([]error, len(letters))
+	var wg sync.WaitGroup
+	for i, letter := range letters {
+		board, _ := s.service.GetBoard(s.ctx, "game-1", "player-1")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.service.PlaceLetter(s.ctx, board, letter, pos)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			s.ErrorIs(err, model.ErrCellOccupied)
+		}
+	}
+	s.Equal(1, succeeded)
+
+	stored, _ := s.service.GetBoard(s.ctx, "game-1", "player-1")
+	s.NotZero(stored.Get(pos))
+	s.Empty(s.service.locks, "locks are released once unused")
+}
+
 // ValidatePlacement tests
 
 func (s *ServiceSuite) TestValidatePlacementValid() {
@@ -170,6 +215,34 @@ func (s *ServiceSuite) TestValidateLetterInvalid() {
 	s.ErrorIs(ValidateLetter('@'), model.ErrInvalidLetter)
 }
 
+func (s *ServiceSuite) TestNormalizeLetterFoldsAccents() {
+	service := New(s.storage, model.Alphabet{FoldAccents: true}, testutil.NopLogger())
+
+	for input, want := range map[rune]rune{'é': 'E', 'À': 'A', 'ç': 'C', 'ñ': 'N', 'q': 'Q'} {
+		got, err := service.NormalizeLetter(input)
+		s.Require().NoError(err)
+		s.Equal(want, got)
+	}
+	_, err := service.NormalizeLetter('1')
+	s.ErrorIs(err, model.ErrInvalidLetter)
+}
+
+func (s *ServiceSuite) TestNormalizeLetterAllowsExtraLetters() {
+	service := New(s.storage, model.Alphabet{ExtraLetters: "éç"}, testutil.NopLogger())
+
+	got, err := service.NormalizeLetter('é')
+	s.Require().NoError(err)
+	s.Equal('É', got)
+
+	_, err = service.NormalizeLetter('à')
+	s.ErrorIs(err, model.ErrInvalidLetter)
+}
+
+func (s *ServiceSuite) TestNormalizeLetterRejectsAccentsByDefault() {
+	_, err := s.service.NormalizeLetter('é')
+	s.ErrorIs(err, model.ErrInvalidLetter)
+}
+
 // IsFull tests
 
 func (s *ServiceSuite) TestIsFullEmpty() {
@@ -0,0 +1,270 @@
+package boardimage
+
+import (
+	"bytes"
+	"container/list"
+	"image"
+	"image/color"
+	"image/png"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
+
+// Layout constants for rendered boards (in pixels)
+const (
+	CellSize  = 48
+	Margin    = 8
+	glyphCols = 5
+	glyphRows = 7
+	glyphPx   = 4 // Each glyph pixel is drawn as a glyphPx x glyphPx square
+)
+
+var (
+	backgroundColor = color.RGBA{R: 0xe2, G: 0xe8, B: 0xf0, A: 0xff}
+	cellColor       = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	letterColor     = color.RGBA{R: 0x1e, G: 0x29, B: 0x3b, A: 0xff}
+)
+
+// ImageSize returns the width/height in pixels of a rendered board of the given grid size
+func ImageSize(gridSize int) int {
+	return gridSize*CellSize + 2*Margin
+}
+
+// DefaultCacheSize is the number of rendered boards kept by New
+const DefaultCacheSize = 256
+
+type cacheEntry struct {
+	key  string
+	data []byte
+}
+
+// Service renders boards as PNG images
+type Service struct {
+	mu        sync.Mutex
+	cacheSize int
+	cache     map[string]*list.Element // Values are *cacheEntry
+	order     *list.List               // Most recently used at the front
+	logger    *slog.Logger
+}
+
+// New creates a new board image service caching up to DefaultCacheSize renders
+func New(logger *slog.Logger) *Service {
+	return NewWithCacheSize(logger, DefaultCacheSize)
+}
+
+// NewWithCacheSize creates a new board image service caching up to cacheSize
+// renders, evicting the least recently used
+func NewWithCacheSize(logger *slog.Logger, cacheSize int) *Service {
+	return &Service{
+		cacheSize: cacheSize,
+		cache:     make(map[string]*list.Element),
+		order:     list.New(),
+		logger:    logger,
+	}
+}
+
+// RenderPNG renders a board as a PNG image
+func (s *Service) RenderPNG(board *model.Board) ([]byte, error) {
+	img := renderBoard(board)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderFinalPNG renders a board from a completed game as a PNG image.
+// Renders are cached by board content, so identical boards share an entry
+// and a board that changes is never served a stale image.
+func (s *Service) RenderFinalPNG(board *model.Board) ([]byte, error) {
+	key := contentKey(board)
+
+	s.mu.Lock()
+	if elem, ok := s.cache[key]; ok {
+		s.order.MoveToFront(elem)
+		data := elem.Value.(*cacheEntry).data
+		s.mu.Unlock()
+		return data, nil
+	}
+	s.mu.Unlock()
+
+	data, err := s.RenderPNG(board)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.cache[key]; ok {
+		return data, nil
+	}
+	s.cache[key] = s.order.PushFront(&cacheEntry{key: key, data: data})
+	for s.order.Len() > s.cacheSize {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.cache, oldest.Value.(*cacheEntry).key)
+	}
+
+	return data, nil
+}
+
+// contentKey identifies a render by the board's size and cells
+func contentKey(board *model.Board) string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(board.Size))
+	b.WriteByte(':')
+	for _, row := range board.Cells {
+		b.WriteString(string(row))
+	}
+	return b.String()
+}
+
+// renderBoard draws the board grid with its letters
+func renderBoard(board *model.Board) *image.RGBA {
+	size := ImageSize(board.Size)
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	fillRect(img, img.Bounds(), backgroundColor)
+
+	for row := 0; row < board.Size; row++ {
+		for col := 0; col < board.Size; col++ {
+			x0 := Margin + col*CellSize
+			y0 := Margin + row*CellSize
+			// Leave a 1px gap on each side as the grid line, and draw
+			// blocked cells as solid squares
+			if board.IsBlocked(model.Position{Row: row, Col: col}) {
+				fillRect(img, image.Rect(x0+1, y0+1, x0+CellSize-1, y0+CellSize-1), letterColor)
+				continue
+			}
+			fillRect(img, image.Rect(x0+1, y0+1, x0+CellSize-1, y0+CellSize-1), cellColor)
+
+			if letter := board.Cells[row][col]; letter != 0 {
+				drawGlyph(img, x0, y0, letter)
+			}
+		}
+	}
+
+	return img
+}
+
+// drawGlyph draws a letter centred in the cell whose top-left corner is (x0, y0)
+// Accented letters are drawn as their base letter with the accent added;
+// letters with no glyph are drawn as a box so they are never silently blank
+func drawGlyph(img *image.RGBA, x0, y0 int, letter rune) {
+	letter = unicode.ToUpper(letter)
+	offsetX := x0 + (CellSize-glyphCols*glyphPx)/2
+	offsetY := y0 + (CellSize-glyphRows*glyphPx)/2
+
+	if glyph, ok := glyphs[letter]; ok {
+		drawRows(img, offsetX, offsetY, glyph[:])
+		return
+	}
+	if accent, ok := accentedLetters[letter]; ok {
+		// Shift the base letter down a row to leave room for marks above it
+		if !accent.mark.below {
+			offsetY += glyphPx
+		}
+		base := glyphs[accent.base]
+		drawRows(img, offsetX, offsetY, base[:])
+		markY := offsetY - 3*glyphPx
+		if accent.mark.below {
+			markY = offsetY + glyphRows*glyphPx
+		}
+		drawRows(img, offsetX, markY, accent.mark.rows[:])
+		return
+	}
+	drawRows(img, offsetX, offsetY, unknownGlyph[:])
+}
+
+// drawRows draws a glyph pattern with its top-left corner at (x0, y0)
+func drawRows(img *image.RGBA, x0, y0 int, rows []string) {
+	for gy, line := range rows {
+		for gx, ch := range line {
+			if ch != '#' {
+				continue
+			}
+			px := x0 + gx*glyphPx
+			py := y0 + gy*glyphPx
+			fillRect(img, image.Rect(px, py, px+glyphPx, py+glyphPx), letterColor)
+		}
+	}
+}
+
+func fillRect(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// glyphs is a simple 5x7 monospace glyph sheet for A-Z
+var glyphs = map[rune][glyphRows]string{
+	'A': {".###.", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'B': {"####.", "#...#", "#...#", "####.", "#...#", "#...#", "####."},
+	'C': {".###.", "#...#", "#....", "#....", "#....", "#...#", ".###."},
+	'D': {"####.", "#...#", "#...#", "#...#", "#...#", "#...#", "####."},
+	'E': {"#####", "#....", "#....", "####.", "#....", "#....", "#####"},
+	'F': {"#####", "#....", "#....", "####.", "#....", "#....", "#...."},
+	'G': {".###.", "#...#", "#....", "#.###", "#...#", "#...#", ".####"},
+	'H': {"#...#", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'I': {"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "#####"},
+	'J': {"..###", "...#.", "...#.", "...#.", "...#.", "#..#.", ".##.."},
+	'K': {"#...#", "#..#.", "#.#..", "##...", "#.#..", "#..#.", "#...#"},
+	'L': {"#....", "#....", "#....", "#....", "#....", "#....", "#####"},
+	'M': {"#...#", "##.##", "#.#.#", "#.#.#", "#...#", "#...#", "#...#"},
+	'N': {"#...#", "##..#", "#.#.#", "#..##", "#...#", "#...#", "#...#"},
+	'O': {".###.", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'P': {"####.", "#...#", "#...#", "####.", "#....", "#....", "#...."},
+	'Q': {".###.", "#...#", "#...#", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R': {"####.", "#...#", "#...#", "####.", "#.#..", "#..#.", "#...#"},
+	'S': {".####", "#....", "#....", ".###.", "....#", "....#", "####."},
+	'T': {"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "..#.."},
+	'U': {"#...#", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'V': {"#...#", "#...#", "#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W': {"#...#", "#...#", "#...#", "#.#.#", "#.#.#", "##.##", "#...#"},
+	'X': {"#...#", "#...#", ".#.#.", "..#..", ".#.#.", "#...#", "#...#"},
+	'Y': {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#..", "..#.."},
+	'Z': {"#####", "....#", "...#.", "..#..", ".#...", "#....", "#####"},
+}
+
+// unknownGlyph is drawn for letters with no glyph
+var unknownGlyph = [glyphRows]string{"#####", "#...#", "#...#", "#...#", "#...#", "#...#", "#####"}
+
+// diacritic is a two-row accent mark drawn above (or below) a base glyph
+type diacritic struct {
+	rows  [2]string
+	below bool
+}
+
+var (
+	acute      = diacritic{rows: [2]string{"...#.", "..#.."}}
+	grave      = diacritic{rows: [2]string{".#...", "..#.."}}
+	circumflex = diacritic{rows: [2]string{"..#..", ".#.#."}}
+	diaeresis  = diacritic{rows: [2]string{".....", ".#.#."}}
+	tilde      = diacritic{rows: [2]string{".#..#", "#.##."}}
+	ring       = diacritic{rows: [2]string{".###.", ".#.#."}}
+	caron      = diacritic{rows: [2]string{".#.#.", "..#.."}}
+	cedilla    = diacritic{rows: [2]string{"..#..", ".##.."}, below: true}
+)
+
+// accentedLetters are the accented letters an alphabet may allow in
+// addition to A-Z (see model.Alphabet), drawn as a base glyph plus a mark
+var accentedLetters = map[rune]struct {
+	base rune
+	mark diacritic
+}{
+	'À': {'A', grave}, 'Á': {'A', acute}, 'Â': {'A', circumflex}, 'Ã': {'A', tilde}, 'Ä': {'A', diaeresis}, 'Å': {'A', ring},
+	'Ç': {'C', cedilla}, 'Č': {'C', caron},
+	'È': {'E', grave}, 'É': {'E', acute}, 'Ê': {'E', circumflex}, 'Ë': {'E', diaeresis}, 'Ě': {'E', caron},
+	'Ì': {'I', grave}, 'Í': {'I', acute}, 'Î': {'I', circumflex}, 'Ï': {'I', diaeresis},
+	'Ñ': {'N', tilde}, 'Ň': {'N', caron},
+	'Ò': {'O', grave}, 'Ó': {'O', acute}, 'Ô': {'O', circumflex}, 'Õ': {'O', tilde}, 'Ö': {'O', diaeresis},
+	'Ř': {'R', caron}, 'Š': {'S', caron}, 'Ş': {'S', cedilla}, 'Ţ': {'T', cedilla}, 'Ť': {'T', caron},
+	'Ù': {'U', grave}, 'Ú': {'U', acute}, 'Û': {'U', circumflex}, 'Ü': {'U', diaeresis}, 'Ů': {'U', ring},
+	'Ý': {'Y', acute}, 'Ÿ': {'Y', diaeresis}, 'Ž': {'Z', caron},
+}
@@ -0,0 +1,116 @@
+package boardimage
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/testutil"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	service *Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.service = New(testutil.NopLogger())
+}
+
+func (s *ServiceSuite) TestRenderPNGProducesValidImageWithExpectedDimensions() {
+	board := model.NewBoard("game-1", "player-1", 5)
+	board.Set(model.Position{Row: 0, Col: 0}, 'C')
+	board.Set(model.Position{Row: 0, Col: 1}, 'A')
+	board.Set(model.Position{Row: 0, Col: 2}, 'T')
+
+	data, err := s.service.RenderPNG(board)
+	s.Require().NoError(err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	s.Require().NoError(err)
+	s.Equal(5*CellSize+2*Margin, img.Bounds().Dx())
+	s.Equal(5*CellSize+2*Margin, img.Bounds().Dy())
+}
+
+func (s *ServiceSuite) TestRenderPNGDrawsLetters() {
+	empty := model.NewBoard("game-1", "player-1", 3)
+	filled := model.NewBoard("game-1", "player-2", 3)
+	filled.Set(model.Position{Row: 1, Col: 1}, 'X')
+
+	emptyData, err := s.service.RenderPNG(empty)
+	s.Require().NoError(err)
+	filledData, err := s.service.RenderPNG(filled)
+	s.Require().NoError(err)
+
+	s.NotEqual(emptyData, filledData)
+}
+
+func (s *ServiceSuite) TestRenderFinalPNGCachesByContent() {
+	board := model.NewBoard("game-1", "player-1", 3)
+	board.Set(model.Position{Row: 0, Col: 0}, 'A')
+
+	first, err := s.service.RenderFinalPNG(board)
+	s.Require().NoError(err)
+
+	// A different board with the same letters shares the cached render
+	same := model.NewBoard("game-2", "player-2", 3)
+	same.Set(model.Position{Row: 0, Col: 0}, 'A')
+	shared, err := s.service.RenderFinalPNG(same)
+	s.Require().NoError(err)
+	s.Equal(first, shared)
+
+	// Changing the board's letters renders it afresh
+	board.Set(model.Position{Row: 2, Col: 2}, 'Z')
+	changed, err := s.service.RenderFinalPNG(board)
+	s.Require().NoError(err)
+	s.NotEqual(first, changed)
+}
+
+func (s *ServiceSuite) TestRenderFinalPNGEvictsLeastRecentlyUsed() {
+	s.service = NewWithCacheSize(testutil.NopLogger(), 2)
+	boards := make([]*model.Board, 3)
+	for i, letter := range "ABC" {
+		boards[i] = model.NewBoard("game-1", "player-1", 2)
+		boards[i].Set(model.Position{Row: 0, Col: 0}, letter)
+	}
+
+	_, _ = s.service.RenderFinalPNG(boards[0])
+	_, _ = s.service.RenderFinalPNG(boards[1])
+	_, _ = s.service.RenderFinalPNG(boards[0]) // Now the most recently used
+	_, _ = s.service.RenderFinalPNG(boards[2])
+
+	s.Len(s.service.cache, 2)
+	s.Contains(s.service.cache, contentKey(boards[0]))
+	s.NotContains(s.service.cache, contentKey(boards[1]))
+	s.Contains(s.service.cache, contentKey(boards[2]))
+}
+
+func (s *ServiceSuite) TestRenderPNGDrawsAccentedLetters() {
+	render := func(letter rune) []byte {
+		board := model.NewBoard("game-1", "player-1", 1)
+		board.Set(model.Position{Row: 0, Col: 0}, letter)
+		data, err := s.service.RenderPNG(board)
+		s.Require().NoError(err)
+		return data
+	}
+
+	empty, err := s.service.RenderPNG(model.NewBoard("game-1", "player-1", 1))
+	s.Require().NoError(err)
+	s.NotEqual(empty, render('É'))
+	s.NotEqual(render('E'), render('É'))
+	s.NotEqual(render('É'), render('È'))
+	// Letters with no glyph are still drawn
+	s.NotEqual(empty, render('Ж'))
+}
+
+func (s *ServiceSuite) TestImageSize() {
+	s.Equal(2*CellSize+2*Margin, ImageSize(2))
+	s.Equal(7*CellSize+2*Margin, ImageSize(7))
+}
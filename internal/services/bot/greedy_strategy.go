@@ -0,0 +1,120 @@
+package bot
+
+import (
+	"sort"
+
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+)
+
+// greedyLetterPool is how many of the most common letters the greedy
+// strategy chooses between when announcing
+const greedyLetterPool = 8
+
+// GreedyStrategy announces common letters and places each letter wherever
+// it most improves the bot's current score
+type GreedyStrategy struct {
+	dictionary *dictionary.Service
+	scoring    *scoring.Service
+	random     random.Random
+}
+
+// NewGreedyStrategy creates a new GreedyStrategy
+func NewGreedyStrategy(dict *dictionary.Service, scoringService *scoring.Service, rnd random.Random) *GreedyStrategy {
+	return &GreedyStrategy{
+		dictionary: dict,
+		scoring:    scoringService,
+		random:     rnd,
+	}
+}
+
+// ChooseLetter picks one of the most common letters in the dictionary,
+// falling back to a random letter if the dictionary isn't loaded. With a
+// rack it picks the rack's most common letter.
+func (s *GreedyStrategy) ChooseLetter(game *model.Game) rune {
+	scores := s.dictionary.LetterScores()
+	if rack := announcerRack(game); len(rack) > 0 {
+		best := rack[0]
+		for _, letter := range rack[1:] {
+			if scores[letter] > scores[best] {
+				best = letter
+			}
+		}
+		return best
+	}
+	if len(scores) == 0 {
+		return rune('A' + letterRandom(s.random, game).Intn(26))
+	}
+
+	letters := make([]rune, 0, len(scores))
+	for letter := range scores {
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		if scores[letters[i]] != scores[letters[j]] {
+			return scores[letters[i]] > scores[letters[j]]
+		}
+		return letters[i] < letters[j]
+	})
+
+	pool := min(greedyLetterPool, len(letters))
+	return letters[letterRandom(s.random, game).Intn(pool)]
+}
+
+// ChoosePosition places the current letter in the allowed empty cell that
+// gives the highest board score, breaking ties randomly
+func (s *GreedyStrategy) ChoosePosition(game *model.Game, board *model.Board) model.Position {
+	ranked := s.RankPositions(game, board)
+	if len(ranked) == 0 {
+		return model.Position{Row: 0, Col: 0}
+	}
+
+	best := 1
+	for best < len(ranked) && ranked[best].Score == ranked[0].Score {
+		best++
+	}
+	return ranked[positionRandom(s.random, game, board).Intn(best)].Position
+}
+
+// RankPositions scores placing the current letter in each allowed empty
+// cell of board, best first. Equal scores keep reading order.
+func (s *GreedyStrategy) RankPositions(game *model.Game, board *model.Board) []RankedPosition {
+	trial := cloneBoard(board)
+	opts := scoring.OptionsForGame(game)
+	required, hasRequired := game.RequiredPosition()
+
+	var ranked []RankedPosition
+	for row := 0; row < board.Size; row++ {
+		for col := 0; col < board.Size; col++ {
+			pos := model.Position{Row: row, Col: col}
+			if board.Cells[row][col] != 0 || !game.AllowsAdjacency(board, pos) {
+				continue
+			}
+			if hasRequired && pos != required {
+				continue
+			}
+			trial.Set(pos, game.CurrentLetter)
+			ranked = append(ranked, RankedPosition{
+				Position: pos,
+				Score:    s.scoring.ScoreBoardWithOptions(trial, opts).TotalScore,
+			})
+			trial.Set(pos, 0)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	return ranked
+}
+
+// cloneBoard returns a deep copy of a board for trial placements
+func cloneBoard(b *model.Board) *model.Board {
+	clone := model.NewBoard(b.GameID, b.PlayerID, b.Size)
+	for row := range b.Cells {
+		copy(clone.Cells[row], b.Cells[row])
+	}
+	return clone
+}
@@ -15,23 +15,30 @@ func NewRandomStrategy(rnd random.Random) *RandomStrategy {
 	return &RandomStrategy{random: rnd}
 }
 
-// ChooseLetter returns a random uppercase letter A-Z
+// ChooseLetter returns a random letter from the announcer's rack, or a
+// random uppercase letter A-Z if the game has no racks
 func (s *RandomStrategy) ChooseLetter(game *model.Game) rune {
-	return rune('A' + s.random.Intn(26))
+	rng := letterRandom(s.random, game)
+	if rack := announcerRack(game); len(rack) > 0 {
+		return rack[rng.Intn(len(rack))]
+	}
+	return rune('A' + rng.Intn(26))
 }
 
-// ChoosePosition picks a random empty cell on the board
+// ChoosePosition picks a random empty cell on the board that the game's
+// placement rules allow
 func (s *RandomStrategy) ChoosePosition(game *model.Game, board *model.Board) model.Position {
 	var empty []model.Position
 	for row := 0; row < board.Size; row++ {
 		for col := 0; col < board.Size; col++ {
-			if board.Cells[row][col] == 0 {
-				empty = append(empty, model.Position{Row: row, Col: col})
+			pos := model.Position{Row: row, Col: col}
+			if board.Cells[row][col] == 0 && game.AllowsAdjacency(board, pos) {
+				empty = append(empty, pos)
 			}
 		}
 	}
 	if len(empty) == 0 {
 		return model.Position{Row: 0, Col: 0}
 	}
-	return empty[s.random.Intn(len(empty))]
+	return empty[positionRandom(s.random, game, board).Intn(len(empty))]
 }
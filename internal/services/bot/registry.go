@@ -0,0 +1,44 @@
+package bot
+
+// StrategyInfo describes a registered strategy for clients choosing one
+type StrategyInfo struct {
+	ID   string // Identifier passed when adding a bot
+	Name string // Human-readable name
+}
+
+// Registry holds the strategies bots can be given, in the order they were
+// registered
+type Registry struct {
+	strategies map[string]Strategy
+	infos      []StrategyInfo
+}
+
+// NewRegistry creates an empty strategy registry
+func NewRegistry() *Registry {
+	return &Registry{strategies: make(map[string]Strategy)}
+}
+
+// Register adds a strategy under id, replacing any registered with that id
+func (r *Registry) Register(id, name string, strategy Strategy) {
+	if _, ok := r.strategies[id]; ok {
+		for i := range r.infos {
+			if r.infos[i].ID == id {
+				r.infos[i].Name = name
+			}
+		}
+	} else {
+		r.infos = append(r.infos, StrategyInfo{ID: id, Name: name})
+	}
+	r.strategies[id] = strategy
+}
+
+// Get returns the strategy registered under id
+func (r *Registry) Get(id string) (Strategy, bool) {
+	strategy, ok := r.strategies[id]
+	return strategy, ok
+}
+
+// List returns the registered strategies in registration order
+func (r *Registry) List() []StrategyInfo {
+	return append([]StrategyInfo(nil), r.infos...)
+}
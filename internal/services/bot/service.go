@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
@@ -41,16 +43,40 @@ type BotAction struct {
 	Position model.Position
 }
 
+// Config holds configuration for the bot service
+type Config struct {
+	// ThinkTime is how long a bot waits before each action when run via
+	// RunBotActions. Zero means bots act synchronously and instantly.
+	ThinkTime time.Duration
+	// MaxBots caps the number of bots in a single lobby
+	MaxBots int
+}
+
+// DefaultConfig returns the default bot configuration (synchronous bots)
+func DefaultConfig() Config {
+	return Config{
+		ThinkTime: 0,
+		MaxBots:   7,
+	}
+}
+
 // Service manages bot players in the game
 type Service struct {
 	storage         storage.Storage
 	lobbyController *lobby.Controller
 	gameController  *game.Controller
 	boardService    *board.Service
-	strategies      map[string]Strategy
+	strategies      *Registry
 	clock           clock.Clock
 	random          random.Random
+	config          Config
 	logger          *slog.Logger
+
+	// Delayed runners: at most one goroutine per game, with a flag asking it
+	// to re-check for bot work once it would otherwise finish
+	mu      sync.Mutex
+	running map[model.GameID]bool
+	rerun   map[model.GameID]bool
 }
 
 // NewService creates a new bot Service
@@ -59,11 +85,15 @@ func NewService(
 	lobbyController *lobby.Controller,
 	gameController *game.Controller,
 	boardService *board.Service,
-	strategies map[string]Strategy,
+	strategies *Registry,
 	clk clock.Clock,
 	rnd random.Random,
+	cfg Config,
 	logger *slog.Logger,
 ) *Service {
+	if cfg.MaxBots == 0 {
+		cfg.MaxBots = DefaultConfig().MaxBots
+	}
 	return &Service{
 		storage:         store,
 		lobbyController: lobbyController,
@@ -72,19 +102,23 @@ func NewService(
 		strategies:      strategies,
 		clock:           clk,
 		random:          rnd,
+		config:          cfg,
 		logger:          logger.With(slog.String("component", "bot-service")),
+		running:         make(map[model.GameID]bool),
+		rerun:           make(map[model.GameID]bool),
 	}
 }
 
 // CreateBotPlayer creates a new bot player and saves it to storage
-func (s *Service) CreateBotPlayer(ctx context.Context, displayName string, strategy string) (*model.Player, error) {
+func (s *Service) CreateBotPlayer(ctx context.Context, displayName string, strategy string, difficulty model.BotDifficulty) (*model.Player, error) {
 	player := &model.Player{
-		ID:          model.PlayerID("bot-" + s.random.String(PlayerIDLength, PlayerIDAlphabet)),
-		DisplayName: displayName,
-		IsGuest:     true,
-		IsBot:       true,
-		BotStrategy: strategy,
-		CreatedAt:   s.clock.Now(),
+		ID:            model.PlayerID("bot-" + s.random.String(PlayerIDLength, PlayerIDAlphabet)),
+		DisplayName:   displayName,
+		IsGuest:       true,
+		IsBot:         true,
+		BotStrategy:   strategy,
+		BotDifficulty: difficulty,
+		CreatedAt:     s.clock.Now(),
 	}
 
 	if err := s.storage.SavePlayer(ctx, player); err != nil {
@@ -94,12 +128,28 @@ func (s *Service) CreateBotPlayer(ctx context.Context, displayName string, strat
 	return player, nil
 }
 
-// AddBotToLobby creates a bot player and adds it to the lobby
-// Only the lobby host can add bots, and only while in waiting state
-func (s *Service) AddBotToLobby(ctx context.Context, code model.LobbyCode, requestingPlayerID model.PlayerID, strategy string) (*model.Player, error) {
+// AddBotToLobby creates a bot player and seats it in the lobby as a player
+// Only the lobby host can add bots, only while in waiting state, and only
+// while the lobby has a free seat.
+// Either strategy or difficulty may be left empty: the difficulty selects a
+// strategy, and an explicit strategy implies a difficulty.
+func (s *Service) AddBotToLobby(ctx context.Context, code model.LobbyCode, requestingPlayerID model.PlayerID, strategy string, difficulty model.BotDifficulty) (*model.Player, error) {
+	if difficulty != "" && !difficulty.IsValid() {
+		return nil, fmt.Errorf("unknown bot difficulty: %s", difficulty)
+	}
+	if strategy == "" {
+		strategy = difficulty.Strategy()
+	}
+	if difficulty == "" {
+		difficulty = model.BotDifficultyEasy
+		if strategy == model.BotStrategyGreedy {
+			difficulty = model.BotDifficultyHard
+		}
+	}
+
 	// Validate strategy
-	if _, ok := s.strategies[strategy]; !ok {
-		return nil, fmt.Errorf("unknown bot strategy: %s", strategy)
+	if _, ok := s.strategies.Get(strategy); !ok {
+		return nil, fmt.Errorf("%w: %s", model.ErrUnknownBotStrategy, strategy)
 	}
 
 	lob, err := s.lobbyController.GetLobby(ctx, code)
@@ -118,16 +168,22 @@ func (s *Service) AddBotToLobby(ctx context.Context, code model.LobbyCode, reque
 		return nil, model.ErrGameInProgress
 	}
 
-	// Count existing bots for naming
+	// Count existing bots for naming and the per-lobby limit
 	botCount := 0
 	for _, m := range lob.Members {
 		if m.Player.IsBot {
 			botCount++
 		}
 	}
+	if botCount >= s.config.MaxBots {
+		return nil, model.ErrTooManyBots
+	}
+	if lob.IsFull() {
+		return nil, model.ErrLobbyFull
+	}
 
 	displayName := fmt.Sprintf("Bot %d", botCount+1)
-	bot, err := s.CreateBotPlayer(ctx, displayName, strategy)
+	bot, err := s.CreateBotPlayer(ctx, displayName, strategy, difficulty)
 	if err != nil {
 		return nil, err
 	}
@@ -136,10 +192,11 @@ func (s *Service) AddBotToLobby(ctx context.Context, code model.LobbyCode, reque
 		return nil, err
 	}
 
-	s.logger.Info("bot added to lobby",
+	s.logger.InfoContext(ctx, "bot added to lobby",
 		slog.String("lobby_code", string(code)),
 		slog.String("bot_id", string(bot.ID)),
 		slog.String("bot_name", displayName),
+		slog.String("difficulty", string(difficulty)),
 	)
 
 	return bot, nil
@@ -182,113 +239,283 @@ func (s *Service) ProcessBotActions(ctx context.Context, gameID model.GameID) ([
 	var actions []BotAction
 
 	for range MaxBotIterations {
-		g, err := s.gameController.GetGame(ctx, gameID)
+		stepActions, err := s.nextBotAction(ctx, gameID, nil)
+		actions = append(actions, stepActions...)
 		if err != nil {
 			return actions, err
 		}
-
-		// Stop if game is finished
-		if g.State == model.GameStateScoring || g.State == model.GameStateAbandoned {
-			if g.State == model.GameStateScoring && len(actions) > 0 {
-				actions = append(actions, BotAction{Type: ActionGameComplete})
-			}
+		if len(stepActions) == 0 || isGameComplete(stepActions) {
 			break
 		}
+	}
+
+	return actions, nil
+}
+
+// PlayOutGame drives bot actions until the game is waiting on a human or has
+// reached scoring, so an all-bot game is played to completion in one call.
+// The number of steps is capped by the cells left to fill, returning
+// ErrBotActionsStalled if bots are somehow still acting past that.
+func (s *Service) PlayOutGame(ctx context.Context, gameID model.GameID) ([]BotAction, error) {
+	g, err := s.gameController.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each remaining turn needs one announcement and one placement per
+	// player, plus a final step to find there is nothing left to do
+	maxSteps := (g.TotalTurns()-g.CurrentTurn)*(len(g.Players)+1) + 1
+
+	var actions []BotAction
+	for range maxSteps {
+		stepActions, err := s.nextBotAction(ctx, gameID, nil)
+		actions = append(actions, stepActions...)
+		if err != nil {
+			return actions, err
+		}
+		if len(stepActions) == 0 || isGameComplete(stepActions) {
+			return actions, nil
+		}
+	}
+
+	return actions, model.ErrBotActionsStalled
+}
+
+// RunBotActions executes bot actions, calling onAction as each one happens.
+// With no think time configured this runs synchronously, exactly like
+// ProcessBotActions. Otherwise actions are spread out over time on a
+// background goroutine, and RunBotActions returns immediately.
+func (s *Service) RunBotActions(ctx context.Context, gameID model.GameID, onAction func(ctx context.Context, action BotAction)) {
+	if s.config.ThinkTime <= 0 {
+		actions, err := s.ProcessBotActions(ctx, gameID)
+		if err != nil {
+			s.logger.WarnContext(ctx, "bot actions failed",
+				slog.String("game_id", string(gameID)),
+				slog.String("error", err.Error()),
+			)
+		}
+		for _, action := range actions {
+			onAction(ctx, action)
+		}
+		return
+	}
 
-		if g.State == model.GameStateAnnouncing {
-			announcer := g.CurrentAnnouncer()
-			announcerPlayer, err := s.storage.GetPlayer(ctx, announcer)
+	s.mu.Lock()
+	if s.running[gameID] {
+		// The existing runner will pick up any new work before it exits
+		s.rerun[gameID] = true
+		s.mu.Unlock()
+		return
+	}
+	s.running[gameID] = true
+	s.mu.Unlock()
+
+	// Detach from the request so the runner outlives it
+	go s.runDelayed(context.WithoutCancel(ctx), gameID, onAction)
+}
+
+// runDelayed is the background loop for RunBotActions with think time
+func (s *Service) runDelayed(ctx context.Context, gameID model.GameID, onAction func(ctx context.Context, action BotAction)) {
+	think := func() { <-s.clock.After(s.config.ThinkTime) }
+
+	for {
+		for range MaxBotIterations {
+			actions, err := s.nextBotAction(ctx, gameID, think)
+			for _, action := range actions {
+				onAction(ctx, action)
+			}
 			if err != nil {
-				return actions, err
+				s.logger.WarnContext(ctx, "delayed bot actions failed",
+					slog.String("game_id", string(gameID)),
+					slog.String("error", err.Error()),
+				)
+				break
 			}
-
-			if !announcerPlayer.IsBot {
-				break // Human's turn to announce
+			if len(actions) == 0 || isGameComplete(actions) {
+				break
 			}
+		}
 
-			botStrategy := s.strategyForPlayer(announcerPlayer)
-			letter := botStrategy.ChooseLetter(g)
-			if err := s.gameController.AnnounceLetter(ctx, gameID, announcer, letter); err != nil {
-				return actions, err
-			}
+		s.mu.Lock()
+		if !s.rerun[gameID] {
+			delete(s.running, gameID)
+			s.mu.Unlock()
+			return
+		}
+		delete(s.rerun, gameID)
+		s.mu.Unlock()
+	}
+}
 
-			actions = append(actions, BotAction{
-				Type:     ActionAnnounce,
-				PlayerID: announcer,
-				Letter:   letter,
-			})
-			continue
+// nextBotAction performs a single bot action if one is due, calling wait (if
+// non-nil) just before acting. It returns the action taken followed by any
+// resulting turn or game completion, or nothing if no bot has anything to do.
+func (s *Service) nextBotAction(ctx context.Context, gameID model.GameID, wait func()) ([]BotAction, error) {
+	g, err := s.gameController.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch g.State {
+	case model.GameStateAnnouncing:
+		announcer := g.CurrentAnnouncer()
+		botStrategy, err := s.actingStrategy(ctx, g, announcer)
+		if err != nil {
+			return nil, err
+		}
+		if botStrategy == nil {
+			return nil, nil // Human's turn to announce
+		}
+		if g.AwaitingStartAcks(s.clock.Now()) {
+			return nil, nil // Humans still loading the game
 		}
 
-		if g.State == model.GameStatePlacing {
-			anyBotPlaced := false
-			for _, pid := range g.Players {
-				if g.Placements[pid] {
-					continue // Already placed
-				}
+		if wait != nil {
+			wait()
+		}
 
-				player, err := s.storage.GetPlayer(ctx, pid)
-				if err != nil {
-					return actions, err
-				}
-				if !player.IsBot {
-					continue // Human player
-				}
+		letter := botStrategy.ChooseLetter(g)
+		if err := s.gameController.AnnounceLetter(ctx, gameID, announcer, letter); err != nil {
+			return nil, err
+		}
 
-				playerBoard, err := s.boardService.GetBoard(ctx, gameID, pid)
-				if err != nil {
-					return actions, err
-				}
+		return []BotAction{{
+			Type:     ActionAnnounce,
+			PlayerID: announcer,
+			Letter:   letter,
+		}}, nil
+
+	case model.GameStatePlacing:
+		turn := g.CurrentTurn
+		for _, pid := range g.Players {
+			if g.Placements[pid] {
+				continue // Already placed
+			}
 
-				botStrategy := s.strategyForPlayer(player)
-				pos := botStrategy.ChoosePosition(g, playerBoard)
-				if err := s.gameController.PlaceLetter(ctx, gameID, pid, pos); err != nil {
-					return actions, err
-				}
+			botStrategy, err := s.actingStrategy(ctx, g, pid)
+			if err != nil {
+				return nil, err
+			}
+			if botStrategy == nil {
+				continue // Human player
+			}
 
-				actions = append(actions, BotAction{
-					Type:     ActionPlace,
-					PlayerID: pid,
-					Position: pos,
-				})
-				anyBotPlaced = true
+			if wait != nil {
+				wait()
 			}
 
-			if !anyBotPlaced {
-				break // Only humans left to place
+			playerBoard, err := s.boardService.GetBoard(ctx, gameID, pid)
+			if err != nil {
+				return nil, err
 			}
 
+			pos, ok := g.RequiredPosition()
+			if !ok {
+				pos = botStrategy.ChoosePosition(g, playerBoard)
+			}
+			if err := s.gameController.PlaceLetter(ctx, gameID, pid, pos); err != nil {
+				return nil, err
+			}
+			if g.RequireConfirm {
+				if err := s.gameController.ConfirmPlacement(ctx, gameID, pid); err != nil {
+					return nil, err
+				}
+			}
+
+			actions := []BotAction{{
+				Type:     ActionPlace,
+				PlayerID: pid,
+				Position: pos,
+			}}
+
 			// Re-read game to check if turn advanced
 			g, err = s.gameController.GetGame(ctx, gameID)
 			if err != nil {
 				return actions, err
 			}
 
-			if g.State == model.GameStateScoring {
+			// Simultaneous games go straight from one turn's placing to the next
+			switch {
+			case g.State == model.GameStateScoring:
 				actions = append(actions, BotAction{Type: ActionGameComplete})
-				break
-			}
-			if g.State == model.GameStateAnnouncing {
+			case g.CurrentTurn != turn:
 				actions = append(actions, BotAction{Type: ActionTurnComplete})
 			}
-			continue
+			return actions, nil
 		}
+	}
+
+	return nil, nil // Only humans left to act, or game finished
+}
+
+// isGameComplete returns true if the actions end with the game completing
+func isGameComplete(actions []BotAction) bool {
+	return len(actions) > 0 && actions[len(actions)-1].Type == ActionGameComplete
+}
 
-		break // Unknown state
+// actingStrategy returns the strategy to act with for a player, or nil if
+// they are a human still playing for themselves. Bots use their own
+// strategy, and players who left are played with the one the game recorded.
+func (s *Service) actingStrategy(ctx context.Context, g *model.Game, playerID model.PlayerID) (Strategy, error) {
+	if strategy, ok := g.BotStrategyFor(playerID); ok {
+		return s.strategyForPlayer(&model.Player{ID: playerID, BotStrategy: strategy}), nil
 	}
 
-	return actions, nil
+	player, err := s.storage.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if !player.IsBot {
+		return nil, nil
+	}
+	return s.strategyForPlayer(player), nil
 }
 
 // strategyForPlayer returns the strategy for a bot player, falling back to
 // the first registered strategy if the player's strategy is not found
 func (s *Service) strategyForPlayer(player *model.Player) Strategy {
-	if st, ok := s.strategies[player.BotStrategy]; ok {
+	if st, ok := s.strategies.Get(player.BotStrategy); ok {
 		return st
 	}
 	// Fallback: use first available strategy
-	for _, st := range s.strategies {
+	for _, info := range s.strategies.List() {
+		st, _ := s.strategies.Get(info.ID)
 		return st
 	}
 	return nil
 }
+
+// HintCount is the most placements a hint suggests
+const HintCount = 3
+
+// PlacementHints spends one of the player's hints for the current turn and
+// returns up to HintCount of the best cells for the announced letter on the
+// player's own board, best first, as ranked by the greedy strategy. It
+// returns no hints, without spending one, if no strategy can rank cells.
+func (s *Service) PlacementHints(ctx context.Context, gameID model.GameID, playerID model.PlayerID) ([]RankedPosition, error) {
+	strategy, ok := s.strategies.Get(model.BotStrategyGreedy)
+	if !ok {
+		return nil, nil
+	}
+	ranker, ok := strategy.(PositionRanker)
+	if !ok {
+		return nil, nil
+	}
+
+	g, err := s.gameController.UseHint(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	board, err := s.boardService.GetBoard(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := ranker.RankPositions(g, board)
+	return ranked[:min(HintCount, len(ranked))], nil
+}
+
+// Strategies lists the strategies bots can be added with
+func (s *Service) Strategies() []StrategyInfo {
+	return s.strategies.List()
+}
@@ -44,16 +44,16 @@ func (s *ServiceSuite) SetupTest() {
 	logger := testutil.NopLogger()
 	s.ctx = context.Background()
 
-	dictService := dictionary.New(s.store, logger)
-	s.boardService = board.New(s.store, logger)
-	scoringService := scoring.New(dictService)
-	s.gameController = game.NewController(s.store, s.boardService, scoringService, s.mockClock, s.mockRandom, logger)
-	s.lobbyController = lobby.NewController(s.store, s.gameController, s.mockClock, s.mockRandom, logger)
-
-	strategies := map[string]bot.Strategy{
-		model.BotStrategyRandom: bot.NewRandomStrategy(s.mockRandom),
-	}
-	s.botService = bot.NewService(s.store, s.lobbyController, s.gameController, s.boardService, strategies, s.mockClock, s.mockRandom, logger)
+	dictService := dictionary.New(s.store, model.DefaultAlphabet(), logger)
+	s.boardService = board.New(s.store, model.DefaultAlphabet(), logger)
+	scoringService := scoring.New(dictService, scoring.DefaultConfig())
+	s.gameController = game.NewController(s.store, s.boardService, scoringService, s.mockClock, s.mockRandom, game.DefaultConfig(), logger)
+	s.lobbyController = lobby.NewController(s.store, s.gameController, s.mockClock, s.mockRandom, lobby.DefaultConfig(), logger)
+
+	strategies := bot.NewRegistry()
+	strategies.Register(model.BotStrategyRandom, "Random", bot.NewRandomStrategy(s.mockRandom))
+	strategies.Register(model.BotStrategyGreedy, "Greedy", bot.NewGreedyStrategy(dictService, scoringService, s.mockRandom))
+	s.botService = bot.NewService(s.store, s.lobbyController, s.gameController, s.boardService, strategies, s.mockClock, s.mockRandom, bot.DefaultConfig(), logger)
 }
 
 func (s *ServiceSuite) createPlayer(id, name string) model.Player {
@@ -70,7 +70,7 @@ func (s *ServiceSuite) createPlayer(id, name string) model.Player {
 func (s *ServiceSuite) TestCreateBotPlayer() {
 	s.mockRandom.QueueString("abcdefghijklmnop")
 
-	player, err := s.botService.CreateBotPlayer(s.ctx, "Bot 1", model.BotStrategyRandom)
+	player, err := s.botService.CreateBotPlayer(s.ctx, "Bot 1", model.BotStrategyRandom, model.BotDifficultyEasy)
 	s.Require().NoError(err)
 
 	s.Equal("Bot 1", player.DisplayName)
@@ -93,7 +93,7 @@ func (s *ServiceSuite) TestAddBotToLobby() {
 	s.Require().NoError(err)
 
 	s.mockRandom.QueueString("abcdefghijklmnop") // bot player ID
-	botPlayer, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 	s.Require().NoError(err)
 
 	s.Equal("Bot 1", botPlayer.DisplayName)
@@ -112,9 +112,9 @@ func (s *ServiceSuite) TestAddBotToLobby_InvalidStrategy() {
 	host := s.createPlayer("host", "Host")
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
-	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "nonexistent")
+	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "nonexistent", "")
 	s.Error(err)
-	s.Contains(err.Error(), "unknown bot strategy")
+	s.ErrorIs(err, model.ErrUnknownBotStrategy)
 }
 
 func (s *ServiceSuite) TestAddBotToLobby_NotHost() {
@@ -125,7 +125,7 @@ func (s *ServiceSuite) TestAddBotToLobby_NotHost() {
 	nonHost := s.createPlayer("other", "Other")
 	_ = s.lobbyController.JoinLobby(s.ctx, lob.Code, nonHost)
 
-	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, nonHost.ID, model.BotStrategyRandom)
+	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, nonHost.ID, model.BotStrategyRandom, "")
 	s.ErrorIs(err, model.ErrNotHost)
 }
 
@@ -136,7 +136,7 @@ func (s *ServiceSuite) TestAddBotToLobby_GameInProgress() {
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	_, _ = s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
 
-	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 	s.ErrorIs(err, model.ErrGameInProgress)
 }
 
@@ -146,23 +146,80 @@ func (s *ServiceSuite) TestAddBotToLobby_SequentialNaming() {
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("bot1botid_abcdef")
-	bot1, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	bot1, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 	s.Require().NoError(err)
 	s.Equal("Bot 1", bot1.DisplayName)
 
 	s.mockRandom.QueueString("bot2botid_abcdef")
-	bot2, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	bot2, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 	s.Require().NoError(err)
 	s.Equal("Bot 2", bot2.DisplayName)
 }
 
+func (s *ServiceSuite) TestAddBotToLobby_LobbyFull() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2, MaxPlayers: 1}))
+
+	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.ErrorIs(err, model.ErrLobbyFull)
+
+	updated, _ := s.lobbyController.GetLobby(s.ctx, lob.Code)
+	s.Len(updated.Members, 1)
+}
+
+func (s *ServiceSuite) TestAddBotToLobby_SeatsBotWhenJoinersSpectate() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2, LateJoinRole: model.RoleSpectator}))
+
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	botPlayer, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.Require().NoError(err)
+
+	updated, _ := s.lobbyController.GetLobby(s.ctx, lob.Code)
+	s.Equal(model.RolePlayer, updated.GetMember(botPlayer.ID).Role)
+}
+
+func (s *ServiceSuite) TestAddBotToLobby_MaxBots() {
+	cfg := bot.DefaultConfig()
+	cfg.MaxBots = 2
+	strategies := bot.NewRegistry()
+	strategies.Register(model.BotStrategyRandom, "Random", bot.NewRandomStrategy(s.mockRandom))
+	botService := bot.NewService(s.store, s.lobbyController, s.gameController, s.boardService, strategies, s.mockClock, s.mockRandom, cfg, testutil.NopLogger())
+
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	// Human members don't count towards the limit
+	_ = s.lobbyController.JoinLobby(s.ctx, lob.Code, s.createPlayer("other", "Other"))
+
+	s.mockRandom.QueueString("bot1botid_abcdef", "bot2botid_abcdef")
+	bot1, err := botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.Require().NoError(err)
+	_, err = botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.Require().NoError(err)
+
+	_, err = botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.ErrorIs(err, model.ErrTooManyBots)
+
+	// Removing a bot re-opens a slot
+	s.Require().NoError(botService.RemoveBotFromLobby(s.ctx, lob.Code, host.ID, bot1.ID))
+	s.mockRandom.QueueString("bot3botid_abcdef")
+	_, err = botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.NoError(err)
+}
+
 func (s *ServiceSuite) TestRemoveBotFromLobby() {
 	s.mockRandom.QueueString("LOBBY1")
 	host := s.createPlayer("host", "Host")
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("abcdefghijklmnop")
-	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	err := s.botService.RemoveBotFromLobby(s.ctx, lob.Code, host.ID, botPlayer.ID)
 	s.Require().NoError(err)
@@ -189,7 +246,7 @@ func (s *ServiceSuite) TestProcessBotActions_BotAnnounces() {
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("abcdefghijklmnop")
-	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
@@ -218,7 +275,7 @@ func (s *ServiceSuite) TestProcessBotActions_BotPlaces() {
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("abcdefghijklmnop")
-	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
@@ -248,13 +305,39 @@ func (s *ServiceSuite) TestProcessBotActions_BotPlaces() {
 	}
 }
 
+func (s *ServiceSuite) TestProcessBotActions_BotPlacesInRequiredCell() {
+	s.mockRandom.QueueString("LOBBY1", "GAME01")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	botPlayer, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+
+	cfg := model.LobbyConfig{GridSize: 2, PlacementMode: model.PlacementModeSequential}
+	s.Require().NoError(s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, cfg))
+	g, err := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(host.ID, g.CurrentAnnouncer())
+
+	s.Require().NoError(s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, 'A'))
+
+	// The strategy would pick the last cell, but only the first is allowed
+	s.mockRandom.QueueIntn(3)
+	actions, err := s.botService.ProcessBotActions(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(actions)
+	s.Equal(bot.ActionPlace, actions[0].Type)
+	s.Equal(botPlayer.ID, actions[0].PlayerID)
+	s.Equal(model.Position{Row: 0, Col: 0}, actions[0].Position)
+}
+
 func (s *ServiceSuite) TestProcessBotActions_HumanAnnouncer() {
 	s.mockRandom.QueueString("LOBBY1", "GAME01")
 	host := s.createPlayer("host", "Host")
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("abcdefghijklmnop")
-	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
@@ -292,10 +375,10 @@ func (s *ServiceSuite) TestProcessBotActions_CascadingTurns() {
 	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
 
 	s.mockRandom.QueueString("bot1abcdefghijkl")
-	bot1, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	bot1, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	s.mockRandom.QueueString("bot2abcdefghijkl")
-	bot2, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	bot2, _ := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	s.mockRandom.QueueString("GAME01")
@@ -349,7 +432,7 @@ func (s *ServiceSuite) TestProcessBotActions_MixedPlayers() {
 	_ = s.lobbyController.JoinLobby(s.ctx, lob.Code, human2)
 
 	s.mockRandom.QueueString("bot1abcdefghijkl")
-	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom)
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
 
 	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
 	s.mockRandom.QueueString("GAME01")
@@ -373,3 +456,279 @@ func (s *ServiceSuite) TestProcessBotActions_MixedPlayers() {
 	updatedGame, _ := s.gameController.GetGame(s.ctx, g.ID)
 	s.Equal(model.GameStatePlacing, updatedGame.State)
 }
+
+// PlayOutGame tests
+
+func (s *ServiceSuite) TestPlayOutGame_AllBotGameReachesScoring() {
+	// Host spectates while 2 bots play a 3x3 game
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	s.mockRandom.QueueString("bot1abcdefghijkl")
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+	s.mockRandom.QueueString("bot2abcdefghijkl")
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+
+	s.Require().NoError(s.lobbyController.SetRole(s.ctx, lob.Code, host.ID, model.RoleSpectator))
+	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 3})
+	s.mockRandom.QueueString("GAME01")
+	g, err := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+	s.Require().NoError(err)
+	s.Len(g.Players, 2)
+
+	// Unqueued random values default to 0 (letter A, first empty cell)
+	actions, err := s.botService.PlayOutGame(s.ctx, g.ID)
+	s.Require().NoError(err)
+
+	counts := make(map[bot.BotActionType]int)
+	for _, action := range actions {
+		counts[action.Type]++
+	}
+	s.Equal(9, counts[bot.ActionAnnounce])
+	s.Equal(18, counts[bot.ActionPlace])
+	s.Equal(8, counts[bot.ActionTurnComplete])
+	s.Equal(1, counts[bot.ActionGameComplete])
+	s.Equal(bot.ActionGameComplete, actions[len(actions)-1].Type)
+
+	updatedGame, _ := s.gameController.GetGame(s.ctx, g.ID)
+	s.Equal(model.GameStateScoring, updatedGame.State)
+}
+
+func (s *ServiceSuite) TestPlayOutGame_SameSeedReplaysSameGame() {
+	playSeeded := func(n string) [][][]rune {
+		s.mockRandom.QueueString("LOBBY" + n)
+		host := s.createPlayer("host"+n, "Host")
+		lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+		s.mockRandom.QueueString("bot1abcdefghijk" + n)
+		_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+		s.mockRandom.QueueString("bot2abcdefghijk" + n)
+		_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+		s.Require().NoError(s.lobbyController.SetRole(s.ctx, lob.Code, host.ID, model.RoleSpectator))
+		s.Require().NoError(s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 3, Seed: 42}))
+
+		s.mockRandom.QueueString("GAME0" + n)
+		g, err := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+		s.Require().NoError(err)
+		s.Equal(int64(42), g.Seed)
+		_, err = s.botService.PlayOutGame(s.ctx, g.ID)
+		s.Require().NoError(err)
+
+		var cells [][][]rune
+		for _, playerID := range g.Players {
+			b, err := s.boardService.GetBoard(s.ctx, g.ID, playerID)
+			s.Require().NoError(err)
+			cells = append(cells, b.Cells)
+		}
+		return cells
+	}
+
+	first := playSeeded("1")
+	s.Equal(first, playSeeded("2"))
+
+	// The seed, not the unqueued mock (always 0), chose the moves
+	s.NotEqual([][]rune{{'A', 'A', 'A'}, {'A', 'A', 'A'}, {'A', 'A', 'A'}}, first[0])
+}
+
+func (s *ServiceSuite) TestPlayOutGame_StopsWhenWaitingOnHuman() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	s.mockRandom.QueueString("bot1abcdefghijkl")
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, model.BotStrategyRandom, "")
+
+	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	s.mockRandom.QueueString("GAME01")
+	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+
+	// Host is the first announcer, so bots have nothing to do yet
+	actions, err := s.botService.PlayOutGame(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.Empty(actions)
+
+	_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, 'A')
+	actions, err = s.botService.PlayOutGame(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.Require().Len(actions, 1)
+	s.Equal(bot.ActionPlace, actions[0].Type)
+
+	updatedGame, _ := s.gameController.GetGame(s.ctx, g.ID)
+	s.Equal(model.GameStatePlacing, updatedGame.State)
+}
+
+// Leaver auto-fill tests
+
+func (s *ServiceSuite) TestLeaverBoardCompletedByBotAndScored() {
+	logger := testutil.NopLogger()
+	dictService := dictionary.New(s.store, model.DefaultAlphabet(), logger)
+	s.Require().NoError(dictService.LoadWords([]string{"at", "ta"}))
+	scoringService := scoring.New(dictService, scoring.DefaultConfig())
+	gameCfg := game.DefaultConfig()
+	gameCfg.LeaverStrategy = model.BotStrategyRandom
+	gameController := game.NewController(s.store, s.boardService, scoringService, s.mockClock, s.mockRandom, gameCfg, logger)
+	lobbyController := lobby.NewController(s.store, gameController, s.mockClock, s.mockRandom, lobby.DefaultConfig(), logger)
+	strategies := bot.NewRegistry()
+	strategies.Register(model.BotStrategyRandom, "Random", bot.NewRandomStrategy(s.mockRandom))
+	botService := bot.NewService(s.store, lobbyController, gameController, s.boardService, strategies, s.mockClock, s.mockRandom, bot.DefaultConfig(), logger)
+
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	leaver := s.createPlayer("leaver", "Leaver")
+	lob, _ := lobbyController.CreateLobby(s.ctx, host)
+	s.Require().NoError(lobbyController.JoinLobby(s.ctx, lob.Code, leaver))
+	_ = lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	s.mockRandom.QueueString("GAME01")
+	g, err := lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(gameController.AnnounceLetter(s.ctx, g.ID, host.ID, 'A'))
+	s.Require().NoError(lobbyController.LeaveLobby(s.ctx, lob.Code, leaver.ID))
+
+	// The leaver keeps their seat, handed to a bot
+	updated, _ := gameController.GetGame(s.ctx, g.ID)
+	s.Equal([]model.PlayerID{host.ID, leaver.ID}, updated.Players)
+	strategy, ok := updated.BotStrategyFor(leaver.ID)
+	s.True(ok)
+	s.Equal(model.BotStrategyRandom, strategy)
+
+	// The host plays on while the bot announces and places for the leaver
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for range 20 {
+		if updated.State == model.GameStateScoring {
+			break
+		}
+		if updated.State == model.GameStateAnnouncing && updated.CurrentAnnouncer() == host.ID {
+			s.Require().NoError(gameController.AnnounceLetter(s.ctx, g.ID, host.ID, 'T'))
+		} else if updated.State == model.GameStatePlacing && !updated.Placements[host.ID] {
+			s.Require().NoError(gameController.PlaceLetter(s.ctx, g.ID, host.ID, positions[updated.CurrentTurn]))
+		}
+		_, err := botService.ProcessBotActions(s.ctx, g.ID)
+		s.Require().NoError(err)
+		updated, _ = gameController.GetGame(s.ctx, g.ID)
+	}
+	s.Require().Equal(model.GameStateScoring, updated.State)
+
+	leaverBoard, err := s.boardService.GetBoard(s.ctx, g.ID, leaver.ID)
+	s.Require().NoError(err)
+	s.True(leaverBoard.IsFull())
+
+	scores, err := gameController.GetFinalScores(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.Len(scores, 2)
+	var scoredPlayers []model.PlayerID
+	for _, score := range scores {
+		scoredPlayers = append(scoredPlayers, score.PlayerID)
+	}
+	s.ElementsMatch([]model.PlayerID{host.ID, leaver.ID}, scoredPlayers)
+}
+
+// Difficulty tests
+
+func (s *ServiceSuite) TestAddBotToLobby_HardDifficultySelectsGreedyStrategy() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	botPlayer, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "", model.BotDifficultyHard)
+	s.Require().NoError(err)
+
+	s.Equal(model.BotStrategyGreedy, botPlayer.BotStrategy)
+	s.Equal(model.BotDifficultyHard, botPlayer.BotDifficulty)
+}
+
+func (s *ServiceSuite) TestAddBotToLobby_DefaultsToEasyRandom() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	botPlayer, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "", "")
+	s.Require().NoError(err)
+
+	s.Equal(model.BotStrategyRandom, botPlayer.BotStrategy)
+	s.Equal(model.BotDifficultyEasy, botPlayer.BotDifficulty)
+}
+
+func (s *ServiceSuite) TestAddBotToLobby_InvalidDifficulty() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+
+	_, err := s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "", "impossible")
+	s.Error(err)
+}
+
+// Think time tests
+
+func (s *ServiceSuite) TestRunBotActions_SynchronousWithoutThinkTime() {
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	_, _ = s.botService.AddBotToLobby(s.ctx, lob.Code, host.ID, "", "")
+	_ = s.lobbyController.SetRole(s.ctx, lob.Code, host.ID, model.RoleSpectator)
+	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	s.mockRandom.QueueString("GAME01")
+	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+
+	var actions []bot.BotAction
+	s.botService.RunBotActions(s.ctx, g.ID, func(_ context.Context, action bot.BotAction) {
+		actions = append(actions, action)
+	})
+
+	// The bot plays the whole game before RunBotActions returns
+	s.Require().NotEmpty(actions)
+	s.Equal(bot.ActionGameComplete, actions[len(actions)-1].Type)
+}
+
+func (s *ServiceSuite) TestRunBotActions_WithThinkTimeCompletesGameOverTime() {
+	strategies := bot.NewRegistry()
+	strategies.Register(model.BotStrategyRandom, "Random", bot.NewRandomStrategy(s.mockRandom))
+	delayed := bot.NewService(s.store, s.lobbyController, s.gameController, s.boardService, strategies,
+		s.mockClock, s.mockRandom, bot.Config{ThinkTime: time.Second}, testutil.NopLogger())
+
+	s.mockRandom.QueueString("LOBBY1")
+	host := s.createPlayer("host", "Host")
+	lob, _ := s.lobbyController.CreateLobby(s.ctx, host)
+	s.mockRandom.QueueString("abcdefghijklmnop")
+	_, _ = delayed.AddBotToLobby(s.ctx, lob.Code, host.ID, "", "")
+	_ = s.lobbyController.SetRole(s.ctx, lob.Code, host.ID, model.RoleSpectator)
+	_ = s.lobbyController.UpdateConfig(s.ctx, lob.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	s.mockRandom.QueueString("GAME01")
+	g, _ := s.lobbyController.StartGame(s.ctx, lob.Code, host.ID)
+
+	actions := make(chan bot.BotAction, 32)
+	delayed.RunBotActions(s.ctx, g.ID, func(_ context.Context, action bot.BotAction) {
+		actions <- action
+	})
+
+	// Nothing happens until the bot has finished thinking
+	s.Eventually(func() bool { return s.mockClock.Waiters() == 1 }, time.Second, time.Millisecond)
+	current, _ := s.gameController.GetGame(s.ctx, g.ID)
+	s.Equal(model.GameStateAnnouncing, current.State)
+
+	// 2x2 grid: 4 turns of announce + place
+	for range 8 {
+		s.Require().Eventually(func() bool { return s.mockClock.Waiters() == 1 }, time.Second, time.Millisecond)
+		s.mockClock.Advance(time.Second)
+	}
+
+	var last bot.BotAction
+	s.Require().Eventually(func() bool {
+		for {
+			select {
+			case last = <-actions:
+				if last.Type == bot.ActionGameComplete {
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, time.Second, time.Millisecond)
+
+	final, _ := s.gameController.GetGame(s.ctx, g.ID)
+	s.Equal(model.GameStateScoring, final.State)
+}
@@ -1,6 +1,12 @@
 package bot
 
-import "github.com/mcoot/crosswordgame-go2/internal/model"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
 
 // Strategy defines how a bot chooses letters and positions
 type Strategy interface {
@@ -9,3 +15,37 @@ type Strategy interface {
 	// ChoosePosition selects a position to place a letter on the board
 	ChoosePosition(game *model.Game, board *model.Board) model.Position
 }
+
+// RankedPosition is a cell a strategy could place the current letter in,
+// with the board score that placement would give
+type RankedPosition struct {
+	Position model.Position
+	Score    int
+}
+
+// PositionRanker is implemented by strategies that can rank every
+// placement, not just choose one
+type PositionRanker interface {
+	RankPositions(game *model.Game, board *model.Board) []RankedPosition
+}
+
+// announcerRack returns the letters the game's announcer may choose from,
+// or nil if the game doesn't use racks
+func announcerRack(game *model.Game) []rune {
+	if game.RackSize <= 0 {
+		return nil
+	}
+	return game.Racks[game.CurrentAnnouncer()]
+}
+
+// letterRandom returns the random source for the announcer's letter choice
+// this turn, which is fixed by the game's seed when it has one
+func letterRandom(fallback random.Random, game *model.Game) random.Random {
+	return random.ForSeed(fallback, game.Seed, fmt.Sprintf("bot-letter/%d", game.CurrentTurn))
+}
+
+// positionRandom returns the random source for a bot's placement this turn,
+// which is fixed by the game's seed and the bot's seat when it has a seed
+func positionRandom(fallback random.Random, game *model.Game, board *model.Board) random.Random {
+	return random.ForSeed(fallback, game.Seed, fmt.Sprintf("bot-position/%d/%d", game.CurrentTurn, slices.Index(game.Players, board.PlayerID)))
+}
@@ -8,6 +8,10 @@ import (
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/mocks"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
+	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
+	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
+	"github.com/mcoot/crosswordgame-go2/internal/testutil"
 )
 
 type StrategySuite struct {
@@ -39,6 +43,17 @@ func (s *StrategySuite) TestChooseLetter_ReturnsValidLetter() {
 	s.Equal('M', letter)
 }
 
+func (s *StrategySuite) TestChooseLetter_UsesAnnouncerRack() {
+	game := &model.Game{
+		Players:  []model.PlayerID{"bot1"},
+		RackSize: 3,
+		Racks:    map[model.PlayerID][]rune{"bot1": {'Q', 'X', 'Z'}},
+	}
+	s.mockRandom.QueueIntn(1)
+
+	s.Equal('X', s.strategy.ChooseLetter(game))
+}
+
 func (s *StrategySuite) TestChoosePosition_EmptyBoard() {
 	board := model.NewBoard("game1", "player1", 3)
 	// 9 empty cells, random picks index 4
@@ -71,3 +86,80 @@ func (s *StrategySuite) TestChoosePosition_OnlyOneEmpty() {
 	pos := s.strategy.ChoosePosition(&model.Game{}, board)
 	s.Equal(model.Position{Row: 1, Col: 1}, pos)
 }
+
+func (s *StrategySuite) TestChoosePosition_AdjacentPlacement() {
+	board := model.NewBoard("game1", "player1", 3)
+	board.Set(model.Position{Row: 0, Col: 0}, 'A')
+	// Only (0,1) and (1,0) touch the existing letter
+	s.mockRandom.QueueIntn(1)
+
+	pos := s.strategy.ChoosePosition(&model.Game{AdjacentPlacement: true}, board)
+	s.Equal(model.Position{Row: 1, Col: 0}, pos)
+}
+
+type GreedyStrategySuite struct {
+	suite.Suite
+	mockRandom  *mocks.MockRandom
+	dictService *dictionary.Service
+	strategy    *bot.GreedyStrategy
+}
+
+func TestGreedyStrategySuite(t *testing.T) {
+	suite.Run(t, new(GreedyStrategySuite))
+}
+
+func (s *GreedyStrategySuite) SetupTest() {
+	logger := testutil.NopLogger()
+	s.mockRandom = mocks.NewMockRandom()
+	s.dictService = dictionary.New(memory.New(), model.DefaultAlphabet(), logger)
+	scoringService := scoring.New(s.dictService, scoring.DefaultConfig())
+	s.strategy = bot.NewGreedyStrategy(s.dictService, scoringService, s.mockRandom)
+}
+
+func (s *GreedyStrategySuite) TestChooseLetter_PicksMostCommonLetter() {
+	s.Require().NoError(s.dictService.LoadWords([]string{"eel", "bee", "tee"}))
+	s.mockRandom.QueueIntn(0) // Most common
+
+	letter := s.strategy.ChooseLetter(&model.Game{})
+	s.Equal('E', letter)
+}
+
+func (s *GreedyStrategySuite) TestChooseLetter_PicksMostCommonRackLetter() {
+	s.Require().NoError(s.dictService.LoadWords([]string{"eel", "bee", "tee"}))
+	game := &model.Game{
+		Players:  []model.PlayerID{"bot1"},
+		RackSize: 3,
+		Racks:    map[model.PlayerID][]rune{"bot1": {'Q', 'B', 'T'}},
+	}
+
+	letter := s.strategy.ChooseLetter(game)
+	s.Contains([]rune{'B', 'T'}, letter)
+}
+
+func (s *GreedyStrategySuite) TestChooseLetter_FallsBackToRandomWithoutDictionary() {
+	s.mockRandom.QueueIntn(3) // 'D'
+
+	letter := s.strategy.ChooseLetter(&model.Game{})
+	s.Equal('D', letter)
+}
+
+func (s *GreedyStrategySuite) TestChoosePosition_CompletesWord() {
+	s.Require().NoError(s.dictService.LoadWords([]string{"cat"}))
+	board := model.NewBoard("game1", "player1", 3)
+	board.Set(model.Position{Row: 2, Col: 0}, 'C')
+	board.Set(model.Position{Row: 2, Col: 1}, 'A')
+
+	pos := s.strategy.ChoosePosition(&model.Game{CurrentLetter: 'T'}, board)
+	s.Equal(model.Position{Row: 2, Col: 2}, pos)
+}
+
+func (s *GreedyStrategySuite) TestChoosePosition_BreaksTiesRandomly() {
+	s.Require().NoError(s.dictService.LoadWords([]string{"cat"}))
+	board := model.NewBoard("game1", "player1", 2)
+	board.Set(model.Position{Row: 0, Col: 0}, 'Q')
+	// No placement scores, so all three empty cells tie
+	s.mockRandom.QueueIntn(2)
+
+	pos := s.strategy.ChoosePosition(&model.Game{CurrentLetter: 'Z'}, board)
+	s.Equal(model.Position{Row: 1, Col: 1}, pos)
+}
@@ -5,29 +5,55 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/storage"
 )
 
+// MinWordLength is the shortest word that counts as a dictionary word
+const MinWordLength = 2
+
+// Names given to word lists that weren't loaded from a file
+const (
+	StoredName = "stored" // Loaded from storage
+	CustomName = "custom" // Loaded directly with LoadWords
+)
+
 // Service provides dictionary/word validation functionality
 type Service struct {
-	storage storage.Storage
-	logger  *slog.Logger
+	storage  storage.Storage
+	alphabet model.Alphabet
+	logger   *slog.Logger
 
-	mu     sync.RWMutex
-	words  map[string]struct{}
-	loaded bool
+	mu           sync.RWMutex
+	words        map[string]struct{}
+	letterScores map[rune]float64
+	loaded       bool
+	name         string
+	loadedAt     time.Time
+}
+
+// Info describes the loaded word list
+type Info struct {
+	Name      string    // File name without extension, StoredName or CustomName
+	WordCount int       // Distinct words after normalization
+	Loaded    bool      // False until a word list has been loaded
+	LoadedAt  time.Time // When the current word list replaced the last one
 }
 
 // New creates a new DictionaryService
-func New(storage storage.Storage, logger *slog.Logger) *Service {
+// Words are normalized with the alphabet so they match letters placed on boards
+func New(storage storage.Storage, alphabet model.Alphabet, logger *slog.Logger) *Service {
 	return &Service{
-		storage: storage,
-		logger:  logger,
-		words:   make(map[string]struct{}),
+		storage:  storage,
+		alphabet: alphabet,
+		logger:   logger,
+		words:    make(map[string]struct{}),
 	}
 }
 
@@ -40,7 +66,7 @@ func (s *Service) LoadFromStorage(ctx context.Context) error {
 		)
 		return err
 	}
-	if err := s.loadWords(words); err != nil {
+	if err := s.loadWords(words, StoredName); err != nil {
 		return err
 	}
 	s.logger.Info("dictionary loaded from storage",
@@ -49,8 +75,27 @@ func (s *Service) LoadFromStorage(ctx context.Context) error {
 	return nil
 }
 
+// progressInterval is how many words are read between progress reports
+const progressInterval = 10000
+
+// LoadProgress reports how far through a dictionary file loading has got
+type LoadProgress struct {
+	Words      int   // Words read so far
+	BytesRead  int64 // Bytes of the file consumed so far
+	TotalBytes int64 // Size of the file
+}
+
 // LoadFromFile loads dictionary words from a file (one word per line)
-func (s *Service) LoadFromFile(ctx context.Context, path string) (err error) {
+func (s *Service) LoadFromFile(ctx context.Context, path string) error {
+	return s.LoadFromFileWithProgress(ctx, path, nil)
+}
+
+// LoadFromFileWithProgress loads dictionary words from a file (one word per
+// line), calling onProgress periodically and once loading finishes.
+// The file is read line by line and the new words only replace the current
+// dictionary once the whole file has been read, so a partial load is never
+// visible to callers. onProgress may be nil.
+func (s *Service) LoadFromFileWithProgress(ctx context.Context, path string, onProgress func(LoadProgress)) (err error) {
 	file, err := os.Open(path)
 	if err != nil {
 		s.logger.Error("failed to open dictionary file",
@@ -65,12 +110,33 @@ func (s *Service) LoadFromFile(ctx context.Context, path string) (err error) {
 		}
 	}()
 
+	var progress LoadProgress
+	if info, err := file.Stat(); err == nil {
+		progress.TotalBytes = info.Size()
+	}
+
 	var words []string
+	set := make(map[string]struct{})
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		word := strings.TrimSpace(scanner.Text())
-		if word != "" {
-			words = append(words, word)
+		line := scanner.Bytes()
+		progress.BytesRead += int64(len(line)) + 1 // Include the newline
+
+		word := strings.TrimSpace(string(line))
+		if word == "" {
+			continue
+		}
+		words = append(words, word)
+		set[s.alphabet.NormalizeWord(word)] = struct{}{}
+
+		if len(words)%progressInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			progress.Words = len(words)
+			if onProgress != nil {
+				onProgress(progress)
+			}
 		}
 	}
 	if err := scanner.Err(); err != nil {
@@ -89,8 +155,14 @@ func (s *Service) LoadFromFile(ctx context.Context, path string) (err error) {
 		return err
 	}
 
-	if err := s.loadWords(words); err != nil {
-		return err
+	s.swapWords(set, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+
+	progress.Words = len(words)
+	if progress.TotalBytes > 0 {
+		progress.BytesRead = progress.TotalBytes
+	}
+	if onProgress != nil {
+		onProgress(progress)
 	}
 
 	s.logger.Info("dictionary loaded from file",
@@ -103,26 +175,69 @@ func (s *Service) LoadFromFile(ctx context.Context, path string) (err error) {
 
 // LoadWords directly loads a slice of words (useful for testing)
 func (s *Service) LoadWords(words []string) error {
-	return s.loadWords(words)
+	return s.loadWords(words, CustomName)
 }
 
-func (s *Service) loadWords(words []string) error {
+func (s *Service) loadWords(words []string, name string) error {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		// Store lowercase (and accent-folded if configured) for case-insensitive matching
+		set[s.alphabet.NormalizeWord(word)] = struct{}{}
+	}
+	s.swapWords(set, name)
+	return nil
+}
+
+// swapWords replaces the dictionary with a fully built word set named name
+// Letter scores are computed before taking the lock so readers are only
+// blocked for the swap itself.
+func (s *Service) swapWords(words map[string]struct{}, name string) {
+	letterScores := computeLetterScores(words, s.alphabet.Letters())
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.words = words
+	s.letterScores = letterScores
+	s.loaded = true
+	s.name = name
+	s.loadedAt = time.Now()
+}
 
-	s.words = make(map[string]struct{}, len(words))
-	for _, word := range words {
-		// Store lowercase for case-insensitive matching
-		s.words[strings.ToLower(word)] = struct{}{}
+// computeLetterScores counts how often each alphabet letter appears across all
+// words and normalizes so the most frequent letter scores 1.0
+func computeLetterScores(words map[string]struct{}, letters []rune) map[rune]float64 {
+	counts := make(map[rune]int, len(letters))
+	for _, r := range letters {
+		counts[r] = 0
 	}
-	s.loaded = true
-	return nil
+	maxCount := 0
+	for word := range words {
+		for _, r := range strings.ToUpper(word) {
+			if _, ok := counts[r]; !ok {
+				continue
+			}
+			counts[r]++
+			if counts[r] > maxCount {
+				maxCount = counts[r]
+			}
+		}
+	}
+
+	scores := make(map[rune]float64, len(letters))
+	for _, r := range letters {
+		if maxCount > 0 {
+			scores[r] = float64(counts[r]) / float64(maxCount)
+		} else {
+			scores[r] = 0
+		}
+	}
+	return scores
 }
 
 // IsValidWord checks if a word exists in the dictionary
-// Words must be at least 2 characters
+// Words must be at least MinWordLength characters
 func (s *Service) IsValidWord(word string) bool {
-	if len(word) < 2 {
+	if utf8.RuneCountInString(word) < MinWordLength {
 		return false
 	}
 
@@ -133,7 +248,7 @@ func (s *Service) IsValidWord(word string) bool {
 		return false
 	}
 
-	_, ok := s.words[strings.ToLower(word)]
+	_, ok := s.words[s.alphabet.NormalizeWord(word)]
 	return ok
 }
 
@@ -151,8 +266,39 @@ func (s *Service) WordCount() int {
 	return len(s.words)
 }
 
+// Info describes the loaded word list, for operators checking which one
+// the server is using
+func (s *Service) Info() Info {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Info{
+		Name:      s.name,
+		WordCount: len(s.words),
+		Loaded:    s.loaded,
+		LoadedAt:  s.loadedAt,
+	}
+}
+
+// LetterScores returns the normalized frequency (0.0-1.0) of each alphabet letter
+// across the loaded dictionary. This is informational only and is not used for validation.
+// Returns nil if the dictionary is not loaded.
+func (s *Service) LetterScores() map[rune]float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.loaded {
+		return nil
+	}
+
+	scores := make(map[rune]float64, len(s.letterScores))
+	for r, score := range s.letterScores {
+		scores[r] = score
+	}
+	return scores
+}
+
 // FindAllValidWords finds all valid words in a line of letters
-// Returns all valid substrings of length >= 2
+// Returns all valid substrings of length >= MinWordLength
 func (s *Service) FindAllValidWords(letters []rune) []ValidWord {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -165,9 +311,9 @@ func (s *Service) FindAllValidWords(letters []rune) []ValidWord {
 	n := len(letters)
 
 	for start := 0; start < n; start++ {
-		for end := start + 2; end <= n; end++ {
+		for end := start + MinWordLength; end <= n; end++ {
 			word := string(letters[start:end])
-			if _, ok := s.words[strings.ToLower(word)]; ok {
+			if _, ok := s.words[s.alphabet.NormalizeWord(word)]; ok {
 				results = append(results, ValidWord{
 					Word:  word,
 					Start: start,
@@ -192,9 +338,12 @@ type ServiceInterface interface {
 	IsValidWord(word string) bool
 	IsLoaded() bool
 	WordCount() int
+	Info() Info
+	LetterScores() map[rune]float64
 	FindAllValidWords(letters []rune) []ValidWord
 	LoadFromStorage(ctx context.Context) error
 	LoadFromFile(ctx context.Context, path string) error
+	LoadFromFileWithProgress(ctx context.Context, path string, onProgress func(LoadProgress)) error
 	LoadWords(words []string) error
 }
 
@@ -2,10 +2,14 @@ package dictionary
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
 	"github.com/mcoot/crosswordgame-go2/internal/testutil"
 )
@@ -23,7 +27,7 @@ func TestServiceSuite(t *testing.T) {
 
 func (s *ServiceSuite) SetupTest() {
 	s.storage = memory.New()
-	s.service = New(s.storage, testutil.NopLogger())
+	s.service = New(s.storage, model.DefaultAlphabet(), testutil.NopLogger())
 	s.ctx = context.Background()
 }
 
@@ -41,6 +45,20 @@ func (s *ServiceSuite) TestLoadWords() {
 	s.Equal(3, s.service.WordCount())
 }
 
+func (s *ServiceSuite) TestInfoDescribesLoadedFile() {
+	s.False(s.service.Info().Loaded)
+
+	path := filepath.Join(s.T().TempDir(), "tiny.txt")
+	s.Require().NoError(os.WriteFile(path, []byte("apple\nbanana\ncherry\ndate\n"), 0o644))
+	s.Require().NoError(s.service.LoadFromFile(s.ctx, path))
+
+	info := s.service.Info()
+	s.Equal("tiny", info.Name)
+	s.Equal(4, info.WordCount)
+	s.True(info.Loaded)
+	s.False(info.LoadedAt.IsZero())
+}
+
 func (s *ServiceSuite) TestIsValidWordAfterLoading() {
 	words := []string{"apple", "banana", "cherry"}
 	_ = s.service.LoadWords(words)
@@ -62,6 +80,38 @@ func (s *ServiceSuite) TestIsValidWordCaseInsensitive() {
 	s.True(s.service.IsValidWord("BANANA"))
 }
 
+func (s *ServiceSuite) TestFoldAccentsMatchesUnaccentedLetters() {
+	service := New(s.storage, model.Alphabet{FoldAccents: true}, testutil.NopLogger())
+	_ = service.LoadWords([]string{"été", "garçon"})
+
+	s.True(service.IsValidWord("ETE"))
+	s.True(service.IsValidWord("été"))
+	s.True(service.IsValidWord("GARCON"))
+	s.Len(service.FindAllValidWords([]rune("XETE")), 1)
+}
+
+func (s *ServiceSuite) TestExtraLettersKeepAccents() {
+	service := New(s.storage, model.Alphabet{ExtraLetters: "É"}, testutil.NopLogger())
+	_ = service.LoadWords([]string{"été"})
+
+	s.True(service.IsValidWord("ÉTÉ"))
+	s.False(service.IsValidWord("ETE"))
+	s.Contains(service.LetterScores(), 'É')
+}
+
+func (s *ServiceSuite) TestFoldAccentsWithExtraLettersFindsBoardWords() {
+	service := New(s.storage, model.Alphabet{FoldAccents: true, ExtraLetters: "É"}, testutil.NopLogger())
+	_ = service.LoadWords([]string{"été"})
+
+	// É stays on the board as an extra letter but the dictionary folds it
+	letter, err := service.alphabet.NormalizeLetter('é')
+	s.Require().NoError(err)
+	s.Equal('É', letter)
+	results := service.FindAllValidWords([]rune("XÉTÉ"))
+	s.Require().Len(results, 1)
+	s.Equal("ÉTÉ", results[0].Word)
+}
+
 func (s *ServiceSuite) TestIsValidWordRequiresMinLength() {
 	words := []string{"a", "ab", "abc"}
 	_ = s.service.LoadWords(words)
@@ -94,6 +144,84 @@ func (s *ServiceSuite) TestLoadFromStorageWhenEmpty() {
 	s.ErrorIs(err, ErrDictionaryNotLoaded)
 }
 
+// writeWordFile writes count distinct words to a temp file and returns its path
+func (s *ServiceSuite) writeWordFile(count int) string {
+	var sb strings.Builder
+	for i := range count {
+		word := ""
+		for n := i; ; n /= 26 {
+			word += string(rune('a' + n%26))
+			if n < 26 {
+				break
+			}
+		}
+		sb.WriteString("zz" + word + "\n")
+	}
+	path := filepath.Join(s.T().TempDir(), "words.txt")
+	s.Require().NoError(os.WriteFile(path, []byte(sb.String()), 0o600))
+	return path
+}
+
+func (s *ServiceSuite) TestLoadFromFileWithProgressReportsProgress() {
+	path := s.writeWordFile(25000)
+
+	var reports []LoadProgress
+	err := s.service.LoadFromFileWithProgress(s.ctx, path, func(p LoadProgress) {
+		reports = append(reports, p)
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(reports, 3)
+	s.Equal(10000, reports[0].Words)
+	s.Equal(20000, reports[1].Words)
+	s.Less(reports[0].BytesRead, reports[1].BytesRead)
+
+	final := reports[2]
+	s.Equal(25000, final.Words)
+	s.Positive(final.TotalBytes)
+	s.Equal(final.TotalBytes, final.BytesRead)
+
+	s.True(s.service.IsLoaded())
+	s.Equal(25000, s.service.WordCount())
+	s.True(s.service.IsValidWord("zzab"))
+}
+
+func (s *ServiceSuite) TestLoadFromFileHidesPartialLoad() {
+	s.Require().NoError(s.service.LoadWords([]string{"apple"}))
+	path := s.writeWordFile(25000)
+
+	err := s.service.LoadFromFileWithProgress(s.ctx, path, func(p LoadProgress) {
+		if p.Words < 25000 {
+			// Mid-load, the previous dictionary is still in effect
+			s.True(s.service.IsValidWord("apple"))
+			s.False(s.service.IsValidWord("zzab"))
+		}
+	})
+	s.Require().NoError(err)
+
+	s.False(s.service.IsValidWord("apple"))
+	s.True(s.service.IsValidWord("zzab"))
+}
+
+func (s *ServiceSuite) TestLoadFromFileCancelledKeepsPreviousWords() {
+	s.Require().NoError(s.service.LoadWords([]string{"apple"}))
+	path := s.writeWordFile(25000)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+	err := s.service.LoadFromFile(ctx, path)
+	s.ErrorIs(err, context.Canceled)
+
+	s.True(s.service.IsValidWord("apple"))
+	s.Equal(1, s.service.WordCount())
+}
+
+func (s *ServiceSuite) TestLoadFromFileMissingFile() {
+	err := s.service.LoadFromFile(s.ctx, filepath.Join(s.T().TempDir(), "missing.txt"))
+	s.Error(err)
+	s.False(s.service.IsLoaded())
+}
+
 func (s *ServiceSuite) TestFindAllValidWords() {
 	words := []string{"at", "ate", "eat", "tea", "eating"}
 	_ = s.service.LoadWords(words)
@@ -136,3 +264,30 @@ func (s *ServiceSuite) TestFindAllValidWordsSingleLetter() {
 	results := s.service.FindAllValidWords([]rune{'A'})
 	s.Empty(results) // Single letter is too short
 }
+
+func (s *ServiceSuite) TestLetterScoresRanksCommonLettersAboveRare() {
+	_ = s.service.LoadWords([]string{"eat", "tea", "ate", "seat", "east", "quiz"})
+
+	scores := s.service.LetterScores()
+
+	s.Len(scores, 26)
+	s.Equal(1.0, scores['E'])
+	s.Greater(scores['E'], scores['Q'])
+	s.Greater(scores['T'], scores['Z'])
+	s.Equal(0.0, scores['X'])
+}
+
+func (s *ServiceSuite) TestLetterScoresRecomputedOnReload() {
+	_ = s.service.LoadWords([]string{"zzz", "za"})
+	s.Equal(1.0, s.service.LetterScores()['Z'])
+
+	_ = s.service.LoadWords([]string{"eee", "ea"})
+
+	scores := s.service.LetterScores()
+	s.Equal(1.0, scores['E'])
+	s.Equal(0.0, scores['Z'])
+}
+
+func (s *ServiceSuite) TestLetterScoresWhenNotLoaded() {
+	s.Nil(s.service.LetterScores())
+}
@@ -2,8 +2,16 @@ package game
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"log/slog"
-	"unicode"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
@@ -13,6 +21,71 @@ import (
 	"github.com/mcoot/crosswordgame-go2/internal/storage"
 )
 
+// MissingBoardPolicy controls how scoring handles a player whose board is
+// missing, e.g. because it expired from storage before the game finished
+type MissingBoardPolicy string
+
+const (
+	MissingBoardEmpty MissingBoardPolicy = "empty" // Score the player on an empty board (default)
+	MissingBoardError MissingBoardPolicy = "error" // Fail scoring with ErrBoardNotFound
+)
+
+// Config holds configuration for the game controller
+type Config struct {
+	// MissingBoards controls how final scores treat players without a board
+	MissingBoards MissingBoardPolicy
+
+	// AdjacentPlacement requires each letter after a board's first to be
+	// placed next to an existing letter, crossword style
+	AdjacentPlacement bool
+
+	// AnnounceTimeout is how long an announcer has to announce before the
+	// turn passes to the next player (0 disables the timeout)
+	AnnounceTimeout time.Duration
+
+	// RackSize restricts each announcer to a rack of this many random
+	// letters, refilled as they are used (0 allows any letter)
+	RackSize int
+
+	// BlockedCells are blocked on every board, for puzzle grids with holes.
+	// Cells outside a game's grid are ignored.
+	BlockedCells []model.Position
+
+	// RequireStartAck holds the first announce of each game until every
+	// player has called AckStart, so slow-loading clients don't miss it
+	RequireStartAck bool
+	// StartAckTimeout lets the first announce go ahead this long after the
+	// game starts even if some players haven't acknowledged (0 waits for
+	// everyone)
+	StartAckTimeout time.Duration
+
+	// LeaverStrategy keeps the seat of a player who leaves mid-game, and has
+	// the bot service play out their board with this bot strategy. Empty
+	// removes them from the game instead.
+	LeaverStrategy string
+
+	// MaxGameDuration abandons games still running this long after they
+	// were created, so games nobody finishes don't linger (0 is unlimited)
+	MaxGameDuration time.Duration
+
+	// StealBonus awards a bonus to the first player each turn whose
+	// placement completes a row spelling a dictionary word. Off by default,
+	// since it costs a dictionary lookup per placement.
+	StealBonus bool
+
+	// HintsPerTurn is how many placement hints each player may ask for per
+	// turn (0 falls back to DefaultConfig)
+	HintsPerTurn int
+}
+
+// DefaultConfig returns default game configuration
+func DefaultConfig() Config {
+	return Config{
+		MissingBoards: MissingBoardEmpty,
+		HintsPerTurn:  1,
+	}
+}
+
 // Controller manages game state machine and turn flow
 type Controller struct {
 	storage        storage.Storage
@@ -20,7 +93,12 @@ type Controller struct {
 	scoringService *scoring.Service
 	clock          clock.Clock
 	random         random.Random
+	cfg            Config
 	logger         *slog.Logger
+
+	// mu serialises read-modify-write updates to game records, since bots
+	// may act from background goroutines alongside player requests
+	mu sync.Mutex
 }
 
 // NewController creates a new GameController
@@ -30,26 +108,64 @@ func NewController(
 	scoringService *scoring.Service,
 	clock clock.Clock,
 	random random.Random,
+	cfg Config,
 	logger *slog.Logger,
 ) *Controller {
+	if cfg.MissingBoards == "" {
+		cfg.MissingBoards = DefaultConfig().MissingBoards
+	}
+	if cfg.HintsPerTurn == 0 {
+		cfg.HintsPerTurn = DefaultConfig().HintsPerTurn
+	}
 	return &Controller{
 		storage:        storage,
 		boardService:   boardService,
 		scoringService: scoringService,
 		clock:          clock,
 		random:         random,
+		cfg:            cfg,
 		logger:         logger,
 	}
 }
 
 // CreateGame initializes a new game with the given players
 func (c *Controller) CreateGame(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, gridSize int) (*model.Game, error) {
+	return c.CreateGameWithConfig(ctx, lobbyCode, players, model.LobbyConfig{GridSize: gridSize})
+}
+
+// CreateGameWithConfig initializes a new game using the lobby's configured options
+func (c *Controller) CreateGameWithConfig(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, config model.LobbyConfig) (*model.Game, error) {
+	return c.CreateGameWithID(ctx, lobbyCode, players, config, "")
+}
+
+// CreateGameWithID is CreateGameWithConfig with a caller-chosen game ID, so
+// retried creates can be detected. An empty ID generates a random one.
+// Returns ErrGameExists if the ID is taken.
+func (c *Controller) CreateGameWithID(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, config model.LobbyConfig, gameID model.GameID) (*model.Game, error) {
+	gridSize := config.GridSize
 	if len(players) == 0 {
 		return nil, model.ErrInsufficientPlayers
 	}
 
+	if gameID != "" {
+		if err := model.ValidateGameID(gameID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Each player gets exactly one board, so a repeated ID would clobber it
+	seen := make(map[model.PlayerID]bool, len(players))
+	for _, playerID := range players {
+		if seen[playerID] {
+			return nil, model.ErrDuplicatePlayer
+		}
+		seen[playerID] = true
+	}
+
 	now := c.clock.Now()
-	gameID := model.GameID(c.random.String(12, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"))
+	if gameID == "" {
+		gameID = model.GameID(c.random.String(12, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"))
+	}
 
 	game := &model.Game{
 		ID:            gameID,
@@ -64,45 +180,370 @@ func (c *Controller) CreateGame(ctx context.Context, lobbyCode model.LobbyCode,
 		TurnStartedAt: now,
 		CreatedAt:     now,
 		UpdatedAt:     now,
-	}
 
-	// Create boards for all players
-	for _, playerID := range players {
-		if _, err := c.boardService.CreateBoard(ctx, gameID, playerID, gridSize); err != nil {
+		RequireConfirm:      config.RequireConfirm,
+		PendingPlacement:    make(map[model.PlayerID]model.Position),
+		PlacementLatency:    make(map[model.PlayerID]time.Duration),
+		RequireEdgeAnchored: config.RequireEdgeAnchored,
+		DelayedReveal:       config.DelayedReveal,
+		PlacementMode:       config.PlacementMode,
+		Mode:                config.Mode,
+		HideLetterHistory:   config.HideLetterHistory,
+		Seed:                config.Seed,
+		StealBonus:          c.cfg.StealBonus,
+		AdjacentPlacement:   c.cfg.AdjacentPlacement,
+		AnnounceDeadline:    c.announceDeadline(now),
+		SpectateToken:       generateSpectateToken(gameID),
+		RackSize:            c.cfg.RackSize,
+		Racks:               make(map[model.PlayerID][]rune),
+		BlockedCells:        blockedCellsForGrid(c.cfg.BlockedCells, gridSize),
+	}
+	if game.IsSimultaneous() {
+		// Nobody announces, so there is nothing to time out or deal racks to
+		game.AnnounceDeadline = time.Time{}
+		game.RackSize = 0
+		c.fillBag(game)
+		c.drawLetter(game, now)
+	} else if c.cfg.RequireStartAck {
+		if err := c.startAckBarrier(ctx, game, now); err != nil {
 			return nil, err
 		}
 	}
+	c.fillRack(game)
 
-	if err := c.storage.SaveGame(ctx, game); err != nil {
-		c.logger.Error("failed to save game",
-			slog.String("game_id", string(game.ID)),
-			slog.String("error", err.Error()),
-		)
+	// Claim the ID before creating boards, so a taken ID never has its
+	// boards overwritten
+	if err := c.storage.CreateGame(ctx, game); err != nil {
+		if !errors.Is(err, model.ErrGameExists) {
+			c.logger.ErrorContext(ctx, "failed to save game",
+				slog.String("game_id", string(game.ID)),
+				slog.String("error", err.Error()),
+			)
+		}
 		return nil, err
 	}
 
-	c.logger.Info("game created",
+	// Create boards for all players
+	for _, playerID := range players {
+		if _, err := c.boardService.CreateBoardWithBlocked(ctx, gameID, playerID, gridSize, game.BlockedCells); err != nil {
+			_ = c.storage.DeleteBoardsForGame(ctx, gameID)
+			_ = c.storage.DeleteGame(ctx, gameID)
+			return nil, err
+		}
+	}
+
+	c.logger.InfoContext(ctx, "game created",
 		slog.String("game_id", string(gameID)),
 		slog.String("lobby_code", string(lobbyCode)),
 		slog.Int("player_count", len(players)),
 		slog.Int("grid_size", gridSize),
 	)
 
+	if game.IsSimultaneous() {
+		c.recordLetterDrawn(ctx, game)
+	}
+
 	return game, nil
 }
 
+// startAckBarrier holds the game's first announce until its players have
+// acknowledged the start. Bots have nothing to load, so they start acked.
+func (c *Controller) startAckBarrier(ctx context.Context, game *model.Game, now time.Time) error {
+	game.StartAcks = make(map[model.PlayerID]bool)
+	for _, playerID := range game.Players {
+		player, err := c.storage.GetPlayer(ctx, playerID)
+		if err != nil {
+			return err
+		}
+		if player.IsBot {
+			game.StartAcks[playerID] = true
+		}
+	}
+	if c.cfg.StartAckTimeout > 0 {
+		game.StartAckDeadline = now.Add(c.cfg.StartAckTimeout)
+		// The announcer's time only starts once they are able to announce
+		game.AnnounceDeadline = c.announceDeadline(game.StartAckDeadline)
+	}
+	return nil
+}
+
+// AckStart records that a player has loaded the game and is ready for the
+// first announce. Returns true if theirs was the last acknowledgement the
+// game was waiting on.
+func (c *Controller) AckStart(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return false, err
+	}
+	if !slices.Contains(game.Players, playerID) {
+		return false, model.ErrPlayerNotFound
+	}
+
+	now := c.clock.Now()
+	if !game.AwaitingStartAcks(now) || game.StartAcks[playerID] {
+		return false, nil
+	}
+
+	game.StartAcks[playerID] = true
+	allReady := game.StartAcked()
+	if allReady {
+		game.TurnStartedAt = now
+		game.AnnounceDeadline = c.announceDeadline(now)
+	}
+	game.UpdatedAt = now
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return false, err
+	}
+
+	c.logger.InfoContext(ctx, "player acknowledged game start",
+		slog.String("game_id", string(game.ID)),
+		slog.String("player_id", string(playerID)),
+		slog.Bool("all_ready", allReady),
+	)
+	return allReady, nil
+}
+
+// WatchStartAckTimeout calls onReady once the game's start acknowledgement
+// deadline passes with players still unacknowledged, since the first
+// announce is then allowed. It does nothing when there is no deadline.
+func (c *Controller) WatchStartAckTimeout(game *model.Game, onReady func()) {
+	if game.StartAckDeadline.IsZero() {
+		return
+	}
+
+	go func() {
+		ctx := context.Background()
+		<-c.clock.After(game.StartAckDeadline.Sub(c.clock.Now()))
+
+		current, err := c.storage.GetGame(ctx, game.ID)
+		if err != nil {
+			return
+		}
+		if current.State == model.GameStateAnnouncing && current.CurrentTurn == 0 && !current.StartAcked() {
+			onReady()
+		}
+	}()
+}
+
+// ImportGame saves a game exported from another instance into the given
+// lobby, along with its boards. A game whose ID is already taken here is
+// given a new ID and spectate token, so an import never overwrites an
+// existing game. If a board can't be saved, the game and its boards are
+// removed again. Returns the game as saved.
+func (c *Controller) ImportGame(ctx context.Context, lobbyCode model.LobbyCode, game *model.Game, boards []*model.Board) (*model.Game, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, b := range boards {
+		if !slices.Contains(game.Players, b.PlayerID) {
+			return nil, fmt.Errorf("%w: board for player %s who isn't in the game", model.ErrInvalidLobbyExport, b.PlayerID)
+		}
+		if !boardFitsGrid(b, game.GridSize) {
+			return nil, fmt.Errorf("%w: board for player %s isn't %dx%d", model.ErrInvalidLobbyExport, b.PlayerID, game.GridSize, game.GridSize)
+		}
+	}
+
+	// Claim the game's ID, or a new one if it is taken here
+	imported := *game
+	imported.LobbyCode = lobbyCode
+	for {
+		err := c.storage.CreateGame(ctx, &imported)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, model.ErrGameExists) {
+			return nil, err
+		}
+		imported.ID = model.GameID(c.random.String(12, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"))
+		imported.SpectateToken = generateSpectateToken(imported.ID)
+	}
+
+	for _, b := range boards {
+		board := *b
+		board.GameID = imported.ID
+		if err := c.storage.SaveBoard(ctx, &board); err != nil {
+			_ = c.storage.DeleteBoardsForGame(ctx, imported.ID)
+			_ = c.storage.DeleteGame(ctx, imported.ID)
+			return nil, err
+		}
+	}
+
+	c.logger.InfoContext(ctx, "game imported",
+		slog.String("game_id", string(imported.ID)),
+		slog.String("exported_id", string(game.ID)),
+		slog.String("lobby_code", string(lobbyCode)),
+	)
+	return &imported, nil
+}
+
+// boardFitsGrid reports whether a board's cells make up a size x size grid
+func boardFitsGrid(b *model.Board, size int) bool {
+	if b.Size != size || len(b.Cells) != size {
+		return false
+	}
+	for _, row := range b.Cells {
+		if len(row) != size {
+			return false
+		}
+	}
+	return true
+}
+
+// blockedCellsForGrid returns the distinct cells that fall inside a grid of
+// the given size
+func blockedCellsForGrid(cells []model.Position, gridSize int) []model.Position {
+	var blocked []model.Position
+	for _, pos := range cells {
+		if pos.Row < 0 || pos.Row >= gridSize || pos.Col < 0 || pos.Col >= gridSize || slices.Contains(blocked, pos) {
+			continue
+		}
+		blocked = append(blocked, pos)
+	}
+	return blocked
+}
+
 // GetGame retrieves a game by ID
 func (c *Controller) GetGame(ctx context.Context, gameID model.GameID) (*model.Game, error) {
 	return c.storage.GetGame(ctx, gameID)
 }
 
+// GetGameBySpectateToken retrieves the game a share link points at
+// The token is only valid while the game is in progress.
+func (c *Controller) GetGameBySpectateToken(ctx context.Context, token string) (*model.Game, error) {
+	gameID, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, model.ErrInvalidSpectateToken
+	}
+
+	game, err := c.storage.GetGame(ctx, model.GameID(gameID))
+	if err != nil {
+		if errors.Is(err, model.ErrGameNotFound) {
+			return nil, model.ErrInvalidSpectateToken
+		}
+		return nil, err
+	}
+
+	if game.SpectateToken == "" || subtle.ConstantTimeCompare([]byte(game.SpectateToken), []byte(token)) != 1 {
+		return nil, model.ErrInvalidSpectateToken
+	}
+	if game.State == model.GameStateScoring || game.State == model.GameStateAbandoned {
+		return nil, model.ErrInvalidSpectateToken
+	}
+	return game, nil
+}
+
+// generateSpectateToken creates a share token for a game
+// The token embeds the game ID so it can be resolved without an index.
+func generateSpectateToken(gameID model.GameID) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return string(gameID) + "." + base64.RawURLEncoding.EncodeToString(b)
+}
+
 // AnnounceLetter handles the announcer selecting a letter for the turn
 func (c *Controller) AnnounceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, letter rune) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.announceLetter(ctx, gameID, playerID, letter)
+}
+
+// AnnounceAndPlace announces a letter and places it on the announcer's own
+// board in one action. The result is the same as calling AnnounceLetter then
+// PlaceLetter, except that nothing is announced if the position is invalid.
+// The result's game may have moved on to the next turn.
+func (c *Controller) AnnounceAndPlace(ctx context.Context, gameID model.GameID, playerID model.PlayerID, letter rune, pos model.Position) (PlacementResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return PlacementResult{}, err
+	}
+	if err := validateAnnouncingPlayer(game, playerID); err != nil {
+		return PlacementResult{}, err
+	}
+	if game.AwaitingStartAcks(c.clock.Now()) {
+		return PlacementResult{}, model.ErrNotAllReady
+	}
+
+	// Check the placement up front so a bad position doesn't leave the
+	// letter announced with the announcer unable to place it
+	boardObj, err := c.boardService.GetBoard(ctx, gameID, playerID)
+	if err != nil {
+		return PlacementResult{}, err
+	}
+	if err := c.boardService.ValidatePlacement(boardObj, pos); err != nil {
+		return PlacementResult{}, err
+	}
+	if err := validatePlacementMode(game, pos); err != nil {
+		return PlacementResult{}, err
+	}
+	if !game.AllowsAdjacency(boardObj, pos) {
+		return PlacementResult{}, model.ErrPlacementNotAdjacent
+	}
+
+	if err := c.announceLetter(ctx, gameID, playerID, letter); err != nil {
+		return PlacementResult{}, err
+	}
+	return c.placeLetter(ctx, gameID, playerID, pos)
+}
+
+// announceLetter announces a letter; the caller must hold c.mu
+func (c *Controller) announceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, letter rune) error {
 	game, err := c.storage.GetGame(ctx, gameID)
 	if err != nil {
 		return err
 	}
 
+	if err := validateAnnouncingPlayer(game, playerID); err != nil {
+		return err
+	}
+	if game.AwaitingStartAcks(c.clock.Now()) {
+		return model.ErrNotAllReady
+	}
+
+	// Validate letter
+	normalized, err := c.boardService.NormalizeLetter(letter)
+	if err != nil {
+		return err
+	}
+	if game.RackSize > 0 {
+		if !game.RackHas(playerID, normalized) {
+			return model.ErrLetterNotInRack
+		}
+		useRackLetter(game, playerID, normalized)
+		c.fillRack(game)
+	}
+
+	// Update game state
+	now := c.clock.Now()
+	game.RecordAnnouncement(normalized)
+	game.State = model.GameStatePlacing
+	game.Placements = make(map[model.PlayerID]bool)
+	game.PendingPlacement = make(map[model.PlayerID]model.Position)
+	game.LetterAnnouncedAt = now
+	game.AnnounceDeadline = time.Time{}
+	game.UpdatedAt = now
+
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return err
+	}
+
+	c.recordEvent(ctx, game, model.EventLetterAnnounced, playerID, model.LetterAnnouncedPayload{
+		Letter:      game.CurrentLetter,
+		AnnouncerID: playerID,
+		TurnNumber:  game.CurrentTurn,
+	})
+
+	return nil
+}
+
+// validateAnnouncingPlayer checks the game is waiting for this player to announce
+func validateAnnouncingPlayer(game *model.Game, playerID model.PlayerID) error {
 	// Validate game state
 	if game.State == model.GameStateScoring {
 		return model.ErrGameComplete
@@ -119,27 +560,168 @@ func (c *Controller) AnnounceLetter(ctx context.Context, gameID model.GameID, pl
 		return model.ErrNotPlayerTurn
 	}
 
-	// Validate letter
-	if err := board.ValidateLetter(letter); err != nil {
-		return err
+	return nil
+}
+
+// PlacementResult reports what a placement did to its game
+type PlacementResult struct {
+	// Game is the game after the placement
+	Game *model.Game
+	// TurnAdvanced is true when the placement was the turn's last, so the
+	// next turn has started or the game has moved to scoring
+	TurnAdvanced bool
+}
+
+// PlaceLetter handles a player placing the announced letter on their board
+// If the game requires confirmation, the placement is only staged until ConfirmPlacement
+func (c *Controller) PlaceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) error {
+	_, err := c.PlaceLetterWithResult(ctx, gameID, playerID, pos)
+	return err
+}
+
+// PlaceLetterWithResult is PlaceLetter, also reporting whether the
+// placement completed the turn
+func (c *Controller) PlaceLetterWithResult(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) (PlacementResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.placeLetter(ctx, gameID, playerID, pos)
+}
+
+// placeLetter places or stages the announced letter; the caller must hold c.mu
+func (c *Controller) placeLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) (PlacementResult, error) {
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return PlacementResult{}, err
 	}
 
-	// Update game state
-	game.CurrentLetter = unicode.ToUpper(letter)
-	game.State = model.GameStatePlacing
-	game.Placements = make(map[model.PlayerID]bool)
+	if err := validatePlacingPlayer(game, playerID); err != nil {
+		return PlacementResult{}, err
+	}
+	if err := validatePlacementMode(game, pos); err != nil {
+		return PlacementResult{}, err
+	}
+
+	// Get and update board
+	boardObj, err := c.boardService.GetBoard(ctx, gameID, playerID)
+	if err != nil {
+		return PlacementResult{}, err
+	}
+	if !game.AllowsAdjacency(boardObj, pos) {
+		return PlacementResult{}, model.ErrPlacementNotAdjacent
+	}
+
+	if game.RequireConfirm {
+		// Stage only - re-staging replaces any previous pending position
+		if err := c.boardService.ValidatePlacement(boardObj, pos); err != nil {
+			return PlacementResult{}, err
+		}
+		if game.PendingPlacement == nil {
+			game.PendingPlacement = make(map[model.PlayerID]model.Position)
+		}
+		game.PendingPlacement[playerID] = pos
+		game.UpdatedAt = c.clock.Now()
+		if err := c.storage.SaveGame(ctx, game); err != nil {
+			return PlacementResult{}, err
+		}
+		return PlacementResult{Game: game}, nil
+	}
+
+	return c.commitPlacement(ctx, game, boardObj, pos)
+}
+
+// UseHint spends one of the player's placement hints for the current turn
+// and returns the game, so the hint can be worked out from it. Returns
+// ErrHintLimitReached once the player has used HintsPerTurn hints this turn.
+func (c *Controller) UseHint(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (*model.Game, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePlacingPlayer(game, playerID); err != nil {
+		return nil, err
+	}
+
+	if game.HintsUsed == nil || game.HintsTurn != game.CurrentTurn {
+		game.HintsUsed = make(map[model.PlayerID]int)
+		game.HintsTurn = game.CurrentTurn
+	}
+	if game.HintsUsed[playerID] >= c.cfg.HintsPerTurn {
+		return nil, model.ErrHintLimitReached
+	}
+	game.HintsUsed[playerID]++
 	game.UpdatedAt = c.clock.Now()
 
-	return c.storage.SaveGame(ctx, game)
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return nil, err
+	}
+	return game, nil
 }
 
-// PlaceLetter handles a player placing the announced letter on their board
-func (c *Controller) PlaceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) error {
+// ConfirmPlacement commits a player's staged placement
+func (c *Controller) ConfirmPlacement(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error {
+	_, err := c.ConfirmPlacementWithResult(ctx, gameID, playerID)
+	return err
+}
+
+// ConfirmPlacementWithResult is ConfirmPlacement, also reporting whether
+// the placement completed the turn
+func (c *Controller) ConfirmPlacementWithResult(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (PlacementResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return PlacementResult{}, err
+	}
+
+	if err := validatePlacingPlayer(game, playerID); err != nil {
+		return PlacementResult{}, err
+	}
+
+	pos, ok := game.PendingPlacement[playerID]
+	if !ok {
+		return PlacementResult{}, model.ErrNoPendingPlacement
+	}
+
+	boardObj, err := c.boardService.GetBoard(ctx, gameID, playerID)
+	if err != nil {
+		return PlacementResult{}, err
+	}
+
+	delete(game.PendingPlacement, playerID)
+	return c.commitPlacement(ctx, game, boardObj, pos)
+}
+
+// CancelPlacement discards a player's staged placement
+func (c *Controller) CancelPlacement(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	game, err := c.storage.GetGame(ctx, gameID)
 	if err != nil {
 		return err
 	}
 
+	if err := validatePlacingPlayer(game, playerID); err != nil {
+		return err
+	}
+
+	if !game.HasPendingPlacement(playerID) {
+		return model.ErrNoPendingPlacement
+	}
+
+	delete(game.PendingPlacement, playerID)
+	game.UpdatedAt = c.clock.Now()
+	return c.storage.SaveGame(ctx, game)
+}
+
+// validatePlacingPlayer checks the game is accepting placements from this player
+func validatePlacingPlayer(game *model.Game, playerID model.PlayerID) error {
 	// Validate game state
 	if game.State == model.GameStateScoring {
 		return model.ErrGameComplete
@@ -168,55 +750,415 @@ func (c *Controller) PlaceLetter(ctx context.Context, gameID model.GameID, playe
 		return model.ErrAlreadyPlaced
 	}
 
-	// Get and update board
-	boardObj, err := c.boardService.GetBoard(ctx, gameID, playerID)
-	if err != nil {
-		return err
+	return nil
+}
+
+// validatePlacementMode checks the position is allowed by the game's placement mode
+func validatePlacementMode(game *model.Game, pos model.Position) error {
+	if required, ok := game.RequiredPosition(); ok && pos != required {
+		return model.ErrPositionNotAllowed
 	}
+	return nil
+}
 
+// commitPlacement writes the current letter to the board and marks the player as placed
+func (c *Controller) commitPlacement(ctx context.Context, game *model.Game, boardObj *model.Board, pos model.Position) (PlacementResult, error) {
 	if err := c.boardService.PlaceLetter(ctx, boardObj, game.CurrentLetter, pos); err != nil {
-		return err
+		return PlacementResult{}, err
 	}
+	c.recordEvent(ctx, game, model.EventLetterPlaced, boardObj.PlayerID, model.LetterPlacedPayload{
+		PlayerID: boardObj.PlayerID,
+		Position: pos,
+		Letter:   game.CurrentLetter,
+	})
 
-	// Mark as placed
-	game.Placements[playerID] = true
-	game.UpdatedAt = c.clock.Now()
+	// Mark as placed and record how long the player took
+	now := c.clock.Now()
+	c.checkStealBonus(ctx, game, boardObj, pos, now)
+	game.Placements[boardObj.PlayerID] = true
+	if game.PlacementLatency == nil {
+		game.PlacementLatency = make(map[model.PlayerID]time.Duration)
+	}
+	game.PlacementLatency[boardObj.PlayerID] += now.Sub(game.LetterAnnouncedAt)
+	game.UpdatedAt = now
 
 	// Check if all players have placed
 	if game.AllPlayersPlaced() {
-		return c.advanceTurn(ctx, game)
+		if err := c.advanceTurn(ctx, game); err != nil {
+			return PlacementResult{}, err
+		}
+		return PlacementResult{Game: game, TurnAdvanced: true}, nil
 	}
 
-	return c.storage.SaveGame(ctx, game)
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return PlacementResult{}, err
+	}
+	return PlacementResult{Game: game}, nil
+}
+
+// checkStealBonus awards the turn's steal bonus if it's still unclaimed and
+// this placement completed a row spelling a word
+func (c *Controller) checkStealBonus(ctx context.Context, game *model.Game, boardObj *model.Board, pos model.Position, now time.Time) {
+	if !game.StealBonus || game.StealClaimed(game.CurrentTurn) {
+		return
+	}
+	word, ok := boardObj.CompletedRow(pos.Row)
+	if !ok || !c.scoringService.IsWord(word) {
+		return
+	}
+	game.StealBonuses = append(game.StealBonuses, model.StealBonus{
+		PlayerID: boardObj.PlayerID,
+		Turn:     game.CurrentTurn,
+		Word:     word,
+		At:       now,
+	})
+	c.logger.InfoContext(ctx, "steal bonus awarded",
+		slog.String("game_id", string(game.ID)),
+		slog.String("player_id", string(boardObj.PlayerID)),
+		slog.String("word", word),
+	)
 }
 
 // advanceTurn moves to the next turn or completes the game
 func (c *Controller) advanceTurn(ctx context.Context, game *model.Game) error {
+	now := c.clock.Now()
+	game.TurnDurations = append(game.TurnDurations, now.Sub(game.TurnStartedAt))
+	completedTurn := game.CurrentTurn
 	game.CurrentTurn++
 
 	if game.CurrentTurn >= game.TotalTurns() {
 		// Game complete - move to scoring
 		game.State = model.GameStateScoring
-		c.logger.Info("game completed",
+		c.logger.InfoContext(ctx, "game completed",
 			slog.String("game_id", string(game.ID)),
 			slog.String("lobby_code", string(game.LobbyCode)),
 			slog.Int("total_turns", game.CurrentTurn),
 		)
+	} else if game.IsSimultaneous() {
+		// Next turn - draw the next letter straight away
+		game.TurnStartedAt = now
+		c.drawLetter(game, now)
 	} else {
 		// Next turn - rotate announcer
 		game.AnnouncerIdx = (game.AnnouncerIdx + 1) % len(game.Players)
 		game.State = model.GameStateAnnouncing
 		game.CurrentLetter = 0
 		game.Placements = make(map[model.PlayerID]bool)
-		game.TurnStartedAt = c.clock.Now()
+		game.PendingPlacement = make(map[model.PlayerID]model.Position)
+		game.TurnStartedAt = now
+		game.AnnounceDeadline = c.announceDeadline(now)
+		c.fillRack(game)
 	}
 
-	game.UpdatedAt = c.clock.Now()
-	return c.storage.SaveGame(ctx, game)
+	game.UpdatedAt = now
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return err
+	}
+
+	if game.State == model.GameStateScoring {
+		// A DelayedReveal game records its scores once they are revealed
+		if !game.ScoresHidden() {
+			c.recordGameComplete(ctx, game)
+		}
+	} else {
+		c.recordEvent(ctx, game, model.EventTurnComplete, "", model.TurnCompletePayload{
+			TurnNumber:      completedTurn,
+			NextAnnouncerID: game.CurrentAnnouncer(),
+		})
+		if game.IsSimultaneous() {
+			c.recordLetterDrawn(ctx, game)
+		}
+	}
+	return nil
+}
+
+// drawLetter draws a random letter from the bag for everyone to place and
+// moves the game straight to placing, for simultaneous games that have no
+// announcer
+func (c *Controller) drawLetter(game *model.Game, now time.Time) {
+	rng := random.ForSeed(c.random, game.Seed, fmt.Sprintf("draw/%d", game.CurrentTurn))
+	if size := game.BagSize(); size > 0 {
+		game.RecordAnnouncement(takeFromBag(game, rng.Intn(size)))
+	} else {
+		// Games started before the bag was added draw with replacement
+		letters := c.boardService.Letters()
+		game.RecordAnnouncement(letters[rng.Intn(len(letters))])
+	}
+	game.State = model.GameStatePlacing
+	game.Placements = make(map[model.PlayerID]bool)
+	game.PendingPlacement = make(map[model.PlayerID]model.Position)
+	game.LetterAnnouncedAt = now
+	game.AnnounceDeadline = time.Time{}
+}
+
+// fillBag fills the bag with a random letter for each turn of the game
+func (c *Controller) fillBag(game *model.Game) {
+	letters := c.boardService.Letters()
+	rng := random.ForSeed(c.random, game.Seed, "bag")
+	game.Bag = make(map[rune]int)
+	for range game.TotalTurns() {
+		game.Bag[letters[rng.Intn(len(letters))]]++
+	}
+}
+
+// takeFromBag removes and returns the nth letter in the bag, counting
+// through the letters in alphabetical order
+func takeFromBag(game *model.Game, n int) rune {
+	for _, letter := range game.BagLetters() {
+		if n < game.Bag[letter] {
+			game.Bag[letter]--
+			if game.Bag[letter] == 0 {
+				delete(game.Bag, letter)
+			}
+			return letter
+		}
+		n -= game.Bag[letter]
+	}
+	return 0
+}
+
+// recordLetterDrawn records a drawn letter as an announcement with no announcer
+func (c *Controller) recordLetterDrawn(ctx context.Context, game *model.Game) {
+	c.recordEvent(ctx, game, model.EventLetterAnnounced, "", model.LetterAnnouncedPayload{
+		Letter:     game.CurrentLetter,
+		TurnNumber: game.CurrentTurn,
+	})
+}
+
+// fillRack deals the current announcer random letters until their rack
+// holds RackSize letters. It does nothing when racks are off.
+func (c *Controller) fillRack(game *model.Game) {
+	if game.RackSize <= 0 || len(game.Players) == 0 || game.IsSimultaneous() {
+		return
+	}
+	if game.Racks == nil {
+		game.Racks = make(map[model.PlayerID][]rune)
+	}
+	letters := c.boardService.Letters()
+	announcer := game.CurrentAnnouncer()
+	rng := random.ForSeed(c.random, game.Seed, fmt.Sprintf("rack/%d/%d", game.CurrentTurn, game.AnnouncerIdx))
+	for len(game.Racks[announcer]) < game.RackSize {
+		game.Racks[announcer] = append(game.Racks[announcer], letters[rng.Intn(len(letters))])
+	}
+}
+
+// useRackLetter removes one copy of letter from the player's rack
+func useRackLetter(game *model.Game, playerID model.PlayerID, letter rune) {
+	rack := game.Racks[playerID]
+	for i, l := range rack {
+		if l == letter {
+			game.Racks[playerID] = append(rack[:i:i], rack[i+1:]...)
+			return
+		}
+	}
+}
+
+// announceDeadline returns when an announcer starting at now will be
+// skipped, or the zero time if there is no announce timeout
+func (c *Controller) announceDeadline(now time.Time) time.Time {
+	if c.cfg.AnnounceTimeout <= 0 {
+		return time.Time{}
+	}
+	return now.Add(c.cfg.AnnounceTimeout)
+}
+
+// CheckAnnounceTimeout passes the turn to the next announcer if the current
+// one has let the announce deadline pass. Returns true if the announcer was
+// skipped; the turn number and placements are unchanged.
+func (c *Controller) CheckAnnounceTimeout(ctx context.Context, gameID model.GameID) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return false, err
+	}
+
+	now := c.clock.Now()
+	if !game.AnnounceTimedOut(now) {
+		return false, nil
+	}
+
+	skipped := game.CurrentAnnouncer()
+	game.AnnouncerIdx = (game.AnnouncerIdx + 1) % len(game.Players)
+	game.AnnounceDeadline = c.announceDeadline(now)
+	c.fillRack(game)
+	game.UpdatedAt = now
+
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return false, err
+	}
+
+	c.logger.InfoContext(ctx, "announcer timed out",
+		slog.String("game_id", string(game.ID)),
+		slog.String("lobby_code", string(game.LobbyCode)),
+		slog.String("skipped_id", string(skipped)),
+		slog.Int("turn", game.CurrentTurn),
+	)
+
+	c.recordEvent(ctx, game, model.EventAnnouncerSkipped, skipped, model.AnnouncerSkippedPayload{
+		SkippedID:       skipped,
+		NextAnnouncerID: game.CurrentAnnouncer(),
+		TurnNumber:      game.CurrentTurn,
+	})
+	return true, nil
+}
+
+// ForceAdvance ends the current placing turn for an operator, so one stuck
+// player can't hold up everyone else. Players who haven't placed skip the
+// turn, leaving the cell they would have filled empty. Returns the game and
+// the players who were skipped.
+func (c *Controller) ForceAdvance(ctx context.Context, gameID model.GameID) (*model.Game, []model.PlayerID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch game.State {
+	case model.GameStateScoring:
+		return nil, nil, model.ErrGameComplete
+	case model.GameStateAbandoned:
+		return nil, nil, model.ErrGameAbandoned
+	case model.GameStateAnnouncing:
+		return nil, nil, model.ErrLetterNotAnnounced
+	}
+
+	var skipped []model.PlayerID
+	for _, playerID := range game.Players {
+		if !game.Placements[playerID] {
+			game.Placements[playerID] = true
+			delete(game.PendingPlacement, playerID)
+			skipped = append(skipped, playerID)
+		}
+	}
+
+	c.logger.InfoContext(ctx, "placing turn forced on",
+		slog.String("game_id", string(game.ID)),
+		slog.String("lobby_code", string(game.LobbyCode)),
+		slog.Int("turn", game.CurrentTurn),
+		slog.Int("skipped_count", len(skipped)),
+	)
+	c.recordEvent(ctx, game, model.EventPlacementSkipped, "", model.PlacementSkippedPayload{
+		SkippedIDs: skipped,
+		TurnNumber: game.CurrentTurn,
+	})
+
+	if err := c.advanceTurn(ctx, game); err != nil {
+		return nil, nil, err
+	}
+	return game, skipped, nil
+}
+
+// WatchAnnounceTimeout checks the game against its announce deadlines in the
+// background until it ends or its lobby moves on from it, calling onSkip each
+// time an announcer is skipped. It does nothing when AnnounceTimeout is 0.
+func (c *Controller) WatchAnnounceTimeout(gameID model.GameID, onSkip func()) {
+	if c.cfg.AnnounceTimeout <= 0 {
+		return
+	}
+
+	go func() {
+		ctx := context.Background()
+		for {
+			game, err := c.storage.GetGame(ctx, gameID)
+			if err != nil {
+				if !errors.Is(err, model.ErrGameNotFound) {
+					c.logger.ErrorContext(ctx, "failed to load game for announce timeout",
+						slog.String("game_id", string(gameID)),
+						slog.String("error", err.Error()),
+					)
+				}
+				return
+			}
+			if game.State == model.GameStateScoring || game.State == model.GameStateAbandoned || game.IsSimultaneous() {
+				return
+			}
+			if !c.lobbyPlaying(ctx, game) {
+				return
+			}
+
+			// While players are placing there is no deadline yet, but the
+			// next one can't be sooner than a full timeout away
+			wait := c.cfg.AnnounceTimeout
+			if game.State == model.GameStateAnnouncing && !game.AnnounceDeadline.IsZero() && !game.AwaitingStartAcks(c.clock.Now()) {
+				wait = game.AnnounceDeadline.Sub(c.clock.Now())
+			}
+			if wait > 0 {
+				<-c.clock.After(wait)
+			}
+
+			skipped, err := c.CheckAnnounceTimeout(ctx, gameID)
+			if err != nil {
+				c.logger.ErrorContext(ctx, "failed to check announce timeout",
+					slog.String("game_id", string(gameID)),
+					slog.String("error", err.Error()),
+				)
+				return
+			}
+			if skipped && onSkip != nil {
+				onSkip()
+			}
+		}
+	}()
+}
+
+// lobbyPlaying reports whether the game is still its lobby's current game.
+// A lobby that is gone, or that can't be loaded, has no game to watch.
+func (c *Controller) lobbyPlaying(ctx context.Context, game *model.Game) bool {
+	lobby, err := c.storage.GetLobby(ctx, game.LobbyCode)
+	if err != nil {
+		if !errors.Is(err, model.ErrLobbyNotFound) {
+			c.logger.ErrorContext(ctx, "failed to load lobby for announce timeout",
+				slog.String("game_id", string(game.ID)),
+				slog.String("lobby_code", string(game.LobbyCode)),
+				slog.String("error", err.Error()),
+			)
+		}
+		return false
+	}
+	return lobby.CurrentGame != nil && *lobby.CurrentGame == game.ID
+}
+
+// recordGameComplete records the game complete event with the final scores.
+// In a DelayedReveal game it is only called once the scores are revealed.
+func (c *Controller) recordGameComplete(ctx context.Context, game *model.Game) {
+	payload := model.GameCompletePayload{}
+	scores, err := c.GetFinalScores(ctx, game.ID)
+	if err == nil {
+		payload.Scores = scores
+		payload.Winner, _ = c.scoringService.ResolveWinner(scores, game.PlacementLatency)
+	}
+	c.recordEvent(ctx, game, model.EventGameComplete, "", payload)
+}
+
+// recordEvent appends a game event to the history of the game's lobby.
+// Failures are logged rather than returned, since the state change has
+// already been saved.
+func (c *Controller) recordEvent(ctx context.Context, game *model.Game, eventType model.EventType, playerID model.PlayerID, payload any) {
+	event := &model.Event{
+		Type:      eventType,
+		Timestamp: c.clock.Now(),
+		LobbyCode: game.LobbyCode,
+		GameID:    game.ID,
+		PlayerID:  playerID,
+		Payload:   payload,
+	}
+	if err := c.storage.AppendLobbyEvent(ctx, event); err != nil {
+		c.logger.ErrorContext(ctx, "failed to record game event",
+			slog.String("game_id", string(game.ID)),
+			slog.String("event_type", string(eventType)),
+			slog.String("error", err.Error()),
+		)
+	}
 }
 
 // AbandonGame ends a game prematurely
 func (c *Controller) AbandonGame(ctx context.Context, gameID model.GameID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	game, err := c.storage.GetGame(ctx, gameID)
 	if err != nil {
 		return err
@@ -226,19 +1168,195 @@ func (c *Controller) AbandonGame(ctx context.Context, gameID model.GameID) error
 		return nil // Already finished
 	}
 
+	return c.abandon(ctx, game, "abandoned by host")
+}
+
+// abandon marks a running game abandoned; the caller must hold c.mu
+func (c *Controller) abandon(ctx context.Context, game *model.Game, reason string) error {
 	game.State = model.GameStateAbandoned
 	game.UpdatedAt = c.clock.Now()
 
-	c.logger.Info("game abandoned",
-		slog.String("game_id", string(gameID)),
+	c.logger.InfoContext(ctx, "game abandoned",
+		slog.String("game_id", string(game.ID)),
 		slog.String("lobby_code", string(game.LobbyCode)),
+		slog.String("reason", reason),
 	)
 
-	return c.storage.SaveGame(ctx, game)
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return err
+	}
+
+	c.recordEvent(ctx, game, model.EventGameAbandoned, "", model.GameAbandonedPayload{
+		Reason: reason,
+	})
+
+	return nil
+}
+
+// CheckGameDuration abandons the game if it is still running
+// MaxGameDuration after it was created. Returns true if it was abandoned.
+func (c *Controller) CheckGameDuration(ctx context.Context, gameID model.GameID) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cfg.MaxGameDuration <= 0 {
+		return false, nil
+	}
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return false, err
+	}
+
+	if game.State == model.GameStateScoring || game.State == model.GameStateAbandoned {
+		return false, nil
+	}
+	if c.clock.Now().Sub(game.CreatedAt) < c.cfg.MaxGameDuration {
+		return false, nil
+	}
+
+	if err := c.abandon(ctx, game, "timed out: exceeded the maximum game duration"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WatchGameDuration waits in the background until the game has run for
+// MaxGameDuration, then abandons it if it is still going and calls
+// onAbandon. It does nothing when MaxGameDuration is 0.
+func (c *Controller) WatchGameDuration(gameID model.GameID, onAbandon func()) {
+	if c.cfg.MaxGameDuration <= 0 {
+		return
+	}
+
+	go func() {
+		ctx := context.Background()
+		game, err := c.storage.GetGame(ctx, gameID)
+		if err != nil {
+			if !errors.Is(err, model.ErrGameNotFound) {
+				c.logger.ErrorContext(ctx, "failed to load game for duration limit",
+					slog.String("game_id", string(gameID)),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+
+		if wait := game.CreatedAt.Add(c.cfg.MaxGameDuration).Sub(c.clock.Now()); wait > 0 {
+			<-c.clock.After(wait)
+		}
+
+		abandoned, err := c.CheckGameDuration(ctx, gameID)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to check game duration",
+				slog.String("game_id", string(gameID)),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		if abandoned && onAbandon != nil {
+			onAbandon()
+		}
+	}()
 }
 
-// RemovePlayer handles a player leaving mid-game
+// RevealScores makes a completed game's scores visible when DelayedReveal is set
+// Revealing a game whose scores are already visible is a no-op
+func (c *Controller) RevealScores(ctx context.Context, gameID model.GameID) (*model.Game, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.State != model.GameStateScoring {
+		return nil, model.ErrGameNotComplete
+	}
+	if !game.ScoresHidden() {
+		return game, nil
+	}
+
+	game.ScoresRevealed = true
+	game.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return nil, err
+	}
+	c.recordGameComplete(ctx, game)
+	return game, nil
+}
+
+// TurnObligations returns what a player can do in the game right now
+func (c *Controller) TurnObligations(game *model.Game, playerID model.PlayerID) model.TurnObligations {
+	return game.ObligationsFor(playerID, c.clock.Now())
+}
+
+// RevealNext reveals the score of the lowest-scoring player not yet revealed
+// in a DelayedReveal game, ties going to the player seated first. Revealing
+// the last one makes all the game's scores visible. Returns ErrAllRevealed
+// once there is nothing left to reveal.
+func (c *Controller) RevealNext(ctx context.Context, gameID model.GameID) (*model.Game, *model.BoardScore, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if game.State != model.GameStateScoring {
+		return nil, nil, model.ErrGameNotComplete
+	}
+	if !game.ScoresHidden() {
+		return nil, nil, model.ErrAllRevealed
+	}
+
+	scores, err := c.GetFinalScores(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+	byPlayer := make(map[model.PlayerID]model.BoardScore, len(scores))
+	for _, s := range scores {
+		byPlayer[s.PlayerID] = s
+	}
+
+	var next *model.BoardScore
+	for _, playerID := range game.Players {
+		s, ok := byPlayer[playerID]
+		if !ok || game.ScoreRevealed(playerID) {
+			continue
+		}
+		if next == nil || s.TotalScore < next.TotalScore {
+			next = &s
+		}
+	}
+	if next == nil {
+		return nil, nil, model.ErrAllRevealed
+	}
+
+	game.RevealedPlayers = append(game.RevealedPlayers, next.PlayerID)
+	if len(game.RevealedPlayers) == len(byPlayer) {
+		game.ScoresRevealed = true
+	}
+	game.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveGame(ctx, game); err != nil {
+		return nil, nil, err
+	}
+	if game.ScoresRevealed {
+		c.recordGameComplete(ctx, game)
+	}
+	return game, next, nil
+}
+
+// RemovePlayer handles a player leaving mid-game. With LeaverStrategy set
+// they keep their seat and are marked bot-controlled instead.
 func (c *Controller) RemovePlayer(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	game, err := c.storage.GetGame(ctx, gameID)
 	if err != nil {
 		return err
@@ -260,9 +1378,30 @@ func (c *Controller) RemovePlayer(ctx context.Context, gameID model.GameID, play
 	if playerIdx == -1 {
 		return nil // Player not in game
 	}
+	if _, ok := game.BotStrategyFor(playerID); ok {
+		return nil // Already handed to a bot
+	}
+
+	// Keep the seat so the board is completed and scored alongside the rest
+	if c.cfg.LeaverStrategy != "" && len(game.Players) > 1 {
+		if game.BotControlled == nil {
+			game.BotControlled = make(map[model.PlayerID]string)
+		}
+		game.BotControlled[playerID] = c.cfg.LeaverStrategy
+		delete(game.PendingPlacement, playerID)
+		game.UpdatedAt = c.clock.Now()
+
+		c.logger.InfoContext(ctx, "player handed to bot",
+			slog.String("game_id", string(game.ID)),
+			slog.String("player_id", string(playerID)),
+			slog.String("strategy", c.cfg.LeaverStrategy),
+		)
+		return c.storage.SaveGame(ctx, game)
+	}
 
 	// Remove player from list
 	game.Players = append(game.Players[:playerIdx], game.Players[playerIdx+1:]...)
+	delete(game.Racks, playerID)
 
 	// Check if game should be abandoned (not enough players)
 	if len(game.Players) == 0 {
@@ -275,11 +1414,15 @@ func (c *Controller) RemovePlayer(ctx context.Context, gameID model.GameID, play
 	if game.AnnouncerIdx >= len(game.Players) {
 		game.AnnouncerIdx = 0
 	}
+	if game.State == model.GameStateAnnouncing {
+		c.fillRack(game)
+	}
 
 	// If removed player was supposed to announce, skip to placing or next turn
 	// (In placing state, mark them as having placed)
 	if game.State == model.GameStatePlacing {
 		delete(game.Placements, playerID)
+		delete(game.PendingPlacement, playerID)
 		// Check if now all remaining players have placed
 		if game.AllPlayersPlaced() {
 			return c.advanceTurn(ctx, game)
@@ -291,6 +1434,7 @@ func (c *Controller) RemovePlayer(ctx context.Context, gameID model.GameID, play
 }
 
 // GetFinalScores calculates and returns the final scores for a completed game
+// Returns ErrDictionaryNotLoaded rather than all-zero scores if there is no dictionary
 func (c *Controller) GetFinalScores(ctx context.Context, gameID model.GameID) ([]model.BoardScore, error) {
 	game, err := c.storage.GetGame(ctx, gameID)
 	if err != nil {
@@ -301,17 +1445,192 @@ func (c *Controller) GetFinalScores(ctx context.Context, gameID model.GameID) ([
 		return nil, model.ErrNoGameInProgress
 	}
 
+	if err := c.scoringService.CheckDictionary(); err != nil {
+		return nil, err
+	}
+
+	boards, err := c.boardService.GetBoardsForGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err = c.fillMissingBoards(game, boards)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scoringService.ScoreMultipleBoardsWithOptions(boards, scoring.OptionsForGame(game)), nil
+}
+
+// GetStandings scores every player's board as it stands, so a running game
+// can show who is ahead. Scores use the same rules as the final scores,
+// except that a running game's boards are scored alone: the unique word
+// bonus compares boards, so it would give away what others have spelled.
+// Returns ErrGameNotComplete while a finished game's scores are withheld.
+func (c *Controller) GetStandings(ctx context.Context, gameID model.GameID) ([]model.Standing, error) {
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.State == model.GameStateAbandoned {
+		return nil, model.ErrGameAbandoned
+	}
+	if game.ScoresHidden() {
+		return nil, model.ErrGameNotComplete
+	}
+
+	if err := c.scoringService.CheckDictionary(); err != nil {
+		return nil, err
+	}
+
 	boards, err := c.boardService.GetBoardsForGame(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
+	boards, err = c.fillMissingBoards(game, boards)
+	if err != nil {
+		return nil, err
+	}
 
-	return c.scoringService.ScoreMultipleBoards(boards), nil
+	// Only rank players still in the game, in seat order so ties stay stable
+	opts := scoring.OptionsForGame(game)
+	byPlayer := make(map[model.PlayerID]model.BoardScore, len(boards))
+	if game.State == model.GameStateScoring {
+		for _, s := range c.scoringService.ScoreMultipleBoardsWithOptions(boards, opts) {
+			byPlayer[s.PlayerID] = s
+		}
+	} else {
+		for _, b := range boards {
+			byPlayer[b.PlayerID] = *c.scoringService.ScoreBoardWithOptions(b, opts)
+		}
+	}
+	scores := make([]model.BoardScore, 0, len(game.Players))
+	for _, playerID := range game.Players {
+		scores = append(scores, byPlayer[playerID])
+	}
+	return model.RankStandings(scores), nil
+}
+
+// GetWordFrequencies aggregates the words scored across all of a completed
+// game's boards. It returns ErrGameNotComplete before scoring, and while a
+// delayed reveal is still withholding the scores.
+func (c *Controller) GetWordFrequencies(ctx context.Context, gameID model.GameID) (map[string]model.WordFrequency, error) {
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.State != model.GameStateScoring || game.ScoresHidden() {
+		return nil, model.ErrGameNotComplete
+	}
+
+	scores, err := c.GetFinalScores(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	return c.scoringService.AggregateWords(scores), nil
+}
+
+// GetGameBundle collects a completed game's summary, boards, scores and
+// recorded events into one bundle. Like GetWordFrequencies, it returns
+// ErrGameNotComplete before scoring or while scores are withheld.
+func (c *Controller) GetGameBundle(ctx context.Context, gameID model.GameID) (*model.GameBundle, error) {
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.State != model.GameStateScoring || game.ScoresHidden() {
+		return nil, model.ErrGameNotComplete
+	}
+
+	scores, err := c.GetFinalScores(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := c.CreateGameSummary(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	boards, err := c.boardService.GetBoardsForGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Events expire with the lobby, so a missing move log isn't an error
+	var moves []*model.Event
+	events, err := c.storage.GetLobbyEvents(ctx, game.LobbyCode)
+	if err != nil {
+		c.logger.WarnContext(ctx, "failed to load events for game bundle",
+			slog.String("game_id", string(gameID)),
+			slog.String("error", err.Error()),
+		)
+	}
+	for _, event := range events {
+		if event.GameID == gameID {
+			moves = append(moves, event)
+		}
+	}
+
+	return &model.GameBundle{
+		Game:    game,
+		Summary: *summary,
+		Boards:  boards,
+		Scores:  scores,
+		Moves:   moves,
+	}, nil
+}
+
+// fillMissingBoards makes sure every player in the game has a board to
+// score, applying the configured MissingBoardPolicy to any that don't
+func (c *Controller) fillMissingBoards(game *model.Game, boards []*model.Board) ([]*model.Board, error) {
+	// Check each player rather than comparing counts, since the boards of
+	// players who left mid-game are still stored
+	have := make(map[model.PlayerID]bool, len(boards))
+	for _, b := range boards {
+		have[b.PlayerID] = true
+	}
+
+	for _, playerID := range game.Players {
+		if have[playerID] {
+			continue
+		}
+		if c.cfg.MissingBoards == MissingBoardError {
+			return nil, fmt.Errorf("%w: player %s has no board in game %s", model.ErrBoardNotFound, playerID, game.ID)
+		}
+		c.logger.Warn("scoring missing board as empty",
+			slog.String("game_id", string(game.ID)),
+			slog.String("player_id", string(playerID)),
+		)
+		b := model.NewBoard(game.ID, playerID, game.GridSize)
+		b.Block(game.BlockedCells)
+		boards = append(boards, b)
+	}
+	return boards, nil
 }
 
 // CreateGameSummary creates a summary record for a completed game
 func (c *Controller) CreateGameSummary(ctx context.Context, gameID model.GameID) (*model.GameSummary, error) {
+	game, err := c.storage.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a dictionary the game can still be completed, just unscored
 	scores, err := c.GetFinalScores(ctx, gameID)
+	if errors.Is(err, model.ErrDictionaryNotLoaded) {
+		return &model.GameSummary{
+			ID:              gameID,
+			FinalScores:     map[model.PlayerID]int{},
+			Unscored:        true,
+			Result:          "Not scored: no dictionary was loaded",
+			TotalTurnTime:   game.TotalTurnTime(),
+			AverageTurnTime: game.AverageTurnTime(),
+			StealBonuses:    game.StealBonuses,
+			CompletedAt:     c.clock.Now(),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -321,23 +1640,60 @@ func (c *Controller) CreateGameSummary(ctx context.Context, gameID model.GameID)
 		finalScores[s.PlayerID] = s.TotalScore
 	}
 
+	winner, tied := c.scoringService.ResolveWinner(scores, game.PlacementLatency)
+
+	// Players who have since expired from storage are named by ID
+	names := make(map[model.PlayerID]string, len(game.Players))
+	for _, playerID := range game.Players {
+		if player, err := c.storage.GetPlayer(ctx, playerID); err == nil {
+			names[playerID] = player.DisplayName
+		}
+	}
+	result := c.scoringService.FormatResult(scores, names)
+	if tied && winner != "" {
+		name := names[winner]
+		if name == "" {
+			name = string(winner)
+		}
+		result += "; " + name + " wins on the tie-break"
+	}
+
 	return &model.GameSummary{
-		ID:          gameID,
-		FinalScores: finalScores,
-		Winner:      c.scoringService.DetermineWinner(scores),
-		CompletedAt: c.clock.Now(),
+		ID:              gameID,
+		FinalScores:     finalScores,
+		Winner:          winner,
+		Tied:            tied,
+		Result:          result,
+		TotalTurnTime:   game.TotalTurnTime(),
+		AverageTurnTime: game.AverageTurnTime(),
+		StealBonuses:    game.StealBonuses,
+		CompletedAt:     c.clock.Now(),
 	}, nil
 }
 
 // Interface for dependency injection
 type ControllerInterface interface {
 	CreateGame(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, gridSize int) (*model.Game, error)
+	CreateGameWithConfig(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, config model.LobbyConfig) (*model.Game, error)
+	CreateGameWithID(ctx context.Context, lobbyCode model.LobbyCode, players []model.PlayerID, config model.LobbyConfig, gameID model.GameID) (*model.Game, error)
 	GetGame(ctx context.Context, gameID model.GameID) (*model.Game, error)
 	AnnounceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, letter rune) error
+	AnnounceAndPlace(ctx context.Context, gameID model.GameID, playerID model.PlayerID, letter rune, pos model.Position) (PlacementResult, error)
 	PlaceLetter(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) error
+	PlaceLetterWithResult(ctx context.Context, gameID model.GameID, playerID model.PlayerID, pos model.Position) (PlacementResult, error)
+	ConfirmPlacement(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error
+	ConfirmPlacementWithResult(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (PlacementResult, error)
+	UseHint(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (*model.Game, error)
+	CancelPlacement(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error
+	CheckAnnounceTimeout(ctx context.Context, gameID model.GameID) (bool, error)
+	WatchAnnounceTimeout(gameID model.GameID, onSkip func())
+	WatchGameDuration(gameID model.GameID, onAbandon func())
+	RevealScores(ctx context.Context, gameID model.GameID) (*model.Game, error)
+	RevealNext(ctx context.Context, gameID model.GameID) (*model.Game, *model.BoardScore, error)
 	AbandonGame(ctx context.Context, gameID model.GameID) error
 	RemovePlayer(ctx context.Context, gameID model.GameID, playerID model.PlayerID) error
 	GetFinalScores(ctx context.Context, gameID model.GameID) ([]model.BoardScore, error)
+	GetStandings(ctx context.Context, gameID model.GameID) ([]model.Standing, error)
 	CreateGameSummary(ctx context.Context, gameID model.GameID) (*model.GameSummary, error)
 }
 
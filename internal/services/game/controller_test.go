@@ -2,6 +2,8 @@ package game
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,12 +37,12 @@ func TestControllerSuite(t *testing.T) {
 func (s *ControllerSuite) SetupTest() {
 	s.storage = memory.New()
 	logger := testutil.NopLogger()
-	s.boardService = board.New(s.storage, logger)
-	s.dictService = dictionary.New(s.storage, logger)
-	s.scoringService = scoring.New(s.dictService)
+	s.boardService = board.New(s.storage, model.DefaultAlphabet(), logger)
+	s.dictService = dictionary.New(s.storage, model.DefaultAlphabet(), logger)
+	s.scoringService = scoring.New(s.dictService, scoring.DefaultConfig())
 	s.clock = mocks.NewMockClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
 	s.random = mocks.NewMockRandom()
-	s.controller = NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, logger)
+	s.controller = NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, DefaultConfig(), logger)
 	s.ctx = context.Background()
 
 	// Load dictionary for scoring tests
@@ -80,11 +82,54 @@ func (s *ControllerSuite) TestCreateGameCreatesBoardsForAllPlayers() {
 	}
 }
 
+func (s *ControllerSuite) TestCreateGameWithID() {
+	players := []model.PlayerID{"player-1", "player-2"}
+
+	game, err := s.controller.CreateGameWithID(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 3}, "my-game_1")
+	s.Require().NoError(err)
+	s.Equal(model.GameID("my-game_1"), game.ID)
+
+	stored, err := s.controller.GetGame(s.ctx, "my-game_1")
+	s.Require().NoError(err)
+	s.Equal(players, stored.Players)
+	board, err := s.boardService.GetBoard(s.ctx, "my-game_1", "player-1")
+	s.Require().NoError(err)
+	s.Equal(3, board.Size)
+
+	// Reusing the ID is rejected rather than overwriting the game
+	_, err = s.controller.CreateGameWithID(s.ctx, "LOBBY2", []model.PlayerID{"player-3"}, model.LobbyConfig{GridSize: 3}, "my-game_1")
+	s.ErrorIs(err, model.ErrGameExists)
+	stored, err = s.controller.GetGame(s.ctx, "my-game_1")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("LOBBY1"), stored.LobbyCode)
+	_, err = s.boardService.GetBoard(s.ctx, "my-game_1", "player-3")
+	s.ErrorIs(err, model.ErrBoardNotFound)
+}
+
+func (s *ControllerSuite) TestCreateGameWithIDRejectsInvalidIDs() {
+	for _, id := range []model.GameID{"has.dot", "has space", model.GameID(strings.Repeat("A", model.MaxGameIDLength+1))} {
+		_, err := s.controller.CreateGameWithID(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, model.LobbyConfig{GridSize: 3}, id)
+		s.ErrorIs(err, model.ErrInvalidGameID, id)
+	}
+}
+
 func (s *ControllerSuite) TestCreateGameFailsWithNoPlayers() {
 	_, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{}, 5)
 	s.ErrorIs(err, model.ErrInsufficientPlayers)
 }
 
+func (s *ControllerSuite) TestCreateGameFailsWithDuplicatePlayers() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2", "player-1"}
+
+	_, err := s.controller.CreateGame(s.ctx, "LOBBY1", players, 5)
+	s.ErrorIs(err, model.ErrDuplicatePlayer)
+
+	// No boards are created for a rejected game
+	_, err = s.boardService.GetBoard(s.ctx, "GAME12345678", "player-1")
+	s.ErrorIs(err, model.ErrBoardNotFound)
+}
+
 func (s *ControllerSuite) TestCreateGameIsPersisted() {
 	s.random.QueueString("GAME12345678")
 	players := []model.PlayerID{"player-1"}
@@ -112,6 +157,55 @@ func (s *ControllerSuite) TestAnnounceLetterSucceeds() {
 	s.Equal(model.GameStatePlacing, updated.State)
 }
 
+func (s *ControllerSuite) TestAnnouncedLettersListsEachTurnInOrder() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 5)
+	s.Empty(game.AnnouncedLetters)
+
+	turns := []struct {
+		announcer model.PlayerID
+		letter    rune
+	}{{"player-1", 'C'}, {"player-2", 'A'}, {"player-1", 'T'}}
+	for i, turn := range turns {
+		s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, turn.announcer, turn.letter))
+		for _, playerID := range players {
+			s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, playerID, model.Position{Row: 0, Col: i}))
+		}
+	}
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal([]model.AnnouncedLetter{
+		{Turn: 0, Letter: 'C'},
+		{Turn: 1, Letter: 'A'},
+		{Turn: 2, Letter: 'T'},
+	}, updated.AnnouncedLetters)
+}
+
+func (s *ControllerSuite) TestForceAdvanceSkipsPlayersWhoHaventPlaced() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 3)
+
+	// Not waiting on placements yet
+	_, _, err := s.controller.ForceAdvance(s.ctx, game.ID)
+	s.ErrorIs(err, model.ErrLetterNotAnnounced)
+
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+
+	updated, skipped, err := s.controller.ForceAdvance(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{"player-2"}, skipped)
+	s.Equal(1, updated.CurrentTurn)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Equal(model.PlayerID("player-2"), updated.CurrentAnnouncer())
+
+	// The skipped player's board is left as it was
+	b, _ := s.boardService.GetBoard(s.ctx, game.ID, "player-2")
+	s.False(b.HasLetters())
+}
+
 func (s *ControllerSuite) TestAnnounceLetterNormalizesToUppercase() {
 	s.random.QueueString("GAME12345678")
 	players := []model.PlayerID{"player-1"}
@@ -124,6 +218,32 @@ func (s *ControllerSuite) TestAnnounceLetterNormalizesToUppercase() {
 	s.Equal('A', updated.CurrentLetter)
 }
 
+func (s *ControllerSuite) TestAnnounceLetterRejectsAccentsByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 5)
+
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'é'), model.ErrInvalidLetter)
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", '7'), model.ErrInvalidLetter)
+}
+
+func (s *ControllerSuite) TestAnnounceLetterFoldsAccentsWhenConfigured() {
+	boardService := board.New(s.storage, model.Alphabet{FoldAccents: true}, testutil.NopLogger())
+	s.controller = NewController(s.storage, boardService, s.scoringService, s.clock, s.random, DefaultConfig(), testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 5)
+
+	err := s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'é')
+	s.Require().NoError(err)
+	err = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	s.Require().NoError(err)
+
+	b, _ := boardService.GetBoard(s.ctx, game.ID, "player-1")
+	s.Equal('E', b.Get(model.Position{Row: 0, Col: 0}))
+
+	// Digits and symbols are still rejected
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", '7'), model.ErrInvalidLetter)
+}
+
 func (s *ControllerSuite) TestAnnounceLetterFailsIfNotAnnouncer() {
 	s.random.QueueString("GAME12345678")
 	players := []model.PlayerID{"player-1", "player-2"}
@@ -231,6 +351,73 @@ func (s *ControllerSuite) TestPlaceLetterFailsForNonPlayer() {
 
 // Turn advancement tests
 
+// AnnounceAndPlace tests
+
+func (s *ControllerSuite) TestAnnounceAndPlaceMatchesSeparateCalls() {
+	for i, players := range [][]model.PlayerID{
+		{"player-1", "player-2"},
+		{"player-1"}, // Sole player placing advances the turn
+	} {
+		s.random.QueueString(fmt.Sprintf("GAME0000000%d", 2*i+1), fmt.Sprintf("GAME0000000%d", 2*i+2))
+		separate, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 3)
+		combined, _ := s.controller.CreateGame(s.ctx, "LOBBY2", players, 3)
+		pos := model.Position{Row: 1, Col: 2}
+
+		s.Require().NoError(s.controller.AnnounceLetter(s.ctx, separate.ID, "player-1", 'a'))
+		s.Require().NoError(s.controller.PlaceLetter(s.ctx, separate.ID, "player-1", pos))
+
+		result, err := s.controller.AnnounceAndPlace(s.ctx, combined.ID, "player-1", 'a', pos)
+		s.Require().NoError(err)
+
+		expected, _ := s.controller.GetGame(s.ctx, separate.ID)
+		actual, _ := s.controller.GetGame(s.ctx, combined.ID)
+		s.Equal(actual, result.Game)
+		expected.ID, expected.LobbyCode, expected.SpectateToken = actual.ID, actual.LobbyCode, actual.SpectateToken
+		s.Equal(expected, actual)
+
+		expectedBoard, _ := s.boardService.GetBoard(s.ctx, separate.ID, "player-1")
+		actualBoard, _ := s.boardService.GetBoard(s.ctx, combined.ID, "player-1")
+		s.Equal(expectedBoard.Cells, actualBoard.Cells)
+	}
+}
+
+func (s *ControllerSuite) TestAnnounceAndPlaceAdvancesTurn() {
+	s.random.QueueString("GAME12345678")
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 3)
+
+	result, err := s.controller.AnnounceAndPlace(s.ctx, game.ID, "player-1", 'A', model.Position{Row: 0, Col: 0})
+	s.Require().NoError(err)
+	s.True(result.TurnAdvanced)
+	s.Equal(model.GameStateAnnouncing, result.Game.State)
+	s.Equal(1, result.Game.CurrentTurn)
+}
+
+func (s *ControllerSuite) TestAnnounceAndPlaceFailsIfNotAnnouncer() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 3)
+
+	_, err := s.controller.AnnounceAndPlace(s.ctx, game.ID, "player-2", 'A', model.Position{Row: 0, Col: 0})
+	s.ErrorIs(err, model.ErrNotPlayerTurn)
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Equal(rune(0), updated.CurrentLetter)
+}
+
+func (s *ControllerSuite) TestAnnounceAndPlaceDoesNotAnnounceOnInvalidPosition() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 3)
+
+	_, err := s.controller.AnnounceAndPlace(s.ctx, game.ID, "player-1", 'A', model.Position{Row: 5, Col: 0})
+	s.Require().Error(err)
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Equal(rune(0), updated.CurrentLetter)
+}
+
 func (s *ControllerSuite) TestAllPlayersPlacedAdvancesTurn() {
 	s.random.QueueString("GAME12345678")
 	players := []model.PlayerID{"player-1", "player-2"}
@@ -330,6 +517,44 @@ func (s *ControllerSuite) TestCannotActOnAbandonedGame() {
 	s.ErrorIs(err, model.ErrGameAbandoned)
 }
 
+// GetGameBySpectateToken tests
+
+func (s *ControllerSuite) TestGetGameBySpectateTokenSucceeds() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 5)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(game.SpectateToken)
+
+	found, err := s.controller.GetGameBySpectateToken(s.ctx, game.SpectateToken)
+	s.Require().NoError(err)
+	s.Equal(game.ID, found.ID)
+}
+
+func (s *ControllerSuite) TestGetGameBySpectateTokenRejectsWrongSecret() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 5)
+	s.Require().NoError(err)
+
+	_, err = s.controller.GetGameBySpectateToken(s.ctx, string(game.ID)+".guess")
+	s.ErrorIs(err, model.ErrInvalidSpectateToken)
+	_, err = s.controller.GetGameBySpectateToken(s.ctx, string(game.ID))
+	s.ErrorIs(err, model.ErrInvalidSpectateToken)
+	_, err = s.controller.GetGameBySpectateToken(s.ctx, "MISSING.token")
+	s.ErrorIs(err, model.ErrInvalidSpectateToken)
+}
+
+func (s *ControllerSuite) TestGetGameBySpectateTokenRejectsCompletedGame() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 1)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+
+	_, err = s.controller.GetGameBySpectateToken(s.ctx, game.SpectateToken)
+	s.ErrorIs(err, model.ErrInvalidSpectateToken)
+}
+
 // RemovePlayer tests
 
 func (s *ControllerSuite) TestRemovePlayerFromGame() {
@@ -409,6 +634,86 @@ func (s *ControllerSuite) TestGetFinalScoresFailsIfGameNotComplete() {
 	s.ErrorIs(err, model.ErrNoGameInProgress)
 }
 
+func (s *ControllerSuite) TestGetFinalScoresFailsWithoutDictionary() {
+	logger := testutil.NopLogger()
+	emptyDict := dictionary.New(s.storage, model.DefaultAlphabet(), logger)
+	controller := NewController(s.storage, s.boardService, scoring.New(emptyDict, scoring.DefaultConfig()), s.clock, s.random, DefaultConfig(), logger)
+
+	s.random.QueueString("GAME12345678")
+	game, _ := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 2)
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for _, pos := range positions {
+		_ = controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+		_ = controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Require().Equal(model.GameStateScoring, updated.State)
+
+	_, err := controller.GetFinalScores(s.ctx, game.ID)
+	s.ErrorIs(err, model.ErrDictionaryNotLoaded)
+
+	// The game can still be completed, just without scores
+	summary, err := controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.True(summary.Unscored)
+	s.Empty(summary.FinalScores)
+	s.Empty(summary.Winner)
+}
+
+// completeGameLosingBoard plays a two-player 2x2 game to completion, then
+// drops player-2's board from storage as if it had expired
+func (s *ControllerSuite) completeGameLosingBoard(controller *Controller) *model.Game {
+	s.random.QueueString("GAME12345678")
+	game, _ := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 2)
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	letters := []rune{'A', 'T', 'X', 'X'}
+	for i, pos := range positions {
+		g, _ := controller.GetGame(s.ctx, game.ID)
+		s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, g.CurrentAnnouncer(), letters[i]))
+		s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, "player-1", pos))
+		s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, "player-2", pos))
+	}
+
+	kept, err := s.storage.GetBoard(s.ctx, game.ID, "player-1")
+	s.Require().NoError(err)
+	s.Require().NoError(s.storage.DeleteBoardsForGame(s.ctx, game.ID))
+	s.Require().NoError(s.storage.SaveBoard(s.ctx, kept))
+	return game
+}
+
+func (s *ControllerSuite) TestGetFinalScoresScoresMissingBoardAsEmpty() {
+	game := s.completeGameLosingBoard(s.controller)
+
+	scores, err := s.controller.GetFinalScores(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 2)
+
+	byPlayer := make(map[model.PlayerID]model.BoardScore)
+	for _, score := range scores {
+		byPlayer[score.PlayerID] = score
+	}
+	s.Positive(byPlayer["player-1"].TotalScore)
+	s.Require().Contains(byPlayer, model.PlayerID("player-2"))
+	s.Zero(byPlayer["player-2"].TotalScore)
+	s.Empty(byPlayer["player-2"].Words)
+}
+
+func (s *ControllerSuite) TestGetFinalScoresFailsOnMissingBoardWhenConfigured() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{MissingBoards: MissingBoardError}, testutil.NopLogger())
+	game := s.completeGameLosingBoard(controller)
+
+	_, err := controller.GetFinalScores(s.ctx, game.ID)
+	s.ErrorIs(err, model.ErrBoardNotFound)
+	s.ErrorContains(err, "player-2")
+}
+
 // CreateGameSummary tests
 
 func (s *ControllerSuite) TestCreateGameSummary() {
@@ -431,3 +736,816 @@ func (s *ControllerSuite) TestCreateGameSummary() {
 	s.Equal(game.ID, summary.ID)
 	s.Contains(summary.FinalScores, model.PlayerID("player-1"))
 }
+
+func (s *ControllerSuite) TestCreateGameSummarySpeedTieBreakNamesFasterPlacer() {
+	scoringService := scoring.New(s.dictService, scoring.Config{TieBreak: scoring.TieBreakSpeed})
+	s.controller = NewController(s.storage, s.boardService, scoringService, s.clock, s.random, DefaultConfig(), testutil.NopLogger())
+
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 2)
+
+	// Both players build identical boards, but player-2 always places first
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for i, pos := range positions {
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, players[i%2], 'Z')
+		s.clock.Advance(time.Second)
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-2", pos)
+		s.clock.Advance(2 * time.Second)
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(4*time.Second, updated.PlacementLatency["player-2"])
+	s.Equal(12*time.Second, updated.PlacementLatency["player-1"])
+
+	summary, err := s.controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Equal(summary.FinalScores["player-1"], summary.FinalScores["player-2"])
+	s.Equal(model.PlayerID("player-2"), summary.Winner)
+	s.True(summary.Tied)
+}
+
+func (s *ControllerSuite) TestCreateGameSummaryTieWithoutTieBreak() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 2)
+
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for i, pos := range positions {
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, players[i%2], 'Z')
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-2", pos)
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	summary, err := s.controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Empty(summary.Winner)
+	s.True(summary.Tied)
+}
+
+func (s *ControllerSuite) TestGetFinalScoresAppliesEdgeAnchoring() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1"}
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 4, RequireEdgeAnchored: true})
+	s.Require().NoError(err)
+	s.True(game.RequireEdgeAnchored)
+
+	// "AT" floats in the middle of the second row
+	for i := range 16 {
+		pos := model.Position{Row: i / 4, Col: i % 4}
+		letter := 'Z'
+		switch pos {
+		case model.Position{Row: 1, Col: 1}:
+			letter = 'A'
+		case model.Position{Row: 1, Col: 2}:
+			letter = 'T'
+		}
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", letter)
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	scores, err := s.controller.GetFinalScores(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 1)
+	s.Empty(scores[0].Words)
+	s.Equal(0, scores[0].TotalScore)
+}
+
+// Turn timing tests
+
+func (s *ControllerSuite) TestAdvanceTurnRecordsTurnDurations() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 2)
+
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for i, pos := range positions {
+		s.clock.Advance(time.Duration(i+1) * time.Second)
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", rune('A'+i))
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal([]time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second}, updated.TurnDurations)
+}
+
+func (s *ControllerSuite) TestCreateGameSummaryIncludesTurnTiming() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 2)
+
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for i, pos := range positions {
+		s.clock.Advance(time.Duration(i+1) * time.Second)
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", rune('A'+i))
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	summary, err := s.controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Equal(10*time.Second, summary.TotalTurnTime)
+	s.Equal(2500*time.Millisecond, summary.AverageTurnTime)
+}
+
+func (s *ControllerSuite) TestAbandonedGameKeepsPartialTurnTiming() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1"}
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", players, 5)
+
+	s.clock.Advance(3 * time.Second)
+	_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+	_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	s.clock.Advance(time.Minute)
+	err := s.controller.AbandonGame(s.ctx, game.ID)
+	s.Require().NoError(err)
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal([]time.Duration{3 * time.Second}, updated.TurnDurations)
+	s.Equal(3*time.Second, updated.TotalTurnTime())
+	s.Equal(3*time.Second, updated.AverageTurnTime())
+}
+
+func (s *ControllerSuite) TestTurnTimingIsZeroWithNoCompletedTurns() {
+	s.random.QueueString("GAME12345678")
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 5)
+	_ = s.controller.AbandonGame(s.ctx, game.ID)
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Empty(updated.TurnDurations)
+	s.Equal(time.Duration(0), updated.AverageTurnTime())
+}
+
+// Sequential placement tests
+
+func (s *ControllerSuite) TestSequentialPlacementOnlyAcceptsExpectedCell() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 2, PlacementMode: model.PlacementModeSequential})
+	s.Require().NoError(err)
+
+	required, ok := game.RequiredPosition()
+	s.Require().True(ok)
+	s.Equal(model.Position{Row: 0, Col: 0}, required)
+
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	err = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 1, Col: 1})
+	s.ErrorIs(err, model.ErrPositionNotAllowed)
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-2", model.Position{Row: 0, Col: 0}))
+
+	// The next turn moves along the row
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	required, ok = updated.RequiredPosition()
+	s.Require().True(ok)
+	s.Equal(model.Position{Row: 0, Col: 1}, required)
+
+	// Announcing and placing at once is checked before anything is announced
+	_, err = s.controller.AnnounceAndPlace(s.ctx, game.ID, "player-2", 'B', model.Position{Row: 1, Col: 0})
+	s.ErrorIs(err, model.ErrPositionNotAllowed)
+	updated, _ = s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+}
+
+func (s *ControllerSuite) TestFreePlacementHasNoRequiredPosition() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 3)
+	s.Require().NoError(err)
+
+	_, ok := game.RequiredPosition()
+	s.False(ok)
+}
+
+// Adjacent placement tests
+
+func (s *ControllerSuite) TestStealBonusGoesToFirstRowWordEachTurn() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{StealBonus: true}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", players, 2)
+	s.Require().NoError(err)
+
+	place := func(playerID model.PlayerID, row, col int) {
+		s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, playerID, model.Position{Row: row, Col: col}))
+	}
+
+	// Both spell AT across the top row, but player-2 finishes it first
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	place("player-1", 0, 0)
+	place("player-2", 0, 0)
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-2", 'T'))
+	s.clock.Advance(3 * time.Second)
+	place("player-2", 0, 1)
+	place("player-1", 0, 1)
+
+	// Only player-1 spells GO along the bottom; player-2 has OG
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'G'))
+	place("player-1", 1, 0)
+	place("player-2", 1, 1)
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-2", 'O'))
+	place("player-2", 1, 0)
+	place("player-1", 1, 1)
+
+	summary, err := controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.StealBonus{
+		{PlayerID: "player-2", Turn: 1, Word: "AT", At: s.clock.Now()},
+		{PlayerID: "player-1", Turn: 3, Word: "GO", At: s.clock.Now()},
+	}, summary.StealBonuses)
+}
+
+func (s *ControllerSuite) TestStealBonusPointsDecideTheWinner() {
+	cfg := scoring.DefaultConfig()
+	cfg.StealBonus = 5
+	scoringService := scoring.New(s.dictService, cfg)
+	controller := NewController(s.storage, s.boardService, scoringService, s.clock, s.random, Config{StealBonus: true}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 2)
+	s.Require().NoError(err)
+
+	// Both boards end up AT over XX, but player-2 finishes AT first
+	for _, turn := range []struct {
+		announcer model.PlayerID
+		letter    rune
+		order     []model.PlayerID
+		pos       model.Position
+	}{
+		{"player-1", 'A', []model.PlayerID{"player-1", "player-2"}, model.Position{Row: 0, Col: 0}},
+		{"player-2", 'T', []model.PlayerID{"player-2", "player-1"}, model.Position{Row: 0, Col: 1}},
+		{"player-1", 'X', []model.PlayerID{"player-1", "player-2"}, model.Position{Row: 1, Col: 0}},
+		{"player-2", 'X', []model.PlayerID{"player-1", "player-2"}, model.Position{Row: 1, Col: 1}},
+	} {
+		s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, turn.announcer, turn.letter))
+		for _, playerID := range turn.order {
+			s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, playerID, turn.pos))
+		}
+	}
+
+	scores, err := controller.GetFinalScores(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 2)
+	s.Equal(model.PlayerID("player-2"), scores[0].PlayerID)
+	s.Equal(5, scores[0].StealBonus)
+	s.Equal(scores[1].TotalScore+5, scores[0].TotalScore)
+
+	summary, err := controller.CreateGameSummary(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-2"), summary.Winner)
+	s.False(summary.Tied)
+	s.Equal(summary.FinalScores["player-1"]+5, summary.FinalScores["player-2"])
+}
+
+func (s *ControllerSuite) TestStealBonusOffByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, _ := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 2)
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'T'))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 1}))
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Empty(updated.StealBonuses)
+}
+
+func (s *ControllerSuite) TestAdjacentPlacementRejectsIsolatedLetters() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{AdjacentPlacement: true}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 3)
+	s.Require().NoError(err)
+	s.True(game.AdjacentPlacement)
+
+	// The first letter may go anywhere
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'C'))
+	s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 1, Col: 1}))
+
+	// Later letters must touch an existing one
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	err = controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	s.ErrorIs(err, model.ErrPlacementNotAdjacent)
+	s.Require().NoError(controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 1, Col: 2}))
+
+	// Announcing and placing at once is checked before anything is announced
+	_, err = controller.AnnounceAndPlace(s.ctx, game.ID, "player-1", 'T', model.Position{Row: 2, Col: 0})
+	s.ErrorIs(err, model.ErrPlacementNotAdjacent)
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+}
+
+func (s *ControllerSuite) TestIsolatedPlacementAllowedByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, 3)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'C'))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+	s.Require().NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+	s.NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 2, Col: 2}))
+}
+
+// Announce timeout tests
+
+func (s *ControllerSuite) TestCheckAnnounceTimeoutSkipsStalledAnnouncer() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{AnnounceTimeout: 30 * time.Second}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2", "player-3"}
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", players, 3)
+	s.Require().NoError(err)
+	s.Equal(s.clock.Now().Add(30*time.Second), game.AnnounceDeadline)
+
+	// Nothing happens before the deadline
+	s.clock.Advance(29 * time.Second)
+	skipped, err := controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.False(skipped)
+
+	s.clock.Advance(time.Second)
+	skipped, err = controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.True(skipped)
+
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.PlayerID("player-2"), updated.CurrentAnnouncer())
+	s.Equal(0, updated.CurrentTurn)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Equal(s.clock.Now().Add(30*time.Second), updated.AnnounceDeadline)
+	s.ErrorIs(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'C'), model.ErrNotPlayerTurn)
+
+	// The next announcer gets a full window, then the turn wraps round
+	s.clock.Advance(30 * time.Second)
+	_, _ = controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	s.clock.Advance(30 * time.Second)
+	_, _ = controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	updated, _ = controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.PlayerID("player-1"), updated.CurrentAnnouncer())
+}
+
+func (s *ControllerSuite) TestCheckAnnounceTimeoutIgnoresPlacing() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{AnnounceTimeout: 30 * time.Second}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'C'))
+	s.clock.Advance(time.Minute)
+	skipped, err := controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.False(skipped)
+
+	// Once the turn completes, the next announcer's window starts afresh
+	_ = controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	_ = controller.PlaceLetter(s.ctx, game.ID, "player-2", model.Position{Row: 0, Col: 0})
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.PlayerID("player-2"), updated.CurrentAnnouncer())
+	s.Equal(s.clock.Now().Add(30*time.Second), updated.AnnounceDeadline)
+}
+
+func (s *ControllerSuite) TestNoAnnounceTimeoutByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+	s.True(game.AnnounceDeadline.IsZero())
+
+	s.clock.Advance(time.Hour)
+	skipped, err := s.controller.CheckAnnounceTimeout(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.False(skipped)
+}
+
+func (s *ControllerSuite) TestWatchAnnounceTimeoutSkipsOnClock() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{AnnounceTimeout: 30 * time.Second}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "LOBBY1", State: model.LobbyStateInGame, CurrentGame: &game.ID}))
+
+	skips := make(chan struct{}, 1)
+	controller.WatchAnnounceTimeout(game.ID, func() { skips <- struct{}{} })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+
+	s.clock.Advance(30 * time.Second)
+	select {
+	case <-skips:
+	case <-time.After(time.Second):
+		s.FailNow("announcer was not skipped")
+	}
+
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.PlayerID("player-2"), updated.CurrentAnnouncer())
+
+	// The watcher stops once the game ends
+	s.Require().NoError(controller.AbandonGame(s.ctx, game.ID))
+	s.clock.Advance(30 * time.Second)
+	s.Never(func() bool { return s.clock.Waiters() > 0 }, 50*time.Millisecond, time.Millisecond)
+}
+
+func (s *ControllerSuite) TestWatchAnnounceTimeoutStopsWhenLobbyGone() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{AnnounceTimeout: 30 * time.Second}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "LOBBY1", State: model.LobbyStateInGame, CurrentGame: &game.ID}))
+
+	skips := make(chan struct{}, 1)
+	controller.WatchAnnounceTimeout(game.ID, func() { skips <- struct{}{} })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+
+	// The lobby expires with the game still announcing
+	s.Require().NoError(s.storage.DeleteLobby(s.ctx, "LOBBY1"))
+	s.clock.Advance(30 * time.Second)
+	<-skips
+	s.Never(func() bool { return s.clock.Waiters() > 0 }, 50*time.Millisecond, time.Millisecond)
+}
+
+// Max game duration tests
+
+func (s *ControllerSuite) TestWatchGameDurationAbandonsLongGame() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{MaxGameDuration: time.Hour}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	abandons := make(chan struct{}, 1)
+	controller.WatchGameDuration(game.ID, func() { abandons <- struct{}{} })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+
+	// Still running just short of the limit
+	s.clock.Advance(59 * time.Minute)
+	abandoned, err := controller.CheckGameDuration(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.False(abandoned)
+
+	s.clock.Advance(time.Minute)
+	select {
+	case <-abandons:
+	case <-time.After(time.Second):
+		s.FailNow("game was not abandoned")
+	}
+
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAbandoned, updated.State)
+}
+
+func (s *ControllerSuite) TestNoMaxGameDurationByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	s.clock.Advance(24 * time.Hour)
+	abandoned, err := s.controller.CheckGameDuration(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.False(abandoned)
+}
+
+// Announce rack tests
+
+func (s *ControllerSuite) TestRackDealtToAnnouncer() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{RackSize: 3}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	s.random.QueueIntn(2, 0, 19) // C, A, T
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	s.Equal([]rune{'C', 'A', 'T'}, game.Racks["player-1"])
+	s.Empty(game.Racks["player-2"])
+
+	// The used letter is replaced straight away
+	s.random.QueueIntn(25) // Z
+	s.Require().NoError(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'a'))
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal('A', updated.CurrentLetter)
+	s.Equal([]rune{'C', 'T', 'Z'}, updated.Racks["player-1"])
+
+	// The next announcer is dealt a rack when their turn starts
+	s.random.QueueIntn(4, 4, 4) // E, E, E
+	_ = controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	_ = controller.PlaceLetter(s.ctx, game.ID, "player-2", model.Position{Row: 0, Col: 0})
+	updated, _ = controller.GetGame(s.ctx, game.ID)
+	s.Equal([]rune{'E', 'E', 'E'}, updated.Racks["player-2"])
+}
+
+func (s *ControllerSuite) TestAnnounceRejectsLetterNotInRack() {
+	controller := NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, Config{RackSize: 3}, testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	s.random.QueueIntn(2, 0, 19) // C, A, T
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	s.ErrorIs(controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'Q'), model.ErrLetterNotInRack)
+
+	updated, _ := controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Equal([]rune{'C', 'A', 'T'}, updated.Racks["player-1"])
+}
+
+func (s *ControllerSuite) TestNoRackByDefault() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	s.Empty(game.Racks["player-1"])
+	s.NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'Q'))
+}
+
+// Simultaneous mode tests
+
+func (s *ControllerSuite) TestSimultaneousModeDrawsLetterEachTurn() {
+	s.random.QueueString("GAME12345678")
+	s.random.QueueIntn(0, 1, 2, 2) // Bag of A, B, C, C
+	s.random.QueueIntn(2)          // First C
+	players := []model.PlayerID{"player-1", "player-2"}
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 2, Mode: model.GameModeSimultaneous})
+	s.Require().NoError(err)
+
+	// Every turn starts with the letter already drawn
+	s.Equal(model.GameStatePlacing, game.State)
+	s.Equal('C', game.CurrentLetter)
+	s.Equal(map[rune]int{'A': 1, 'B': 1, 'C': 1}, game.Bag)
+	s.Empty(game.CurrentAnnouncer())
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'), model.ErrNotPlayerTurn)
+	s.Equal(model.TurnObligations{CanPlace: true, CurrentLetter: 'C'}, s.controller.TurnObligations(game, "player-1"))
+
+	s.random.QueueIntn(0) // A
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0}))
+	placed, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.TurnObligations{HasPlaced: true, CurrentLetter: 'C'}, s.controller.TurnObligations(placed, "player-1"))
+	s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, "player-2", model.Position{Row: 0, Col: 0}))
+
+	// The bag is restored from storage with the drawn letter taken out
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(1, updated.CurrentTurn)
+	s.Equal(model.GameStatePlacing, updated.State)
+	s.Equal('A', updated.CurrentLetter)
+	s.Equal(map[rune]int{'B': 1, 'C': 1}, updated.Bag)
+	s.Empty(updated.Placements)
+
+	// Playing out the rest of the board completes the game as usual
+	for _, pos := range []model.Position{{Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}} {
+		for _, playerID := range players {
+			s.Require().NoError(s.controller.PlaceLetter(s.ctx, game.ID, playerID, pos))
+		}
+	}
+	updated, _ = s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateScoring, updated.State)
+	s.Empty(updated.Bag)
+	drawn := make([]rune, len(updated.AnnouncedLetters))
+	for i, a := range updated.AnnouncedLetters {
+		drawn[i] = a.Letter
+	}
+	s.Equal([]rune{'C', 'A', 'B', 'C'}, drawn)
+}
+
+// Start acknowledgement tests
+
+func (s *ControllerSuite) TestAnnounceBlockedUntilAllPlayersAckStart() {
+	cfg := DefaultConfig()
+	cfg.RequireStartAck = true
+	s.controller = NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, cfg, testutil.NopLogger())
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, &model.Player{ID: "player-1"}))
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, &model.Player{ID: "player-2"}))
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, &model.Player{ID: "bot-1", IsBot: true}))
+
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2", "bot-1"}, 5)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{"player-1", "player-2"}, game.UnackedPlayers()) // Bots start acked
+
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'), model.ErrNotAllReady)
+
+	allReady, err := s.controller.AckStart(s.ctx, game.ID, "player-1")
+	s.Require().NoError(err)
+	s.False(allReady)
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'), model.ErrNotAllReady)
+
+	_, err = s.controller.AckStart(s.ctx, game.ID, "outsider")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+
+	allReady, err = s.controller.AckStart(s.ctx, game.ID, "player-2")
+	s.Require().NoError(err)
+	s.True(allReady)
+	s.NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+}
+
+func (s *ControllerSuite) TestStartAckTimeoutAllowsAnnounce() {
+	cfg := DefaultConfig()
+	cfg.RequireStartAck = true
+	cfg.StartAckTimeout = 10 * time.Second
+	s.controller = NewController(s.storage, s.boardService, s.scoringService, s.clock, s.random, cfg, testutil.NopLogger())
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, &model.Player{ID: "player-1"}))
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, &model.Player{ID: "player-2"}))
+
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 5)
+	s.Require().NoError(err)
+	s.ErrorIs(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'), model.ErrNotAllReady)
+
+	// Nobody acknowledged, but the wait is over
+	s.clock.Advance(10 * time.Second)
+	s.NoError(s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A'))
+}
+
+// Placement confirmation tests
+
+func (s *ControllerSuite) createConfirmGame(players []model.PlayerID) *model.Game {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 5, RequireConfirm: true})
+	s.Require().NoError(err)
+	return game
+}
+
+func (s *ControllerSuite) TestPlaceLetterWithRequireConfirmStagesPlacement() {
+	game := s.createConfirmGame([]model.PlayerID{"player-1", "player-2"})
+	_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+
+	err := s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 1, Col: 2})
+	s.Require().NoError(err)
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.False(updated.Placements["player-1"])
+	s.True(updated.HasPendingPlacement("player-1"))
+	s.Equal(model.Position{Row: 1, Col: 2}, updated.PendingPlacement["player-1"])
+
+	board, _ := s.boardService.GetBoard(s.ctx, game.ID, "player-1")
+	s.Equal(rune(0), board.Cells[1][2])
+}
+
+func (s *ControllerSuite) TestConfirmPlacementCommitsAndAdvancesTurn() {
+	game := s.createConfirmGame([]model.PlayerID{"player-1", "player-2"})
+	_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+	_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+	_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-2", model.Position{Row: 0, Col: 1})
+
+	s.Require().NoError(s.controller.ConfirmPlacement(s.ctx, game.ID, "player-1"))
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStatePlacing, updated.State)
+	s.True(updated.Placements["player-1"])
+
+	s.Require().NoError(s.controller.ConfirmPlacement(s.ctx, game.ID, "player-2"))
+	updated, _ = s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(1, updated.CurrentTurn)
+	s.Equal(model.GameStateAnnouncing, updated.State)
+	s.Empty(updated.PendingPlacement)
+
+	board, _ := s.boardService.GetBoard(s.ctx, game.ID, "player-2")
+	s.Equal('A', board.Cells[0][1])
+}
+
+func (s *ControllerSuite) TestCancelPlacementAllowsChoosingAgain() {
+	game := s.createConfirmGame([]model.PlayerID{"player-1", "player-2"})
+	_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+	_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 0, Col: 0})
+
+	s.Require().NoError(s.controller.CancelPlacement(s.ctx, game.ID, "player-1"))
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.False(updated.HasPendingPlacement("player-1"))
+	s.ErrorIs(s.controller.ConfirmPlacement(s.ctx, game.ID, "player-1"), model.ErrNoPendingPlacement)
+
+	err := s.controller.PlaceLetter(s.ctx, game.ID, "player-1", model.Position{Row: 2, Col: 2})
+	s.Require().NoError(err)
+	updated, _ = s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.Position{Row: 2, Col: 2}, updated.PendingPlacement["player-1"])
+}
+
+func (s *ControllerSuite) TestConfirmPlacementFailsWithoutPendingPlacement() {
+	game := s.createConfirmGame([]model.PlayerID{"player-1"})
+	_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+
+	err := s.controller.ConfirmPlacement(s.ctx, game.ID, "player-1")
+	s.ErrorIs(err, model.ErrNoPendingPlacement)
+}
+
+// Delayed reveal tests
+
+func (s *ControllerSuite) TestRevealScoresUnhidesDelayedScores() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, model.LobbyConfig{GridSize: 2, DelayedReveal: true})
+	s.Require().NoError(err)
+
+	positions := []model.Position{
+		{Row: 0, Col: 0}, {Row: 0, Col: 1},
+		{Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}
+	for _, pos := range positions {
+		_ = s.controller.AnnounceLetter(s.ctx, game.ID, "player-1", 'A')
+		_ = s.controller.PlaceLetter(s.ctx, game.ID, "player-1", pos)
+	}
+
+	updated, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(model.GameStateScoring, updated.State)
+	s.True(updated.ScoresHidden())
+	s.Zero(s.countEvents("LOBBY1", model.EventGameComplete), "scores are recorded only once revealed")
+
+	revealed, err := s.controller.RevealScores(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.True(revealed.ScoresRevealed)
+	s.False(revealed.ScoresHidden())
+	s.Equal(1, s.countEvents("LOBBY1", model.EventGameComplete))
+
+	stored, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.False(stored.ScoresHidden())
+}
+
+func (s *ControllerSuite) countEvents(code model.LobbyCode, eventType model.EventType) int {
+	events, err := s.storage.GetLobbyEvents(s.ctx, code)
+	s.Require().NoError(err)
+	count := 0
+	for _, e := range events {
+		if e.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *ControllerSuite) TestRevealNextRevealsLowestScoreFirst() {
+	s.random.QueueString("GAME12345678")
+	players := []model.PlayerID{"player-1", "player-2", "player-3"}
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", players, model.LobbyConfig{GridSize: 2, DelayedReveal: true})
+	s.Require().NoError(err)
+
+	// player-1 spells four words, player-3 one and player-2 none
+	for playerID, rows := range map[model.PlayerID][2]string{
+		"player-1": {"AT", "TO"},
+		"player-2": {"XX", "XX"},
+		"player-3": {"GO", "XX"},
+	} {
+		board := model.NewBoard(game.ID, playerID, 2)
+		for row, letters := range rows {
+			for col, l := range letters {
+				board.Set(model.Position{Row: row, Col: col}, l)
+			}
+		}
+		s.Require().NoError(s.storage.SaveBoard(s.ctx, board))
+	}
+	game.State = model.GameStateScoring
+	s.Require().NoError(s.storage.SaveGame(s.ctx, game))
+
+	var order []model.PlayerID
+	var totals []int
+	for range players {
+		revealed, score, err := s.controller.RevealNext(s.ctx, game.ID)
+		s.Require().NoError(err)
+		order = append(order, score.PlayerID)
+		totals = append(totals, score.TotalScore)
+		s.Equal(len(order) == len(players), revealed.ScoresRevealed)
+		if !revealed.ScoresRevealed {
+			s.Zero(s.countEvents("LOBBY1", model.EventGameComplete))
+		}
+	}
+	s.Equal(1, s.countEvents("LOBBY1", model.EventGameComplete))
+
+	s.Equal([]model.PlayerID{"player-2", "player-3", "player-1"}, order)
+	s.IsIncreasing(totals)
+
+	stored, _ := s.controller.GetGame(s.ctx, game.ID)
+	s.Equal(order, stored.RevealedPlayers)
+	s.False(stored.ScoresHidden())
+
+	_, _, err = s.controller.RevealNext(s.ctx, game.ID)
+	s.ErrorIs(err, model.ErrAllRevealed)
+}
+
+func (s *ControllerSuite) TestRevealScoresFailsIfGameNotComplete() {
+	s.random.QueueString("GAME12345678")
+	game, err := s.controller.CreateGameWithConfig(s.ctx, "LOBBY1", []model.PlayerID{"player-1"}, model.LobbyConfig{GridSize: 2, DelayedReveal: true})
+	s.Require().NoError(err)
+
+	_, err = s.controller.RevealScores(s.ctx, game.ID)
+	s.ErrorIs(err, model.ErrGameNotComplete)
+}
+
+// Standings tests
+
+func (s *ControllerSuite) TestGetStandingsLeavesOutUniqueWordBonusMidGame() {
+	scoringService := scoring.New(s.dictService, scoring.Config{UniqueWordBonus: 5})
+	controller := NewController(s.storage, s.boardService, scoringService, s.clock, s.random, DefaultConfig(), testutil.NopLogger())
+	s.random.QueueString("GAME12345678")
+	game, err := controller.CreateGame(s.ctx, "LOBBY1", []model.PlayerID{"player-1", "player-2"}, 3)
+	s.Require().NoError(err)
+
+	board, err := s.storage.GetBoard(s.ctx, game.ID, "player-1")
+	s.Require().NoError(err)
+	for i, letter := range "CAT" {
+		board.Set(model.Position{Row: 0, Col: i}, letter)
+	}
+	s.Require().NoError(s.storage.SaveBoard(s.ctx, board))
+
+	standings, err := controller.GetStandings(s.ctx, game.ID)
+	s.Require().NoError(err)
+	s.Require().Len(standings, 2)
+	s.Equal(model.PlayerID("player-1"), standings[0].Score.PlayerID)
+	s.Equal(0, standings[0].Score.UniqueBonus)
+	s.Equal(6, standings[0].Score.TotalScore)
+}
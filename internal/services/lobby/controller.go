@@ -2,7 +2,12 @@ package lobby
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/dependencies/random"
@@ -18,13 +23,65 @@ const (
 	LobbyCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
 )
 
+// Config holds configuration for the lobby controller
+type Config struct {
+	// MaxGameHistory caps the number of completed games kept per lobby
+	MaxGameHistory int
+	// GridSizeBounds limits the grid sizes a lobby may be configured with
+	GridSizeBounds model.GridSizeBounds
+	// AllowDuplicateNames disables labelling members that share a display
+	// name as "Alice (2)" etc.
+	AllowDuplicateNames bool
+	// MaxLobbies caps the number of concurrent lobbies (0 means unlimited)
+	MaxLobbies int
+	// HostAbsenceTimeout is how long the host must have been disconnected
+	// before another member can claim host
+	HostAbsenceTimeout time.Duration
+	// Presence reports which members are connected, for claiming host
+	// If nil, the host is always treated as present
+	Presence Presence
+	// AutoDismissDelay is how long a finished game's scores stay up before the
+	// game is dismissed for the host (0 disables auto-dismiss)
+	AutoDismissDelay time.Duration
+	// GameCooldown is how long after a game is completed before the lobby
+	// can start another, to avoid accidental instant rematches (0 disables)
+	GameCooldown time.Duration
+}
+
+// Presence reports when players were last connected to a lobby
+type Presence interface {
+	// LastSeen returns when the player was last connected, and whether they
+	// are connected now. A zero time means they haven't been seen.
+	LastSeen(code model.LobbyCode, playerID model.PlayerID) (time.Time, bool)
+}
+
+// DefaultConfig returns default lobby configuration
+func DefaultConfig() Config {
+	return Config{
+		MaxGameHistory:     50,
+		GridSizeBounds:     model.DefaultGridSizeBounds(),
+		HostAbsenceTimeout: 2 * time.Minute,
+	}
+}
+
 // Controller manages lobby state machine and member operations
 type Controller struct {
 	storage        storage.Storage
 	gameController *game.Controller
 	clock          clock.Clock
 	random         random.Random
+	cfg            Config
 	logger         *slog.Logger
+
+	// dismissMu guards dismissTimers, which cancels each lobby's pending
+	// auto-dismiss when the game is dismissed some other way
+	dismissMu     sync.Mutex
+	dismissTimers map[model.LobbyCode]chan struct{}
+
+	// completeMu serialises CompleteGame, so a game finished from two paths
+	// at once (say a bot's move and the host dismissing it) is only counted
+	// once
+	completeMu sync.Mutex
 }
 
 // NewController creates a new LobbyController
@@ -33,14 +90,27 @@ func NewController(
 	gameController *game.Controller,
 	clock clock.Clock,
 	random random.Random,
+	cfg Config,
 	logger *slog.Logger,
 ) *Controller {
+	if cfg.MaxGameHistory == 0 {
+		cfg.MaxGameHistory = DefaultConfig().MaxGameHistory
+	}
+	if cfg.GridSizeBounds.Min == 0 && cfg.GridSizeBounds.Max == 0 {
+		cfg.GridSizeBounds.Min = DefaultConfig().GridSizeBounds.Min
+		cfg.GridSizeBounds.Max = DefaultConfig().GridSizeBounds.Max
+	}
+	if cfg.HostAbsenceTimeout == 0 {
+		cfg.HostAbsenceTimeout = DefaultConfig().HostAbsenceTimeout
+	}
 	return &Controller{
 		storage:        storage,
 		gameController: gameController,
 		clock:          clock,
 		random:         random,
+		cfg:            cfg,
 		logger:         logger,
+		dismissTimers:  make(map[model.LobbyCode]chan struct{}),
 	}
 }
 
@@ -48,23 +118,32 @@ func NewController(
 func (c *Controller) CreateLobby(ctx context.Context, host model.Player) (*model.Lobby, error) {
 	now := c.clock.Now()
 
-	// Generate unique lobby code
-	var code model.LobbyCode
-	for {
-		code = model.LobbyCode(c.random.String(LobbyCodeLength, LobbyCodeAlphabet))
-		exists, err := c.storage.LobbyExists(ctx, code)
+	if c.cfg.MaxLobbies > 0 {
+		count, err := c.storage.CountLobbies(ctx)
 		if err != nil {
 			return nil, err
 		}
-		if !exists {
-			break
+		if count >= c.cfg.MaxLobbies {
+			return nil, model.ErrServerAtCapacity
 		}
 	}
 
+	code, err := c.generateCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the default grid size within the configured bounds
+	config := model.DefaultLobbyConfig()
+	config.GridSize = c.cfg.GridSizeBounds.Clamp(config.GridSize)
+	if err := config.Validate(c.cfg.GridSizeBounds); err != nil {
+		return nil, err
+	}
+
 	lobby := &model.Lobby{
 		Code:   code,
 		State:  model.LobbyStateWaiting,
-		Config: model.DefaultLobbyConfig(),
+		Config: config,
 		Members: []model.LobbyMember{
 			{
 				Player:   host,
@@ -80,31 +159,284 @@ func (c *Controller) CreateLobby(ctx context.Context, host model.Player) (*model
 	}
 
 	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
-		c.logger.Error("failed to save lobby",
+		c.logger.ErrorContext(ctx, "failed to save lobby",
 			slog.String("lobby_code", string(code)),
 			slog.String("error", err.Error()),
 		)
 		return nil, err
 	}
 
-	c.logger.Info("lobby created",
+	c.logger.InfoContext(ctx, "lobby created",
 		slog.String("lobby_code", string(code)),
 		slog.String("host_id", string(host.ID)),
 	)
 
+	c.recordEvent(ctx, code, model.EventLobbyCreated, host.ID, model.PlayerJoinedPayload{
+		Player: host,
+		Role:   model.RolePlayer,
+	})
+
 	return lobby, nil
 }
 
+// generateCode returns a lobby code no existing lobby is using
+func (c *Controller) generateCode(ctx context.Context) (model.LobbyCode, error) {
+	for {
+		code := model.LobbyCode(c.random.String(LobbyCodeLength, LobbyCodeAlphabet))
+		exists, err := c.storage.LobbyExists(ctx, code)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return code, nil
+		}
+	}
+}
+
+// ExportLobby gathers a lobby with its members' player records and its
+// current game and boards, for ImportLobby to recreate elsewhere
+func (c *Controller) ExportLobby(ctx context.Context, code model.LobbyCode) (*model.LobbyExport, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &model.LobbyExport{Lobby: lobby, Players: []*model.Player{}, Boards: []*model.Board{}}
+	for _, m := range lobby.Members {
+		player, err := c.storage.GetPlayer(ctx, m.Player.ID)
+		if errors.Is(err, model.ErrPlayerNotFound) {
+			// Expired from storage; the member's copy is all there is
+			player = &m.Player
+		} else if err != nil {
+			return nil, err
+		}
+		export.Players = append(export.Players, player)
+	}
+
+	if lobby.CurrentGame != nil {
+		export.Game, err = c.gameController.GetGame(ctx, *lobby.CurrentGame)
+		if err != nil {
+			return nil, err
+		}
+		export.Boards, err = c.storage.GetBoardsForGame(ctx, export.Game.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return export, nil
+}
+
+// ImportLobby recreates a lobby from ExportLobby. The lobby gets a new code
+// if its own is taken here, and its game a new ID likewise. Players already
+// stored here are kept as they are, but none may be in another lobby.
+// The export is checked before anything is written, and anything written is
+// removed again if a later step fails.
+func (c *Controller) ImportLobby(ctx context.Context, export *model.LobbyExport) (*model.Lobby, error) {
+	if err := c.validateExport(export); err != nil {
+		return nil, err
+	}
+
+	for _, m := range export.Lobby.Members {
+		code, err := c.storage.GetLobbyForPlayer(ctx, m.Player.ID)
+		if err != nil {
+			return nil, err
+		}
+		if code != "" {
+			return nil, fmt.Errorf("%w: player %s is in lobby %s", model.ErrAlreadyInLobby, m.Player.ID, code)
+		}
+	}
+
+	var createdPlayers []model.PlayerID
+	var claimedCode model.LobbyCode
+	var importedGame model.GameID
+	rollback := func() {
+		if importedGame != "" {
+			_ = c.storage.DeleteBoardsForGame(ctx, importedGame)
+			_ = c.storage.DeleteGame(ctx, importedGame)
+		}
+		if claimedCode != "" {
+			_ = c.storage.DeleteLobby(ctx, claimedCode)
+		}
+		for _, id := range createdPlayers {
+			_ = c.storage.DeletePlayer(ctx, id)
+		}
+	}
+
+	for _, p := range export.Players {
+		if _, err := c.storage.GetPlayer(ctx, p.ID); err == nil {
+			continue
+		} else if !errors.Is(err, model.ErrPlayerNotFound) {
+			rollback()
+			return nil, err
+		}
+		if err := c.storage.SavePlayer(ctx, p); err != nil {
+			rollback()
+			return nil, err
+		}
+		createdPlayers = append(createdPlayers, p.ID)
+	}
+
+	// Claim the lobby's code, or a new one if it is taken here, before the
+	// game is saved under it
+	lobby := *export.Lobby
+	lobby.CurrentGame = nil
+	for {
+		err := c.storage.CreateLobby(ctx, &lobby)
+		if err == nil {
+			claimedCode = lobby.Code
+			break
+		}
+		if !errors.Is(err, model.ErrLobbyCodeTaken) {
+			rollback()
+			return nil, err
+		}
+		if lobby.Code, err = c.generateCode(ctx); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+
+	if export.Game != nil {
+		g, err := c.gameController.ImportGame(ctx, lobby.Code, export.Game, export.Boards)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+		importedGame = g.ID
+		lobby.CurrentGame = &g.ID
+		if err := c.storage.SaveLobby(ctx, &lobby); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+
+	c.logger.InfoContext(ctx, "lobby imported",
+		slog.String("lobby_code", string(lobby.Code)),
+		slog.String("exported_code", string(export.Lobby.Code)),
+		slog.Int("member_count", len(lobby.Members)),
+	)
+	return &lobby, nil
+}
+
+// validateExport checks a lobby export is complete and consistent before
+// ImportLobby writes any of it. Errors wrap ErrInvalidLobbyExport.
+func (c *Controller) validateExport(export *model.LobbyExport) error {
+	if export == nil || export.Lobby == nil {
+		return fmt.Errorf("%w: no lobby", model.ErrInvalidLobbyExport)
+	}
+	lobby := export.Lobby
+	if !validLobbyCode(lobby.Code) {
+		return fmt.Errorf("%w: invalid lobby code %q", model.ErrInvalidLobbyExport, lobby.Code)
+	}
+	if err := lobby.Config.Validate(c.cfg.GridSizeBounds); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInvalidLobbyExport, err)
+	}
+
+	hosts := 0
+	for _, m := range lobby.Members {
+		if m.IsHost {
+			hosts++
+		}
+	}
+	if hosts != 1 {
+		return fmt.Errorf("%w: lobby has %d hosts, want 1", model.ErrInvalidLobbyExport, hosts)
+	}
+
+	if (lobby.CurrentGame == nil) != (export.Game == nil) ||
+		(export.Game != nil && export.Game.ID != *lobby.CurrentGame) {
+		return fmt.Errorf("%w: current game doesn't match the lobby", model.ErrInvalidLobbyExport)
+	}
+	if export.Game != nil && export.Game.GridSize != lobby.Config.GridSize {
+		return fmt.Errorf("%w: game is %dx%d but the lobby is %dx%d", model.ErrInvalidLobbyExport,
+			export.Game.GridSize, export.Game.GridSize, lobby.Config.GridSize, lobby.Config.GridSize)
+	}
+	return nil
+}
+
+// validLobbyCode reports whether code has the shape of a lobby code
+func validLobbyCode(code model.LobbyCode) bool {
+	if len(code) != LobbyCodeLength {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// GridSizes lists the grid sizes lobbies may be configured with, ascending
+func (c *Controller) GridSizes() []int {
+	return c.cfg.GridSizeBounds.Sizes()
+}
+
 // GetLobby retrieves a lobby by code
 func (c *Controller) GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lobby, error) {
 	return c.storage.GetLobby(ctx, code)
 }
 
+// ListGames returns the lobby's current game (nil if there is none) and the
+// summaries of its completed games, oldest first
+func (c *Controller) ListGames(ctx context.Context, code model.LobbyCode) (*model.Game, []model.GameSummary, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var current *model.Game
+	if lobby.CurrentGame != nil {
+		current, err = c.gameController.GetGame(ctx, *lobby.CurrentGame)
+		if errors.Is(err, model.ErrGameNotFound) {
+			current = nil // Expired from storage; the history is still useful
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return current, lobby.GameHistory, nil
+}
+
 // GetActiveLobbyCode returns the lobby code for a player's active lobby, if any
 func (c *Controller) GetActiveLobbyCode(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, error) {
 	return c.storage.GetLobbyForPlayer(ctx, playerID)
 }
 
+// GetActiveGame returns the lobby and game a player can resume, if any
+// Returns empty values if the player is not in a lobby with an in-progress
+// game, including when the lobby or game has expired from storage
+func (c *Controller) GetActiveGame(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, model.GameID, error) {
+	code, err := c.storage.GetLobbyForPlayer(ctx, playerID)
+	if err != nil || code == "" {
+		return "", "", err
+	}
+
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if errors.Is(err, model.ErrLobbyNotFound) {
+		return "", "", nil // Expired since the index was read
+	} else if err != nil {
+		return "", "", err
+	}
+
+	if lobby.State != model.LobbyStateInGame || lobby.CurrentGame == nil {
+		return code, "", nil
+	}
+
+	game, err := c.gameController.GetGame(ctx, *lobby.CurrentGame)
+	if errors.Is(err, model.ErrGameNotFound) {
+		return code, "", nil // Expired from storage; nothing to resume
+	} else if err != nil {
+		return "", "", err
+	}
+
+	// Finished games are no longer resumable
+	if game.State == model.GameStateScoring || game.State == model.GameStateAbandoned {
+		return code, "", nil
+	}
+
+	return code, game.ID, nil
+}
+
 // JoinLobby adds a player to a lobby
 func (c *Controller) JoinLobby(ctx context.Context, code model.LobbyCode, player model.Player) error {
 	lobby, err := c.storage.GetLobby(ctx, code)
@@ -117,37 +449,111 @@ func (c *Controller) JoinLobby(ctx context.Context, code model.LobbyCode, player
 		return model.ErrAlreadyInLobby
 	}
 
-	// Determine role - spectator if game in progress, player otherwise
+	// Determine role - spectator if game in progress, the lobby is full or
+	// the host has every joiner spectate, player otherwise. Bots can't
+	// spectate, so they always take a seat and a full lobby turns them away.
 	role := model.RolePlayer
-	if lobby.State == model.LobbyStateInGame {
+	if player.IsBot {
+		if lobby.IsFull() {
+			return model.ErrLobbyFull
+		}
+	} else if lobby.State == model.LobbyStateInGame || lobby.IsFull() || lobby.Config.LateJoinRole == model.RoleSpectator {
 		role = model.RoleSpectator
 	}
 
 	lobby.Members = append(lobby.Members, model.LobbyMember{
-		Player:   player,
-		Role:     role,
-		IsHost:   false,
-		JoinedAt: c.clock.Now(),
+		Player:       player,
+		Role:         role,
+		IsHost:       false,
+		JoinedAt:     c.clock.Now(),
+		DisplayLabel: c.memberLabel(lobby, player),
 	})
 	lobby.UpdatedAt = c.clock.Now()
 
 	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
-		c.logger.Error("failed to save lobby after join",
+		c.logger.ErrorContext(ctx, "failed to save lobby after join",
 			slog.String("lobby_code", string(code)),
 			slog.String("error", err.Error()),
 		)
 		return err
 	}
 
-	c.logger.Info("player joined lobby",
+	c.logger.InfoContext(ctx, "player joined lobby",
 		slog.String("lobby_code", string(code)),
 		slog.String("player_id", string(player.ID)),
 		slog.String("role", string(role)),
 	)
 
+	c.recordEvent(ctx, code, model.EventPlayerJoined, player.ID, model.PlayerJoinedPayload{
+		Player: player,
+		Role:   role,
+	})
+
+	// The joiner who fills the lobby starts the game on the host's behalf
+	if lobby.Config.AutoStart && role == model.RolePlayer && lobby.IsFull() {
+		host := lobby.GetHost()
+		if host == nil {
+			return nil
+		}
+		if _, err := c.startGame(ctx, lobby, host.Player.ID, ""); err != nil {
+			// The join itself succeeded; the host can still start manually
+			c.logger.WarnContext(ctx, "failed to auto-start game",
+				slog.String("lobby_code", string(code)),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
 	return nil
 }
 
+// UpdateMemberDisplayName propagates a player's new display name into the
+// lobby they are currently a member of
+// Returns nil if the player is not in a lobby
+func (c *Controller) UpdateMemberDisplayName(ctx context.Context, playerID model.PlayerID, displayName string) (*model.Lobby, error) {
+	code, err := c.storage.GetLobbyForPlayer(ctx, playerID)
+	if err != nil || code == "" {
+		return nil, err
+	}
+
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	member := lobby.GetMember(playerID)
+	if member == nil {
+		return nil, nil
+	}
+	member.Player.DisplayName = displayName
+	member.DisplayLabel = c.memberLabel(lobby, member.Player)
+	lobby.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		c.logger.ErrorContext(ctx, "failed to save lobby after rename",
+			slog.String("lobby_code", string(code)),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	return lobby, nil
+}
+
+// memberLabel returns the label to store for a member, disambiguating it from
+// other members with the same display name unless duplicates are allowed
+// Returns empty if the display name can be shown as-is
+func (c *Controller) memberLabel(lobby *model.Lobby, player model.Player) string {
+	if c.cfg.AllowDuplicateNames {
+		return ""
+	}
+	label := lobby.UniqueLabel(player.DisplayName, player.ID)
+	if label == player.DisplayName {
+		return ""
+	}
+	return label
+}
+
 // LeaveLobby removes a player from a lobby
 func (c *Controller) LeaveLobby(ctx context.Context, code model.LobbyCode, playerID model.PlayerID) error {
 	lobby, err := c.storage.GetLobby(ctx, code)
@@ -162,6 +568,7 @@ func (c *Controller) LeaveLobby(ctx context.Context, code model.LobbyCode, playe
 
 	wasHost := member.IsHost
 	wasPlayer := member.Role == model.RolePlayer
+	displayName := member.Player.DisplayName
 
 	// Remove member
 	for i, m := range lobby.Members {
@@ -177,7 +584,7 @@ func (c *Controller) LeaveLobby(ctx context.Context, code model.LobbyCode, playe
 		if lobby.CurrentGame != nil {
 			_ = c.gameController.AbandonGame(ctx, *lobby.CurrentGame)
 		}
-		c.logger.Info("lobby deleted (empty)",
+		c.logger.InfoContext(ctx, "lobby deleted (empty)",
 			slog.String("lobby_code", string(code)),
 		)
 		return c.storage.DeleteLobby(ctx, code)
@@ -202,13 +609,29 @@ func (c *Controller) LeaveLobby(ctx context.Context, code model.LobbyCode, playe
 
 	lobby.UpdatedAt = c.clock.Now()
 
-	c.logger.Info("player left lobby",
+	c.logger.InfoContext(ctx, "player left lobby",
 		slog.String("lobby_code", string(code)),
 		slog.String("player_id", string(playerID)),
 		slog.Bool("was_host", wasHost),
 	)
 
-	return c.storage.SaveLobby(ctx, lobby)
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	c.recordEvent(ctx, code, model.EventPlayerLeft, playerID, model.PlayerLeftPayload{
+		PlayerID:    playerID,
+		DisplayName: displayName,
+	})
+	if wasHost {
+		newHostID := lobby.Members[0].Player.ID
+		c.recordEvent(ctx, code, model.EventHostChanged, newHostID, model.HostChangedPayload{
+			OldHostID: playerID,
+			NewHostID: newHostID,
+		})
+	}
+
+	return nil
 }
 
 // SetRole changes a member's role (player/spectator)
@@ -228,7 +651,56 @@ func (c *Controller) SetRole(ctx context.Context, code model.LobbyCode, playerID
 		return model.ErrNotInLobby
 	}
 
+	oldRole := member.Role
 	member.Role = role
+	if oldRole == model.RoleSpectator && role == model.RolePlayer {
+		// Newly promoted players take the last seat, so they announce after
+		// the existing players rather than shifting everyone's turn
+		lobby.MoveMemberToEnd(playerID)
+	}
+	lobby.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	c.recordEvent(ctx, code, model.EventRoleChanged, playerID, model.RoleChangedPayload{
+		PlayerID: playerID,
+		OldRole:  oldRole,
+		NewRole:  role,
+	})
+
+	return nil
+}
+
+// SetReady marks a member as ready, or not, for the next game
+func (c *Controller) SetReady(ctx context.Context, code model.LobbyCode, playerID model.PlayerID, ready bool) error {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	// Readiness only matters between games, which includes once the current
+	// game has finished and the lobby is waiting on a rematch
+	if lobby.State == model.LobbyStateInGame {
+		if lobby.CurrentGame == nil {
+			return model.ErrGameInProgress
+		}
+		g, err := c.gameController.GetGame(ctx, *lobby.CurrentGame)
+		if err != nil {
+			return err
+		}
+		if g.State != model.GameStateScoring {
+			return model.ErrGameInProgress
+		}
+	}
+
+	member := lobby.GetMember(playerID)
+	if member == nil {
+		return model.ErrNotInLobby
+	}
+
+	member.Ready = ready
 	lobby.UpdatedAt = c.clock.Now()
 
 	return c.storage.SaveLobby(ctx, lobby)
@@ -258,71 +730,344 @@ func (c *Controller) TransferHost(ctx context.Context, code model.LobbyCode, req
 	newHost.IsHost = true
 	lobby.UpdatedAt = c.clock.Now()
 
-	return c.storage.SaveLobby(ctx, lobby)
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	c.recordEvent(ctx, code, model.EventHostChanged, newHostID, model.HostChangedPayload{
+		OldHostID: requestingPlayer,
+		NewHostID: newHostID,
+	})
+
+	return nil
 }
 
-// StartGame begins a new game with current players
-func (c *Controller) StartGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error) {
+// ClaimHost lets a member take over from a host who has gone away
+// The claim is rejected while the host is connected or was seen within
+// HostAbsenceTimeout. Host passes to the longest-tenured human member, who
+// may not be the requester. Returns the updated lobby.
+func (c *Controller) ClaimHost(ctx context.Context, code model.LobbyCode, requester model.PlayerID) (*model.Lobby, error) {
 	lobby, err := c.storage.GetLobby(ctx, code)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify requester is host
-	host := lobby.GetHost()
-	if host == nil || host.Player.ID != requestingPlayer {
-		return nil, model.ErrNotHost
-	}
-
-	// Cannot start if game in progress
-	if lobby.State == model.LobbyStateInGame {
-		return nil, model.ErrGameInProgress
+	if lobby.GetMember(requester) == nil {
+		return nil, model.ErrNotInLobby
 	}
 
-	// Get players (not spectators)
-	players := lobby.GetPlayers()
-	if len(players) == 0 {
-		return nil, model.ErrInsufficientPlayers
+	host := lobby.GetHost()
+	if host != nil {
+		if host.Player.ID == requester {
+			return lobby, nil
+		}
+		if c.hostActive(code, host) {
+			return nil, model.ErrHostPresent
+		}
 	}
 
-	// Extract player IDs
-	playerIDs := make([]model.PlayerID, len(players))
-	for i, p := range players {
-		playerIDs[i] = p.Player.ID
+	newHost := longestTenuredMember(lobby)
+	if newHost == nil {
+		return nil, model.ErrNotInLobby
 	}
 
-	// Create game
-	g, err := c.gameController.CreateGame(ctx, code, playerIDs, lobby.Config.GridSize)
-	if err != nil {
-		return nil, err
+	var oldHostID model.PlayerID
+	if host != nil {
+		oldHostID = host.Player.ID
+		host.IsHost = false
 	}
-
-	// Update lobby state
-	lobby.State = model.LobbyStateInGame
-	lobby.CurrentGame = &g.ID
+	newHost.IsHost = true
 	lobby.UpdatedAt = c.clock.Now()
 
 	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
-		c.logger.Error("failed to save lobby after game start",
-			slog.String("lobby_code", string(code)),
-			slog.String("error", err.Error()),
-		)
 		return nil, err
 	}
 
-	c.logger.Info("game started in lobby",
+	c.logger.InfoContext(ctx, "host claimed",
 		slog.String("lobby_code", string(code)),
-		slog.String("game_id", string(g.ID)),
-		slog.Int("player_count", len(playerIDs)),
+		slog.String("old_host_id", string(oldHostID)),
+		slog.String("new_host_id", string(newHost.Player.ID)),
+		slog.String("requested_by", string(requester)),
 	)
 
-	return g, nil
+	c.recordEvent(ctx, code, model.EventHostChanged, newHost.Player.ID, model.HostChangedPayload{
+		OldHostID: oldHostID,
+		NewHostID: newHost.Player.ID,
+	})
+
+	return lobby, nil
 }
 
-// AbandonGame ends the current game
-func (c *Controller) AbandonGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) error {
-	lobby, err := c.storage.GetLobby(ctx, code)
-	if err != nil {
+// hostActive reports whether the host is connected or was seen recently
+// A host who has never been seen counts from when they joined.
+func (c *Controller) hostActive(code model.LobbyCode, host *model.LobbyMember) bool {
+	if c.cfg.Presence == nil {
+		return true
+	}
+
+	lastSeen, present := c.cfg.Presence.LastSeen(code, host.Player.ID)
+	if present {
+		return true
+	}
+	if lastSeen.Before(host.JoinedAt) {
+		lastSeen = host.JoinedAt
+	}
+	return c.clock.Now().Sub(lastSeen) < c.cfg.HostAbsenceTimeout
+}
+
+// longestTenuredMember returns the earliest-joined human member other than
+// the host, preferring member order on ties
+func longestTenuredMember(lobby *model.Lobby) *model.LobbyMember {
+	var best *model.LobbyMember
+	for i := range lobby.Members {
+		m := &lobby.Members[i]
+		if m.IsHost || m.Player.IsBot {
+			continue
+		}
+		if best == nil || m.JoinedAt.Before(best.JoinedAt) {
+			best = m
+		}
+	}
+	return best
+}
+
+// ShuffleSeats randomly reorders the lobby members (host only, between games)
+// Announcer order follows member order, so this also picks a new first announcer
+func (c *Controller) ShuffleSeats(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Lobby, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+
+	if lobby.State == model.LobbyStateInGame {
+		return nil, model.ErrGameInProgress
+	}
+
+	// Fisher-Yates shuffle using the injected random source, or the lobby's
+	// seed so a seeded lobby shuffles the same way each time it's replayed
+	rng := random.ForSeed(c.random, lobby.Config.Seed, fmt.Sprintf("shuffle/%d", len(lobby.GameHistory)))
+	for i := len(lobby.Members) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		lobby.Members[i], lobby.Members[j] = lobby.Members[j], lobby.Members[i]
+	}
+	lobby.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return nil, err
+	}
+
+	return lobby, nil
+}
+
+// RegenerateCode moves the lobby to a new code so a leaked one stops working
+// (host only, between games). Members keep their seats. Returns the updated lobby.
+func (c *Controller) RegenerateCode(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Lobby, error) {
+	// The checks run inside the rename so a game can't start, or the host
+	// change, between checking and moving the lobby
+	check := func(lobby *model.Lobby) error {
+		host := lobby.GetHost()
+		if host == nil || host.Player.ID != requestingPlayer {
+			return model.ErrNotHost
+		}
+		if lobby.State == model.LobbyStateInGame {
+			return model.ErrGameInProgress
+		}
+		return nil
+	}
+
+	var newCode model.LobbyCode
+	for {
+		var err error
+		newCode, err = c.generateCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Another lobby may claim the code between generating and renaming
+		err = c.storage.RenameLobby(ctx, code, newCode, check)
+		if errors.Is(err, model.ErrLobbyCodeTaken) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	c.logger.InfoContext(ctx, "lobby code regenerated",
+		slog.String("old_code", string(code)),
+		slog.String("lobby_code", string(newCode)),
+	)
+
+	c.recordEvent(ctx, newCode, model.EventCodeChanged, requestingPlayer, model.CodeChangedPayload{
+		OldCode: code,
+		NewCode: newCode,
+	})
+
+	return c.storage.GetLobby(ctx, newCode)
+}
+
+// StartGame begins a new game with current players
+func (c *Controller) StartGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error) {
+	return c.StartGameWithID(ctx, code, requestingPlayer, "")
+}
+
+// StartGameWithID is StartGame with a caller-chosen game ID, letting clients
+// make starting idempotent. An empty ID generates a random one.
+// Returns ErrGameExists if a game with the ID exists, including when a retry
+// finds the game it already started.
+func (c *Controller) StartGameWithID(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, gameID model.GameID) (*model.Game, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify requester is host
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+
+	return c.startGame(ctx, lobby, requestingPlayer, gameID)
+}
+
+// PreviewGame returns the players a game started now would have, in the
+// order they would announce, without starting it (host only)
+// The first player announces first.
+func (c *Controller) PreviewGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) ([]model.PlayerID, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+
+	if lobby.State == model.LobbyStateInGame {
+		return nil, model.ErrGameInProgress
+	}
+
+	return gamePlayerIDs(lobby)
+}
+
+// gamePlayerIDs returns the lobby's players (not spectators) in seat order,
+// which is the order they announce in
+func gamePlayerIDs(lobby *model.Lobby) ([]model.PlayerID, error) {
+	players := lobby.GetPlayers()
+	if len(players) == 0 {
+		return nil, model.ErrInsufficientPlayers
+	}
+
+	// Skip any member listed twice by a racing join
+	playerIDs := make([]model.PlayerID, 0, len(players))
+	seen := make(map[model.PlayerID]bool, len(players))
+	for _, p := range players {
+		if seen[p.Player.ID] {
+			continue
+		}
+		seen[p.Player.ID] = true
+		playerIDs = append(playerIDs, p.Player.ID)
+	}
+	return playerIDs, nil
+}
+
+// startGame creates a game for the lobby's current players, with a random ID
+// if gameID is empty. Callers are responsible for checking startedBy may
+// start the game.
+func (c *Controller) startGame(ctx context.Context, lobby *model.Lobby, startedBy model.PlayerID, gameID model.GameID) (*model.Game, error) {
+	code := lobby.Code
+
+	// A retried start finds its own game already running
+	if gameID != "" && lobby.CurrentGame != nil && *lobby.CurrentGame == gameID {
+		return nil, model.ErrGameExists
+	}
+
+	// Cannot start if game in progress
+	if lobby.State == model.LobbyStateInGame {
+		return nil, model.ErrGameInProgress
+	}
+
+	playerIDs, err := gamePlayerIDs(lobby)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkCanStart(lobby, playerIDs); err != nil {
+		return nil, err
+	}
+
+	// Create game
+	g, err := c.gameController.CreateGameWithID(ctx, code, playerIDs, lobby.Config, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update lobby state
+	lobby.State = model.LobbyStateInGame
+	lobby.CurrentGame = &g.ID
+	lobby.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		c.logger.ErrorContext(ctx, "failed to save lobby after game start",
+			slog.String("lobby_code", string(code)),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "game started in lobby",
+		slog.String("lobby_code", string(code)),
+		slog.String("game_id", string(g.ID)),
+		slog.Int("player_count", len(playerIDs)),
+	)
+
+	c.recordEvent(ctx, code, model.EventGameStarted, startedBy, model.GameStartedPayload{
+		GameID:   g.ID,
+		Players:  playerIDs,
+		GridSize: g.GridSize,
+	})
+
+	return g, nil
+}
+
+// checkCanStart applies the checks every new game must pass, whether started
+// by the host or as a rematch, to the players it would include
+func (c *Controller) checkCanStart(lobby *model.Lobby, playerIDs []model.PlayerID) error {
+	if lobby.Config.RequireReady && !lobby.AllPlayersReady() {
+		return model.ErrPlayersNotReady
+	}
+
+	humans := 0
+	for _, id := range playerIDs {
+		if m := lobby.GetMember(id); m != nil && !m.Player.IsBot {
+			humans++
+		}
+	}
+	if humans < lobby.Config.MinHumanPlayers {
+		return fmt.Errorf("%w: %d needed, %d in lobby", model.ErrInsufficientHumans, lobby.Config.MinHumanPlayers, humans)
+	}
+
+	if lobby.Config.MaxPlayers > 0 && len(playerIDs) > lobby.Config.MaxPlayers {
+		return model.ErrLobbyFull
+	}
+
+	if remaining := c.cooldownRemaining(lobby); remaining > 0 {
+		return fmt.Errorf("%w: %s remaining", model.ErrLobbyOnCooldown, remaining.Round(time.Second))
+	}
+
+	return nil
+}
+
+// AbandonGame ends the current game
+func (c *Controller) AbandonGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) error {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
 		return err
 	}
 
@@ -338,26 +1083,82 @@ func (c *Controller) AbandonGame(ctx context.Context, code model.LobbyCode, requ
 	}
 
 	// Abandon the game
-	if err := c.gameController.AbandonGame(ctx, *lobby.CurrentGame); err != nil {
+	gameID := *lobby.CurrentGame
+	if err := c.gameController.AbandonGame(ctx, gameID); err != nil {
 		return err
 	}
 
-	// Update lobby state
+	// Update lobby state; everyone readies up again for the next game
 	lobby.State = model.LobbyStateWaiting
 	lobby.CurrentGame = nil
+	lobby.ResetReady()
 	lobby.UpdatedAt = c.clock.Now()
 
-	return c.storage.SaveLobby(ctx, lobby)
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	c.recordGameEvent(ctx, code, gameID, model.EventGameEnded, requestingPlayer, nil)
+
+	return nil
+}
+
+// RevealScores reveals the scores of the lobby's finished game (host only)
+func (c *Controller) RevealScores(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+
+	if lobby.CurrentGame == nil {
+		return nil, model.ErrNoGameInProgress
+	}
+
+	return c.gameController.RevealScores(ctx, *lobby.CurrentGame)
+}
+
+// RevealNext reveals the next score of the lobby's finished game, lowest
+// first (host only)
+func (c *Controller) RevealNext(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, *model.BoardScore, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, nil, model.ErrNotHost
+	}
+
+	if lobby.CurrentGame == nil {
+		return nil, nil, model.ErrNoGameInProgress
+	}
+
+	return c.gameController.RevealNext(ctx, *lobby.CurrentGame)
 }
 
-// CompleteGame handles a game completing (called when game reaches scoring state)
+// CompleteGame handles a game completing (called when game reaches scoring
+// state). It does nothing if the lobby's last game has already been completed.
 func (c *Controller) CompleteGame(ctx context.Context, code model.LobbyCode) error {
+	c.cancelAutoDismiss(code)
+
+	c.completeMu.Lock()
+	defer c.completeMu.Unlock()
+
 	lobby, err := c.storage.GetLobby(ctx, code)
 	if err != nil {
 		return err
 	}
 
 	if lobby.CurrentGame == nil {
+		if len(lobby.GameHistory) > 0 {
+			return nil // Already completed by another path
+		}
 		return model.ErrNoGameInProgress
 	}
 
@@ -367,16 +1168,489 @@ func (c *Controller) CompleteGame(ctx context.Context, code model.LobbyCode) err
 		return err
 	}
 
-	// Add to history
+	c.addToHistory(lobby, summary)
+	lobby.State = model.LobbyStateWaiting
+	lobby.CurrentGame = nil
+	lobby.ResetReady() // Everyone readies up again for the next game
+	lobby.UpdatedAt = c.clock.Now()
+	lobby.LastGameCompletedAt = lobby.UpdatedAt
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	c.recordPlayerStats(ctx, lobby, summary)
+	c.recordGameEvent(ctx, code, summary.ID, model.EventGameEnded, "", nil)
+
+	return nil
+}
+
+// cooldownRemaining returns how long the lobby must wait before starting
+// another game, or 0 if it may start one now
+func (c *Controller) cooldownRemaining(lobby *model.Lobby) time.Duration {
+	if c.cfg.GameCooldown <= 0 || lobby.LastGameCompletedAt.IsZero() {
+		return 0
+	}
+	return max(lobby.LastGameCompletedAt.Add(c.cfg.GameCooldown).Sub(c.clock.Now()), 0)
+}
+
+// addToHistory appends a finished game's summary to the lobby's history,
+// dropping the oldest entries beyond the cap
+func (c *Controller) addToHistory(lobby *model.Lobby, summary *model.GameSummary) {
 	lobby.GameHistory = append(lobby.GameHistory, *summary)
+	if excess := len(lobby.GameHistory) - c.cfg.MaxGameHistory; excess > 0 {
+		lobby.GameHistory = append([]model.GameSummary(nil), lobby.GameHistory[excess:]...)
+	}
+}
+
+// Rematch completes the lobby's finished game and starts a new one with the
+// same players in the same seats and the same config (host only). If the
+// finished game has already been completed, the lobby's last game is replayed
+// instead. The lobby is saved once, so it is never seen idle between the two
+// games. Players who have left the lobby since are dropped, and the rest must
+// pass the same checks as a game started by the host.
+func (c *Controller) Rematch(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error) {
+	c.cancelAutoDismiss(code)
+
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+
+	// The finished game is either still the lobby's current game, or has
+	// already been completed (the API completes games as soon as they end),
+	// in which case the last game in the history is replayed
+	var finishedID model.GameID
+	switch {
+	case lobby.CurrentGame != nil:
+		finishedID = *lobby.CurrentGame
+	case len(lobby.GameHistory) > 0:
+		finishedID = lobby.GameHistory[len(lobby.GameHistory)-1].ID
+	default:
+		return nil, model.ErrNoGameInProgress
+	}
+	finished, err := c.gameController.GetGame(ctx, finishedID)
+	if err != nil {
+		return nil, err
+	}
+	if finished.State != model.GameStateScoring {
+		return nil, model.ErrGameNotComplete
+	}
+
+	playerIDs := make([]model.PlayerID, 0, len(finished.Players))
+	for _, playerID := range finished.Players {
+		if lobby.GetMember(playerID) != nil {
+			playerIDs = append(playerIDs, playerID)
+		}
+	}
+	if len(playerIDs) == 0 {
+		return nil, model.ErrInsufficientPlayers
+	}
+	if err := c.checkCanStart(lobby, playerIDs); err != nil {
+		return nil, err
+	}
+
+	var summary *model.GameSummary
+	if lobby.CurrentGame != nil {
+		summary, err = c.gameController.CreateGameSummary(ctx, finished.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g, err := c.gameController.CreateGameWithConfig(ctx, code, playerIDs, lobby.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary != nil {
+		c.addToHistory(lobby, summary)
+	}
+	lobby.State = model.LobbyStateInGame
+	lobby.CurrentGame = &g.ID
+	lobby.ResetReady()
+	lobby.UpdatedAt = c.clock.Now()
+
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		c.logger.ErrorContext(ctx, "failed to save lobby after rematch",
+			slog.String("lobby_code", string(code)),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "rematch started in lobby",
+		slog.String("lobby_code", string(code)),
+		slog.String("game_id", string(g.ID)),
+		slog.Int("player_count", len(playerIDs)),
+	)
+
+	if summary != nil {
+		c.recordPlayerStats(ctx, lobby, summary)
+		c.recordGameEvent(ctx, code, summary.ID, model.EventGameEnded, "", nil)
+	}
+	c.recordEvent(ctx, code, model.EventGameStarted, requestingPlayer, model.GameStartedPayload{
+		GameID:   g.ID,
+		Players:  playerIDs,
+		GridSize: g.GridSize,
+	})
+
+	return g, nil
+}
+
+// ScheduleAutoDismiss completes the lobby's finished game after
+// AutoDismissDelay, in case the host never dismisses the scores, and then
+// calls onDismiss. It does nothing if the game has already been dismissed by
+// then, and nothing at all when AutoDismissDelay is 0.
+func (c *Controller) ScheduleAutoDismiss(code model.LobbyCode, gameID model.GameID, onDismiss func()) {
+	if c.cfg.AutoDismissDelay <= 0 {
+		return
+	}
+
+	cancel := make(chan struct{})
+	c.dismissMu.Lock()
+	if pending, ok := c.dismissTimers[code]; ok {
+		close(pending)
+	}
+	c.dismissTimers[code] = cancel
+	c.dismissMu.Unlock()
+
+	go func() {
+		select {
+		case <-c.clock.After(c.cfg.AutoDismissDelay):
+		case <-cancel:
+			return
+		}
+
+		c.dismissMu.Lock()
+		if c.dismissTimers[code] == cancel {
+			delete(c.dismissTimers, code)
+		}
+		c.dismissMu.Unlock()
+
+		dismissed, err := c.autoDismiss(context.Background(), code, gameID)
+		if err != nil {
+			c.logger.Error("failed to auto-dismiss game",
+				slog.String("lobby_code", string(code)),
+				slog.String("game_id", string(gameID)),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		if dismissed && onDismiss != nil {
+			onDismiss()
+		}
+	}()
+}
+
+// WatchAnnounceTimeout skips the lobby game's announcers whenever they stall
+// past the game controller's announce timeout, calling onSkip after each skip
+func (c *Controller) WatchAnnounceTimeout(gameID model.GameID, onSkip func()) {
+	c.gameController.WatchAnnounceTimeout(gameID, onSkip)
+}
+
+// WatchGameDuration abandons the lobby game once it runs past the game
+// controller's maximum duration, returning the lobby to waiting, then calls
+// onAbandon with the lobby's code. The code is read from the game when the
+// limit is reached, since the game may have moved lobby in the meantime.
+func (c *Controller) WatchGameDuration(gameID model.GameID, onAbandon func(code model.LobbyCode)) {
+	c.gameController.WatchGameDuration(gameID, func() {
+		ctx := context.Background()
+		game, err := c.gameController.GetGame(ctx, gameID)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to load timed out game",
+				slog.String("game_id", string(gameID)),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		code := game.LobbyCode
+		ended, err := c.endTimedOutGame(ctx, code, gameID)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to end timed out game",
+				slog.String("lobby_code", string(code)),
+				slog.String("game_id", string(gameID)),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		if ended && onAbandon != nil {
+			onAbandon(code)
+		}
+	})
+}
+
+// endTimedOutGame returns the lobby to waiting if gameID is still its
+// current game
+func (c *Controller) endTimedOutGame(ctx context.Context, code model.LobbyCode, gameID model.GameID) (bool, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if errors.Is(err, model.ErrLobbyNotFound) {
+		return false, nil // Everyone left in the meantime
+	}
+	if err != nil {
+		return false, err
+	}
+	if lobby.CurrentGame == nil || *lobby.CurrentGame != gameID {
+		return false, nil
+	}
+
 	lobby.State = model.LobbyStateWaiting
 	lobby.CurrentGame = nil
+	lobby.ResetReady()
 	lobby.UpdatedAt = c.clock.Now()
 
-	return c.storage.SaveLobby(ctx, lobby)
+	if err := c.storage.SaveLobby(ctx, lobby); err != nil {
+		return false, err
+	}
+
+	c.recordGameEvent(ctx, code, gameID, model.EventGameEnded, "", nil)
+	return true, nil
+}
+
+// autoDismiss completes gameID if it is still the lobby's finished game
+func (c *Controller) autoDismiss(ctx context.Context, code model.LobbyCode, gameID model.GameID) (bool, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if errors.Is(err, model.ErrLobbyNotFound) {
+		return false, nil // Everyone left in the meantime
+	}
+	if err != nil {
+		return false, err
+	}
+	if lobby.CurrentGame == nil || *lobby.CurrentGame != gameID {
+		return false, nil
+	}
+
+	game, err := c.gameController.GetGame(ctx, gameID)
+	if err != nil {
+		return false, err
+	}
+	if game.State != model.GameStateScoring {
+		return false, nil
+	}
+	// A DelayedReveal game the host never revealed is revealed first, so
+	// its scores are recorded before it goes into the history
+	if game.ScoresHidden() {
+		if _, err := c.gameController.RevealScores(ctx, gameID); err != nil {
+			return false, err
+		}
+	}
+
+	c.logger.InfoContext(ctx, "auto-dismissing finished game",
+		slog.String("lobby_code", string(code)),
+		slog.String("game_id", string(gameID)),
+	)
+	if err := c.CompleteGame(ctx, code); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// cancelAutoDismiss stops the lobby's pending auto-dismiss, if any
+func (c *Controller) cancelAutoDismiss(code model.LobbyCode) {
+	c.dismissMu.Lock()
+	defer c.dismissMu.Unlock()
+	if pending, ok := c.dismissTimers[code]; ok {
+		close(pending)
+		delete(c.dismissTimers, code)
+	}
+}
+
+// recordPlayerStats adds a completed game to the stats of each registered
+// player in it. Guests and bots have no stats. Failures are logged rather
+// than returned, since the game has already been completed.
+func (c *Controller) recordPlayerStats(ctx context.Context, lobby *model.Lobby, summary *model.GameSummary) {
+	if summary.Unscored {
+		return
+	}
+	scores, err := c.gameController.GetFinalScores(ctx, summary.ID)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to score game for player stats",
+			slog.String("game_id", string(summary.ID)),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, score := range scores {
+		member := lobby.GetMember(score.PlayerID)
+		if member == nil || member.Player.IsGuest || member.Player.IsBot {
+			continue
+		}
+		result := model.PlayerGameResultFromScore(score, summary.Winner == score.PlayerID)
+		if err := c.storage.RecordPlayerGameResult(ctx, score.PlayerID, result); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record player stats",
+				slog.String("game_id", string(summary.ID)),
+				slog.String("player_id", string(score.PlayerID)),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// GetEvents returns the events recorded for a lobby in the order they
+// happened, optionally only those at or after since
+// Returns ErrNoLobbyEvents if nothing matches
+func (c *Controller) GetEvents(ctx context.Context, code model.LobbyCode, since time.Time) ([]*model.Event, error) {
+	events, err := c.storage.GetLobbyEvents(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*model.Event, 0, len(events))
+	for _, event := range events {
+		if !since.IsZero() && event.Timestamp.Before(since) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+
+	if len(filtered) == 0 {
+		return nil, model.ErrNoLobbyEvents
+	}
+	return filtered, nil
+}
+
+// GetVisibleEvents is GetEvents for a lobby member, leaving out what the
+// member isn't allowed to see yet: other players' placements while their
+// boards are hidden, and final scores while a delayed reveal withholds them.
+// Spectator chat is only shown to those who can use it.
+// Returns ErrNotInLobby if viewer isn't a member.
+func (c *Controller) GetVisibleEvents(ctx context.Context, code model.LobbyCode, viewer model.PlayerID, since time.Time) ([]*model.Event, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	member := lobby.GetMember(viewer)
+	if member == nil {
+		return nil, model.ErrNotInLobby
+	}
+
+	events, err := c.GetEvents(ctx, code, since)
+	if err != nil {
+		return nil, err
+	}
+
+	// Games that have expired from storage finished long ago, so their
+	// events are public
+	games := make(map[model.GameID]*model.Game)
+	gameFor := func(id model.GameID) *model.Game {
+		if g, ok := games[id]; ok {
+			return g
+		}
+		g, err := c.gameController.GetGame(ctx, id)
+		if err != nil {
+			g = nil
+		}
+		games[id] = g
+		return g
+	}
+	spectatorSeesBoards := member.Role == model.RoleSpectator && lobby.Config.SpectatorsSeeBoards()
+
+	visible := make([]*model.Event, 0, len(events))
+	for _, event := range events {
+		switch event.Type {
+		case model.EventLetterPlaced:
+			g := gameFor(event.GameID)
+			inProgress := g != nil && g.State != model.GameStateScoring && g.State != model.GameStateAbandoned
+			if inProgress && event.PlayerID != viewer && !spectatorSeesBoards {
+				continue
+			}
+		case model.EventGameComplete:
+			if g := gameFor(event.GameID); g != nil && g.ScoresHidden() {
+				continue
+			}
+		case model.EventSpectatorChat:
+			if !lobby.InSpectatorChat(viewer) {
+				continue
+			}
+		}
+		visible = append(visible, event)
+	}
+
+	if len(visible) == 0 {
+		return nil, model.ErrNoLobbyEvents
+	}
+	return visible, nil
+}
+
+// SendSpectatorChat records a message in the lobby's spectator chat, which
+// only spectators and the host can use, and returns the lobby and message so
+// the caller can deliver it. Surrounding whitespace is trimmed.
+func (c *Controller) SendSpectatorChat(ctx context.Context, code model.LobbyCode, playerID model.PlayerID, message string) (*model.Lobby, *model.SpectatorChatPayload, error) {
+	message = strings.TrimSpace(message)
+	if err := model.ValidateChatMessage(message); err != nil {
+		return nil, nil, err
+	}
+
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !lobby.InSpectatorChat(playerID) {
+		return nil, nil, model.ErrNotInSpectatorChat
+	}
+
+	chat := &model.SpectatorChatPayload{DisplayName: lobby.GetMember(playerID).Label(), Message: message}
+	c.recordEvent(ctx, code, model.EventSpectatorChat, playerID, *chat)
+	return lobby, chat, nil
+}
+
+// GetSpectatorChat returns the lobby's spectator chat messages, oldest first,
+// or nil if viewer can't read the chat
+func (c *Controller) GetSpectatorChat(ctx context.Context, code model.LobbyCode, viewer model.PlayerID) ([]model.SpectatorChatPayload, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if !lobby.InSpectatorChat(viewer) {
+		return nil, nil
+	}
+
+	events, err := c.storage.GetLobbyEvents(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	var chat []model.SpectatorChatPayload
+	for _, event := range events {
+		if payload, ok := event.Payload.(model.SpectatorChatPayload); ok && event.Type == model.EventSpectatorChat {
+			chat = append(chat, payload)
+		}
+	}
+	return chat, nil
+}
+
+// recordEvent appends a lobby-level event to the lobby's history
+func (c *Controller) recordEvent(ctx context.Context, code model.LobbyCode, eventType model.EventType, playerID model.PlayerID, payload any) {
+	c.recordGameEvent(ctx, code, "", eventType, playerID, payload)
+}
+
+// recordGameEvent appends an event to the lobby's history. Failures are
+// logged rather than returned, since the state change has already been saved.
+func (c *Controller) recordGameEvent(ctx context.Context, code model.LobbyCode, gameID model.GameID, eventType model.EventType, playerID model.PlayerID, payload any) {
+	event := &model.Event{
+		Type:      eventType,
+		Timestamp: c.clock.Now(),
+		LobbyCode: code,
+		GameID:    gameID,
+		PlayerID:  playerID,
+		Payload:   payload,
+	}
+	if err := c.storage.AppendLobbyEvent(ctx, event); err != nil {
+		c.logger.ErrorContext(ctx, "failed to record lobby event",
+			slog.String("lobby_code", string(code)),
+			slog.String("event_type", string(eventType)),
+			slog.String("error", err.Error()),
+		)
+	}
 }
 
 // UpdateConfig updates the lobby configuration
+// Returns an error wrapping ErrInvalidGridSize if the grid size is out of bounds
 func (c *Controller) UpdateConfig(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, config model.LobbyConfig) error {
 	lobby, err := c.storage.GetLobby(ctx, code)
 	if err != nil {
@@ -394,25 +1668,64 @@ func (c *Controller) UpdateConfig(ctx context.Context, code model.LobbyCode, req
 		return model.ErrGameInProgress
 	}
 
+	if err := config.Validate(c.cfg.GridSizeBounds); err != nil {
+		return err
+	}
+
 	lobby.Config = config
 	lobby.UpdatedAt = c.clock.Now()
 
 	return c.storage.SaveLobby(ctx, lobby)
 }
 
+// ValidateConfig checks config as UpdateConfig would, without applying it,
+// and returns every problem found (host only, while waiting)
+func (c *Controller) ValidateConfig(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, config model.LobbyConfig) ([]model.ConfigFieldError, error) {
+	lobby, err := c.storage.GetLobby(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	host := lobby.GetHost()
+	if host == nil || host.Player.ID != requestingPlayer {
+		return nil, model.ErrNotHost
+	}
+	if lobby.State == model.LobbyStateInGame {
+		return nil, model.ErrGameInProgress
+	}
+
+	return config.FieldErrors(c.cfg.GridSizeBounds), nil
+}
+
 // Interface for dependency injection
 type ControllerInterface interface {
 	CreateLobby(ctx context.Context, host model.Player) (*model.Lobby, error)
 	GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lobby, error)
+	ListGames(ctx context.Context, code model.LobbyCode) (*model.Game, []model.GameSummary, error)
 	GetActiveLobbyCode(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, error)
+	GetActiveGame(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, model.GameID, error)
 	JoinLobby(ctx context.Context, code model.LobbyCode, player model.Player) error
 	LeaveLobby(ctx context.Context, code model.LobbyCode, playerID model.PlayerID) error
 	SetRole(ctx context.Context, code model.LobbyCode, playerID model.PlayerID, role model.LobbyMemberRole) error
+	SetReady(ctx context.Context, code model.LobbyCode, playerID model.PlayerID, ready bool) error
 	TransferHost(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, newHostID model.PlayerID) error
+	ClaimHost(ctx context.Context, code model.LobbyCode, requester model.PlayerID) (*model.Lobby, error)
+	ShuffleSeats(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Lobby, error)
+	RegenerateCode(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Lobby, error)
 	StartGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error)
+	StartGameWithID(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, gameID model.GameID) (*model.Game, error)
+	PreviewGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) ([]model.PlayerID, error)
 	AbandonGame(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) error
+	RevealScores(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, error)
+	RevealNext(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID) (*model.Game, *model.BoardScore, error)
 	CompleteGame(ctx context.Context, code model.LobbyCode) error
+	ScheduleAutoDismiss(code model.LobbyCode, gameID model.GameID, onDismiss func())
+	WatchAnnounceTimeout(gameID model.GameID, onSkip func())
+	WatchGameDuration(gameID model.GameID, onAbandon func(code model.LobbyCode))
 	UpdateConfig(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, config model.LobbyConfig) error
+	ValidateConfig(ctx context.Context, code model.LobbyCode, requestingPlayer model.PlayerID, config model.LobbyConfig) ([]model.ConfigFieldError, error)
+	GetEvents(ctx context.Context, code model.LobbyCode, since time.Time) ([]*model.Event, error)
+	GetVisibleEvents(ctx context.Context, code model.LobbyCode, viewer model.PlayerID, since time.Time) ([]*model.Event, error)
 }
 
 var _ ControllerInterface = (*Controller)(nil)
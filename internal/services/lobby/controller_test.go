@@ -2,6 +2,8 @@ package lobby
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,13 +36,13 @@ func TestControllerSuite(t *testing.T) {
 func (s *ControllerSuite) SetupTest() {
 	s.storage = memory.New()
 	logger := testutil.NopLogger()
-	boardService := board.New(s.storage, logger)
-	dictService := dictionary.New(s.storage, logger)
-	scoringService := scoring.New(dictService)
+	boardService := board.New(s.storage, model.DefaultAlphabet(), logger)
+	dictService := dictionary.New(s.storage, model.DefaultAlphabet(), logger)
+	scoringService := scoring.New(dictService, scoring.DefaultConfig())
 	s.clock = mocks.NewMockClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
 	s.random = mocks.NewMockRandom()
-	s.gameController = game.NewController(s.storage, boardService, scoringService, s.clock, s.random, logger)
-	s.controller = NewController(s.storage, s.gameController, s.clock, s.random, logger)
+	s.gameController = game.NewController(s.storage, boardService, scoringService, s.clock, s.random, game.DefaultConfig(), logger)
+	s.controller = NewController(s.storage, s.gameController, s.clock, s.random, DefaultConfig(), logger)
 	s.ctx = context.Background()
 
 	// Load dictionary
@@ -126,6 +128,52 @@ func (s *ControllerSuite) TestJoinLobbyDuringGameAsSpectator() {
 	s.Equal(model.RoleSpectator, updated.GetMember(player.ID).Role)
 }
 
+func (s *ControllerSuite) TestJoinLobbyWhileWaitingSpectatesWhenConfigured() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, LateJoinRole: model.RoleSpectator}))
+
+	player := s.createPlayer("player-1", "Player")
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, player))
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+	s.Equal(model.RoleSpectator, updated.GetMember(player.ID).Role)
+}
+
+func (s *ControllerSuite) TestJoinLobbyDisambiguatesDuplicateNames() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Alice")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Alice"))
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Alice"))
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-3", "Bob"))
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal("Alice", updated.GetMember("host-1").Label())
+	s.Equal("Alice (2)", updated.GetMember("player-1").Label())
+	s.Equal("Alice (3)", updated.GetMember("player-2").Label())
+	s.Equal("Bob", updated.GetMember("player-3").Label())
+
+	// The stored player name is unchanged
+	s.Equal("Alice", updated.GetMember("player-1").Player.DisplayName)
+}
+
+func (s *ControllerSuite) TestJoinLobbyKeepsDuplicateNamesWhenAllowed() {
+	cfg := DefaultConfig()
+	cfg.AllowDuplicateNames = true
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+
+	s.random.QueueString("ABC123")
+	lobby, _ := controller.CreateLobby(s.ctx, s.createPlayer("host-1", "Alice"))
+	_ = controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Alice"))
+
+	updated, _ := controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal("Alice", updated.GetMember("player-1").Label())
+}
+
 func (s *ControllerSuite) TestJoinLobbyFailsIfAlreadyMember() {
 	s.random.QueueString("ABC123")
 	host := s.createPlayer("host-1", "Host")
@@ -141,6 +189,28 @@ func (s *ControllerSuite) TestJoinLobbyFailsIfNotFound() {
 	s.ErrorIs(err, model.ErrLobbyNotFound)
 }
 
+// UpdateMemberDisplayName tests
+
+func (s *ControllerSuite) TestUpdateMemberDisplayNameUpdatesLobbyMember() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	updated, err := s.controller.UpdateMemberDisplayName(s.ctx, host.ID, "Renamed")
+	s.Require().NoError(err)
+	s.Require().NotNil(updated)
+	s.Equal(lobby.Code, updated.Code)
+
+	retrieved, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal("Renamed", retrieved.GetMember(host.ID).Player.DisplayName)
+}
+
+func (s *ControllerSuite) TestUpdateMemberDisplayNameReturnsNilWhenNotInLobby() {
+	updated, err := s.controller.UpdateMemberDisplayName(s.ctx, "player-1", "Renamed")
+	s.Require().NoError(err)
+	s.Nil(updated)
+}
+
 // LeaveLobby tests
 
 func (s *ControllerSuite) TestLeaveLobbySucceeds() {
@@ -214,6 +284,27 @@ func (s *ControllerSuite) TestSetRoleSucceeds() {
 	s.Equal(model.RoleSpectator, updated.GetMember(player.ID).Role)
 }
 
+func (s *ControllerSuite) TestSetRolePromotedSpectatorAnnouncesLast() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	spectator := s.createPlayer("spectator-1", "Spectator")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, spectator)
+	s.Require().NoError(s.controller.SetRole(s.ctx, lobby.Code, spectator.ID, model.RoleSpectator))
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+
+	// The spectator joined before player-1 but is promoted after them
+	s.Require().NoError(s.controller.SetRole(s.ctx, lobby.Code, spectator.ID, model.RolePlayer))
+
+	s.random.QueueString("GAME12345678")
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{host.ID, player.ID, spectator.ID}, g.Players)
+	s.Equal(host.ID, g.CurrentAnnouncer())
+}
+
 func (s *ControllerSuite) TestSetRoleFailsDuringGame() {
 	s.random.QueueString("ABC123", "GAME12345678")
 	host := s.createPlayer("host-1", "Host")
@@ -273,6 +364,107 @@ func (s *ControllerSuite) TestTransferHostFailsIfTargetNotInLobby() {
 	s.ErrorIs(err, model.ErrNotInLobby)
 }
 
+// ClaimHost tests
+
+// fakePresence reports fixed presence for players
+type fakePresence struct {
+	connected map[model.PlayerID]bool
+	lastSeen  map[model.PlayerID]time.Time
+}
+
+func (p *fakePresence) LastSeen(_ model.LobbyCode, playerID model.PlayerID) (time.Time, bool) {
+	return p.lastSeen[playerID], p.connected[playerID]
+}
+
+func (s *ControllerSuite) newClaimHostController(presence Presence) *Controller {
+	cfg := DefaultConfig()
+	cfg.HostAbsenceTimeout = time.Minute
+	cfg.Presence = presence
+	return NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+}
+
+func (s *ControllerSuite) TestClaimHostSucceedsAfterHostLapses() {
+	presence := &fakePresence{
+		connected: map[model.PlayerID]bool{"host-1": true},
+		lastSeen:  map[model.PlayerID]time.Time{},
+	}
+	controller := s.newClaimHostController(presence)
+
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+
+	s.clock.Advance(time.Second)
+	veteran := s.createPlayer("player-1", "Veteran")
+	s.Require().NoError(controller.JoinLobby(s.ctx, lobby.Code, veteran))
+	s.clock.Advance(time.Second)
+	newcomer := s.createPlayer("player-2", "Newcomer")
+	s.Require().NoError(controller.JoinLobby(s.ctx, lobby.Code, newcomer))
+
+	// Rejected while the host is connected
+	_, err := controller.ClaimHost(s.ctx, lobby.Code, newcomer.ID)
+	s.ErrorIs(err, model.ErrHostPresent)
+
+	// Rejected while the host was seen recently
+	presence.connected["host-1"] = false
+	presence.lastSeen["host-1"] = s.clock.Now()
+	s.clock.Advance(30 * time.Second)
+	_, err = controller.ClaimHost(s.ctx, lobby.Code, newcomer.ID)
+	s.ErrorIs(err, model.ErrHostPresent)
+
+	// Once the host's presence lapses, host passes to the longest-tenured member
+	s.clock.Advance(time.Minute)
+	updated, err := controller.ClaimHost(s.ctx, lobby.Code, newcomer.ID)
+	s.Require().NoError(err)
+	s.True(updated.GetMember(veteran.ID).IsHost)
+	s.False(updated.GetMember(newcomer.ID).IsHost)
+	s.False(updated.GetMember(host.ID).IsHost)
+
+	stored, _ := controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(veteran.ID, stored.GetHost().Player.ID)
+}
+
+func (s *ControllerSuite) TestClaimHostCountsUnseenHostFromJoin() {
+	controller := s.newClaimHostController(&fakePresence{})
+
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	player := s.createPlayer("player-1", "Player")
+	s.Require().NoError(controller.JoinLobby(s.ctx, lobby.Code, player))
+
+	_, err := controller.ClaimHost(s.ctx, lobby.Code, player.ID)
+	s.ErrorIs(err, model.ErrHostPresent)
+
+	s.clock.Advance(2 * time.Minute)
+	updated, err := controller.ClaimHost(s.ctx, lobby.Code, player.ID)
+	s.Require().NoError(err)
+	s.True(updated.GetMember(player.ID).IsHost)
+}
+
+func (s *ControllerSuite) TestClaimHostFailsForNonMember() {
+	controller := s.newClaimHostController(&fakePresence{})
+
+	s.random.QueueString("ABC123")
+	lobby, _ := controller.CreateLobby(s.ctx, s.createPlayer("host-1", "Host"))
+	s.clock.Advance(time.Hour)
+
+	_, err := controller.ClaimHost(s.ctx, lobby.Code, "outsider")
+	s.ErrorIs(err, model.ErrNotInLobby)
+}
+
+func (s *ControllerSuite) TestClaimHostRejectedWithoutPresence() {
+	s.random.QueueString("ABC123")
+	lobby, _ := s.controller.CreateLobby(s.ctx, s.createPlayer("host-1", "Host"))
+	player := s.createPlayer("player-1", "Player")
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, player))
+	s.clock.Advance(time.Hour)
+
+	// Without presence tracking the host can't be shown to be away
+	_, err := s.controller.ClaimHost(s.ctx, lobby.Code, player.ID)
+	s.ErrorIs(err, model.ErrHostPresent)
+}
+
 // StartGame tests
 
 func (s *ControllerSuite) TestStartGameSucceeds() {
@@ -292,6 +484,127 @@ func (s *ControllerSuite) TestStartGameSucceeds() {
 	s.Equal(&game.ID, updated.CurrentGame)
 }
 
+func (s *ControllerSuite) TestShuffleSeatsReordersMembersDeterministically() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Player 1"))
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Player 2"))
+
+	// Fisher-Yates: swap index 2 with 0, then index 1 with 0
+	s.random.QueueIntn(0, 0)
+	shuffled, err := s.controller.ShuffleSeats(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+
+	order := make([]model.PlayerID, len(shuffled.Members))
+	for i, m := range shuffled.Members {
+		order[i] = m.Player.ID
+	}
+	s.Equal([]model.PlayerID{"player-1", "player-2", "host-1"}, order)
+
+	// The host keeps the host flag wherever they end up seated
+	s.Equal(model.PlayerID("host-1"), shuffled.GetHost().Player.ID)
+
+	// The new order decides who announces first
+	game, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-1"), game.CurrentAnnouncer())
+}
+
+func (s *ControllerSuite) TestShuffleSeatsFailsIfNotHost() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+
+	_, err := s.controller.ShuffleSeats(s.ctx, lobby.Code, player.ID)
+	s.ErrorIs(err, model.ErrNotHost)
+}
+
+func (s *ControllerSuite) TestShuffleSeatsFailsDuringGame() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_, _ = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	_, err := s.controller.ShuffleSeats(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrGameInProgress)
+}
+
+func (s *ControllerSuite) TestRegenerateCodeMovesLobby() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+
+	// A code already in use is skipped
+	s.random.QueueString("OTHER1")
+	_, _ = s.controller.CreateLobby(s.ctx, s.createPlayer("host-2", "Other Host"))
+	s.random.QueueString("OTHER1", "NEW456")
+
+	moved, err := s.controller.RegenerateCode(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("NEW456"), moved.Code)
+	s.Len(moved.Members, 2)
+
+	_, err = s.controller.GetLobby(s.ctx, "ABC123")
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+
+	code, err := s.controller.GetActiveLobbyCode(s.ctx, player.ID)
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("NEW456"), code)
+
+	events, err := s.controller.GetEvents(s.ctx, "NEW456", time.Time{})
+	s.Require().NoError(err)
+	last := events[len(events)-1]
+	s.Equal(model.EventCodeChanged, last.Type)
+	s.Equal(model.CodeChangedPayload{OldCode: "ABC123", NewCode: "NEW456"}, last.Payload)
+}
+
+func (s *ControllerSuite) TestRegenerateCodeFailsIfNotHost() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+
+	_, err := s.controller.RegenerateCode(s.ctx, lobby.Code, player.ID)
+	s.ErrorIs(err, model.ErrNotHost)
+}
+
+func (s *ControllerSuite) TestRegenerateCodeFailsDuringGame() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_, _ = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	_, err := s.controller.RegenerateCode(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrGameInProgress)
+
+	_, err = s.controller.GetLobby(s.ctx, "ABC123")
+	s.NoError(err)
+}
+
+func (s *ControllerSuite) TestStartGameWithIDIsIdempotent() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	game, err := s.controller.StartGameWithID(s.ctx, lobby.Code, host.ID, "start-1")
+	s.Require().NoError(err)
+	s.Equal(model.GameID("start-1"), game.ID)
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Require().NotNil(updated.CurrentGame)
+	s.Equal(model.GameID("start-1"), *updated.CurrentGame)
+
+	// A retry is recognised instead of reporting some other game in progress
+	_, err = s.controller.StartGameWithID(s.ctx, lobby.Code, host.ID, "start-1")
+	s.ErrorIs(err, model.ErrGameExists)
+}
+
 func (s *ControllerSuite) TestStartGameFailsIfNotHost() {
 	s.random.QueueString("ABC123")
 	host := s.createPlayer("host-1", "Host")
@@ -345,6 +658,115 @@ func (s *ControllerSuite) TestStartGameOnlyIncludesPlayers() {
 	s.NotContains(game.Players, spectator.ID)
 }
 
+func (s *ControllerSuite) TestStartGameDedupesRepeatedMembers() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+
+	// Simulate a racing join that stored the same member twice
+	stored, _ := s.storage.GetLobby(s.ctx, lobby.Code)
+	stored.Members = append(stored.Members, stored.Members[1])
+	_ = s.storage.SaveLobby(s.ctx, stored)
+
+	game, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{host.ID, player.ID}, game.Players)
+}
+
+// Ready check tests
+
+func (s *ControllerSuite) TestStartGameWaitsForReadyPlayers() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	player := s.createPlayer("player-1", "Player")
+	spectator := s.createPlayer("spectator-1", "Spectator")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, spectator)
+	_ = s.controller.SetRole(s.ctx, lobby.Code, spectator.ID, model.RoleSpectator)
+	s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, RequireReady: true}))
+
+	_, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrPlayersNotReady)
+
+	s.Require().NoError(s.controller.SetReady(s.ctx, lobby.Code, host.ID, true))
+	_, err = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrPlayersNotReady)
+
+	// Spectators don't need to be ready
+	s.Require().NoError(s.controller.SetReady(s.ctx, lobby.Code, player.ID, true))
+	game, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Len(game.Players, 2)
+}
+
+func (s *ControllerSuite) TestSetReadyFailsIfNotMember() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	err := s.controller.SetReady(s.ctx, lobby.Code, "stranger", true)
+	s.ErrorIs(err, model.ErrNotInLobby)
+}
+
+func (s *ControllerSuite) TestUpdateConfigRejectsAutoStartWithReadyCheck() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	err := s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, MaxPlayers: 2, AutoStart: true, RequireReady: true})
+	s.ErrorIs(err, model.ErrInvalidLobbyConfig)
+}
+
+// PreviewGame tests
+
+func (s *ControllerSuite) TestPreviewGameMatchesStartedGameOrder() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Alice"))
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("spectator-1", "Sam"))
+	_ = s.controller.SetRole(s.ctx, lobby.Code, "spectator-1", model.RoleSpectator)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Bob"))
+
+	preview, err := s.controller.PreviewGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{"host-1", "player-1", "player-2"}, preview)
+
+	// Previewing doesn't start a game
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(preview, g.Players)
+	s.Equal(preview[0], g.CurrentAnnouncer())
+}
+
+func (s *ControllerSuite) TestPreviewGameRequiresHost() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Alice"))
+
+	_, err := s.controller.PreviewGame(s.ctx, lobby.Code, "player-1")
+	s.ErrorIs(err, model.ErrNotHost)
+}
+
+func (s *ControllerSuite) TestPreviewGameFailsDuringGame() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_, _ = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	_, err := s.controller.PreviewGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrGameInProgress)
+}
+
 // AbandonGame tests
 
 func (s *ControllerSuite) TestAbandonGameSucceeds() {
@@ -374,6 +796,19 @@ func (s *ControllerSuite) TestAbandonGameFailsIfNotHost() {
 	s.ErrorIs(err, model.ErrNotHost)
 }
 
+func (s *ControllerSuite) TestRevealScoresFailsIfNotHost() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+	_, _ = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	_, err := s.controller.RevealScores(s.ctx, lobby.Code, player.ID)
+	s.ErrorIs(err, model.ErrNotHost)
+}
+
 func (s *ControllerSuite) TestAbandonGameFailsIfNoGame() {
 	s.random.QueueString("ABC123")
 	host := s.createPlayer("host-1", "Host")
@@ -420,22 +855,170 @@ func (s *ControllerSuite) TestUpdateConfigFailsDuringGame() {
 	s.ErrorIs(err, model.ErrGameInProgress)
 }
 
-// CompleteGame tests
-
-func (s *ControllerSuite) TestCompleteGameAddsToHistory() {
-	s.random.QueueString("ABC123", "GAME12345678")
+func (s *ControllerSuite) TestUpdateConfigRejectsGridSizeBelowMin() {
+	s.random.QueueString("ABC123")
 	host := s.createPlayer("host-1", "Host")
 	lobby, _ := s.controller.CreateLobby(s.ctx, host)
 
-	// Use a 2x2 grid for quick completion
-	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
-	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	err := s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 1})
+	s.ErrorIs(err, model.ErrInvalidGridSize)
+	s.ErrorContains(err, "between 2 and 7")
 
-	// Complete the game
-	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
-	for i, pos := range positions {
-		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
-		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(5, updated.Config.GridSize)
+}
+
+func (s *ControllerSuite) TestUpdateConfigRejectsGridSizeAboveMax() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	err := s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 8})
+	s.ErrorIs(err, model.ErrInvalidGridSize)
+}
+
+func (s *ControllerSuite) TestUpdateConfigAcceptsGridSizeBounds() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	for _, size := range []int{2, 7} {
+		s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: size}))
+	}
+}
+
+func (s *ControllerSuite) TestCustomGridSizeBounds() {
+	cfg := DefaultConfig()
+	cfg.GridSizeBounds = model.GridSizeBounds{Min: 3, Max: 4}
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, err := controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(err)
+	s.Equal(4, lobby.Config.GridSize, "default grid size is clamped into the bounds")
+
+	s.ErrorIs(controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2}), model.ErrInvalidGridSize)
+	s.ErrorIs(controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5}), model.ErrInvalidGridSize)
+	s.NoError(controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 3}))
+}
+
+func (s *ControllerSuite) TestAllowedGridSizes() {
+	cfg := DefaultConfig()
+	cfg.GridSizeBounds.Allowed = []int{7, 4}
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+	s.Equal([]int{4, 7}, controller.GridSizes())
+
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, err := controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(err)
+	s.Equal(4, lobby.Config.GridSize, "default grid size moves to the nearest allowed size")
+
+	// 5 is within the default range but not on the list
+	err = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5})
+	s.ErrorIs(err, model.ErrGridSizeNotAllowed)
+	s.ErrorIs(err, model.ErrInvalidGridSize)
+	s.NoError(controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 7}))
+}
+
+func (s *ControllerSuite) TestUpdateConfigRejectsAutoStartWithoutMaxPlayers() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	err := s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, AutoStart: true})
+	s.ErrorIs(err, model.ErrInvalidLobbyConfig)
+
+	err = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, MaxPlayers: -1})
+	s.ErrorIs(err, model.ErrInvalidLobbyConfig)
+}
+
+func (s *ControllerSuite) TestJoinLobbyBeyondMaxPlayersAsSpectator() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, MaxPlayers: 2}))
+
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Player 1")))
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Player 2")))
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.RolePlayer, updated.GetMember("player-1").Role)
+	s.Equal(model.RoleSpectator, updated.GetMember("player-2").Role)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+}
+
+func (s *ControllerSuite) TestJoinLobbyAutoStartsWhenFull() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, MaxPlayers: 3, AutoStart: true}))
+
+	// Earlier joins leave the lobby waiting
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Player 1")))
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+	s.False(updated.StartedByJoin("player-1"))
+
+	// The third player fills the lobby and starts the game
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Player 2")))
+	updated, _ = s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateInGame, updated.State)
+	s.Require().NotNil(updated.CurrentGame)
+	s.True(updated.StartedByJoin("player-2"))
+
+	game, err := s.gameController.GetGame(s.ctx, *updated.CurrentGame)
+	s.Require().NoError(err)
+	s.Equal([]model.PlayerID{"host-1", "player-1", "player-2"}, game.Players)
+
+	// Anyone joining now spectates without restarting anything
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-3", "Player 3")))
+	after, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(updated.CurrentGame, after.CurrentGame)
+	s.Equal(model.RoleSpectator, after.GetMember("player-3").Role)
+	s.False(after.StartedByJoin("player-3"))
+}
+
+func (s *ControllerSuite) TestCreateLobbyFailsAtCapacity() {
+	cfg := DefaultConfig()
+	cfg.MaxLobbies = 2
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+
+	s.random.QueueString("LOBBY1", "LOBBY2")
+	_, err := controller.CreateLobby(s.ctx, s.createPlayer("host-1", "Host 1"))
+	s.Require().NoError(err)
+	_, err = controller.CreateLobby(s.ctx, s.createPlayer("host-2", "Host 2"))
+	s.Require().NoError(err)
+
+	_, err = controller.CreateLobby(s.ctx, s.createPlayer("host-3", "Host 3"))
+	s.ErrorIs(err, model.ErrServerAtCapacity)
+
+	// Last member leaving deletes the lobby, freeing a slot
+	s.Require().NoError(controller.LeaveLobby(s.ctx, "LOBBY1", "host-1"))
+
+	s.random.QueueString("LOBBY3")
+	lobby, err := controller.CreateLobby(s.ctx, s.createPlayer("host-3", "Host 3"))
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("LOBBY3"), lobby.Code)
+}
+
+// CompleteGame tests
+
+func (s *ControllerSuite) TestCompleteGameAddsToHistory() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	// Use a 2x2 grid for quick completion
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	// Complete the game
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
 	}
 
 	err := s.controller.CompleteGame(s.ctx, lobby.Code)
@@ -447,3 +1030,742 @@ func (s *ControllerSuite) TestCompleteGameAddsToHistory() {
 	s.Len(updated.GameHistory, 1)
 	s.Equal(g.ID, updated.GameHistory[0].ID)
 }
+
+func (s *ControllerSuite) TestCompleteGameWithoutDictionaryLeavesGameUnscored() {
+	logger := testutil.NopLogger()
+	boardService := board.New(s.storage, model.DefaultAlphabet(), logger)
+	emptyDict := dictionary.New(memory.New(), model.DefaultAlphabet(), logger)
+	gameController := game.NewController(s.storage, boardService, scoring.New(emptyDict, scoring.DefaultConfig()), s.clock, s.random, game.DefaultConfig(), logger)
+	controller := NewController(s.storage, gameController, s.clock, s.random, DefaultConfig(), logger)
+
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	_ = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, _ := controller.StartGame(s.ctx, lobby.Code, host.ID)
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+
+	s.Require().NoError(controller.CompleteGame(s.ctx, lobby.Code))
+
+	updated, _ := controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+	s.Require().Len(updated.GameHistory, 1)
+	s.True(updated.GameHistory[0].Unscored)
+	s.Empty(updated.GameHistory[0].FinalScores)
+}
+
+func (s *ControllerSuite) TestCompleteGameResetsReady() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2, RequireReady: true})
+	_ = s.controller.SetReady(s.ctx, lobby.Code, host.ID, true)
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+	s.Require().NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.False(updated.AllPlayersReady())
+	_, err = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrPlayersNotReady)
+}
+
+func (s *ControllerSuite) TestCompleteGameTrimsHistoryToCap() {
+	logger := testutil.NopLogger()
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, Config{MaxGameHistory: 2}, logger)
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002", "GAME00000003")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	_ = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+
+	// Complete three games against a cap of two
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for range 3 {
+		g, err := controller.StartGame(s.ctx, lobby.Code, host.ID)
+		s.Require().NoError(err)
+		for i, pos := range positions {
+			_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+			_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+		}
+		s.Require().NoError(controller.CompleteGame(s.ctx, lobby.Code))
+	}
+
+	updated, _ := controller.GetLobby(s.ctx, lobby.Code)
+	s.Require().Len(updated.GameHistory, 2)
+	s.Equal(model.GameID("GAME00000002"), updated.GameHistory[0].ID)
+	s.Equal(model.GameID("GAME00000003"), updated.GameHistory[1].ID)
+}
+
+func (s *ControllerSuite) TestCompleteGameTwiceCountsGameOnce() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	host.IsGuest = false
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+
+	// Nothing to complete yet
+	s.ErrorIs(s.controller.CompleteGame(s.ctx, lobby.Code), model.ErrNoGameInProgress)
+
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		s.Require().NoError(s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i)))
+		s.Require().NoError(s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos))
+	}
+
+	// Both the player's move and the host's dismiss complete the game
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+		}()
+	}
+	wg.Wait()
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Len(updated.GameHistory, 1)
+	stats, err := s.storage.GetPlayerStats(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Equal(1, stats.GamesPlayed)
+}
+
+func (s *ControllerSuite) TestCompleteGameRecordsRegisteredPlayerStats() {
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002")
+	host := s.createPlayer("host-1", "Host")
+	host.IsGuest = false
+	guest := s.createPlayer("guest-1", "Guest")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, guest))
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+
+	// The announcer alternates host, guest, host, guest. Whoever puts A
+	// and T in the top row makes "AT" and wins; the other spells nothing.
+	letters := []rune{'A', 'T', 'X', 'X'}
+	spelling := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	scrambled := []model.Position{{Row: 0, Col: 0}, {Row: 1, Col: 1}, {Row: 0, Col: 1}, {Row: 1, Col: 0}}
+	playGame := func(hostPositions, guestPositions []model.Position) {
+		g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+		s.Require().NoError(err)
+		for i, letter := range letters {
+			s.Require().NoError(s.gameController.AnnounceLetter(s.ctx, g.ID, g.CurrentAnnouncer(), letter))
+			s.Require().NoError(s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, hostPositions[i]))
+			s.Require().NoError(s.gameController.PlaceLetter(s.ctx, g.ID, guest.ID, guestPositions[i]))
+			g, _ = s.gameController.GetGame(s.ctx, g.ID)
+		}
+		s.Require().NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+	}
+
+	playGame(spelling, scrambled)
+	playGame(scrambled, spelling)
+
+	stats, err := s.storage.GetPlayerStats(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Equal(2, stats.GamesPlayed)
+	s.Equal(1, stats.GamesWon)
+	s.Equal("AT", stats.BestWord)
+
+	// Guests don't accumulate stats
+	guestStats, err := s.storage.GetPlayerStats(s.ctx, guest.ID)
+	s.Require().NoError(err)
+	s.Zero(guestStats.GamesPlayed)
+}
+
+func (s *ControllerSuite) TestScheduleAutoDismissCompletesGame() {
+	cfg := DefaultConfig()
+	cfg.AutoDismissDelay = time.Minute
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	_ = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, _ := controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+
+	dismissed := make(chan struct{})
+	controller.ScheduleAutoDismiss(lobby.Code, g.ID, func() { close(dismissed) })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	s.clock.Advance(time.Minute)
+
+	select {
+	case <-dismissed:
+	case <-time.After(time.Second):
+		s.FailNow("game was not auto-dismissed")
+	}
+
+	updated, _ := controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+	s.Nil(updated.CurrentGame)
+	s.Require().Len(updated.GameHistory, 1)
+	s.Equal(g.ID, updated.GameHistory[0].ID)
+}
+
+func (s *ControllerSuite) TestWatchGameDurationFollowsGameToNewLobby() {
+	logger := testutil.NopLogger()
+	dictService := dictionary.New(s.storage, model.DefaultAlphabet(), logger)
+	gameCfg := game.DefaultConfig()
+	gameCfg.MaxGameDuration = time.Hour
+	gameController := game.NewController(s.storage, board.New(s.storage, model.DefaultAlphabet(), logger), scoring.New(dictService, scoring.DefaultConfig()), s.clock, s.random, gameCfg, logger)
+	controller := NewController(s.storage, gameController, s.clock, s.random, DefaultConfig(), logger)
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	g, err := controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+
+	abandoned := make(chan model.LobbyCode, 1)
+	controller.WatchGameDuration(g.ID, func(code model.LobbyCode) { abandoned <- code })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+
+	// The lobby and its game move to a new code before the limit
+	s.Require().NoError(s.storage.RenameLobby(s.ctx, lobby.Code, "XYZ789", func(*model.Lobby) error { return nil }))
+	moved, _ := s.storage.GetGame(s.ctx, g.ID)
+	moved.LobbyCode = "XYZ789"
+	s.Require().NoError(s.storage.SaveGame(s.ctx, moved))
+
+	s.clock.Advance(time.Hour)
+	select {
+	case code := <-abandoned:
+		s.Equal(model.LobbyCode("XYZ789"), code)
+	case <-time.After(time.Second):
+		s.FailNow("game was not abandoned")
+	}
+
+	updated, err := controller.GetLobby(s.ctx, "XYZ789")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyStateWaiting, updated.State)
+	s.Nil(updated.CurrentGame)
+}
+
+func (s *ControllerSuite) TestScheduleAutoDismissRevealsDelayedScores() {
+	cfg := DefaultConfig()
+	cfg.AutoDismissDelay = time.Minute
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	_ = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2, DelayedReveal: true})
+	g, _ := controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+
+	dismissed := make(chan struct{})
+	controller.ScheduleAutoDismiss(lobby.Code, g.ID, func() { close(dismissed) })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	s.clock.Advance(time.Minute)
+
+	select {
+	case <-dismissed:
+	case <-time.After(time.Second):
+		s.FailNow("game was not auto-dismissed")
+	}
+
+	game, err := s.gameController.GetGame(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.True(game.ScoresRevealed)
+
+	events, _ := s.storage.GetLobbyEvents(s.ctx, lobby.Code)
+	var completed bool
+	for _, e := range events {
+		completed = completed || e.Type == model.EventGameComplete
+	}
+	s.True(completed, "the revealed scores are recorded")
+}
+
+func (s *ControllerSuite) TestScheduleAutoDismissCancelledByHost() {
+	cfg := DefaultConfig()
+	cfg.AutoDismissDelay = time.Minute
+	controller := NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := controller.CreateLobby(s.ctx, host)
+	_ = controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, _ := controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+
+	dismissed := make(chan struct{})
+	controller.ScheduleAutoDismiss(lobby.Code, g.ID, func() { close(dismissed) })
+	s.Require().Eventually(func() bool { return s.clock.Waiters() == 1 }, time.Second, time.Millisecond)
+
+	// The host dismisses first, so the timer must not fire
+	s.Require().NoError(controller.CompleteGame(s.ctx, lobby.Code))
+	s.clock.Advance(time.Minute)
+
+	select {
+	case <-dismissed:
+		s.Fail("auto-dismiss fired after the host dismissed the game")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// Rematch tests
+
+func (s *ControllerSuite) TestRematchKeepsPlayersAndAddsOneToHistory() {
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002")
+	host := s.createPlayer("host-1", "Host")
+	guest := s.createPlayer("player-2", "Guest")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, guest))
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	first, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+
+	// Not until the game is finished
+	_, err = s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrGameNotComplete)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		g, _ := s.gameController.GetGame(s.ctx, first.ID)
+		_ = s.gameController.AnnounceLetter(s.ctx, first.ID, g.CurrentAnnouncer(), rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, first.ID, host.ID, pos)
+		_ = s.gameController.PlaceLetter(s.ctx, first.ID, guest.ID, pos)
+	}
+
+	_, err = s.controller.Rematch(s.ctx, lobby.Code, guest.ID)
+	s.ErrorIs(err, model.ErrNotHost)
+
+	second, err := s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(model.GameID("GAME00000002"), second.ID)
+	s.Equal(first.Players, second.Players)
+	s.Equal(first.GridSize, second.GridSize)
+
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateInGame, updated.State)
+	s.Equal(second.ID, *updated.CurrentGame)
+	s.Require().Len(updated.GameHistory, 1)
+	s.Equal(first.ID, updated.GameHistory[0].ID)
+}
+
+func (s *ControllerSuite) TestRematchReplaysCompletedGame() {
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+
+	// Nothing to replay yet
+	_, err := s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrNoGameInProgress)
+
+	first, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, first.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, first.ID, host.ID, pos)
+	}
+	s.Require().NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+
+	second, err := s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(first.Players, second.Players)
+
+	// The completed game is not recorded twice
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.Equal(model.LobbyStateInGame, updated.State)
+	s.Equal(second.ID, *updated.CurrentGame)
+	s.Len(updated.GameHistory, 1)
+}
+
+func (s *ControllerSuite) TestRematchAppliesStartChecks() {
+	cfg := DefaultConfig()
+	cfg.GameCooldown = 30 * time.Second
+	s.controller = NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2, RequireReady: true})
+	s.Require().NoError(s.controller.SetReady(s.ctx, lobby.Code, host.ID, true))
+	first, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, first.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, first.ID, host.ID, pos)
+	}
+	s.Require().NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+
+	// Readiness was reset when the game started
+	_, err = s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrPlayersNotReady)
+	s.Require().NoError(s.controller.SetReady(s.ctx, lobby.Code, host.ID, true))
+
+	_, err = s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrLobbyOnCooldown)
+
+	s.clock.Advance(30 * time.Second)
+	second, err := s.controller.Rematch(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(model.GameID("GAME00000002"), second.ID)
+
+	// Starting the rematch doesn't count as completing a game
+	updated, _ := s.controller.GetLobby(s.ctx, lobby.Code)
+	s.True(updated.LastGameCompletedAt.Before(s.clock.Now()))
+}
+
+func (s *ControllerSuite) TestSetReadyOnceGameHasFinished() {
+	s.random.QueueString("ABC123", "GAME00000001")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+
+	s.ErrorIs(s.controller.SetReady(s.ctx, lobby.Code, host.ID, true), model.ErrGameInProgress)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+	s.NoError(s.controller.SetReady(s.ctx, lobby.Code, host.ID, true))
+}
+
+// Minimum human player tests
+
+func (s *ControllerSuite) TestStartGameRequiresMinHumanPlayers() {
+	s.random.QueueString("ABC123", "GAME00000001")
+	host := s.createPlayer("host-1", "Host")
+	bot := s.createPlayer("bot-1", "Bot")
+	bot.IsBot = true
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, bot))
+	s.Require().NoError(s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 5, MinHumanPlayers: 2}))
+
+	// The bot fills a seat but doesn't count towards the minimum
+	_, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrInsufficientHumans)
+	s.ErrorContains(err, "2 needed, 1 in lobby")
+
+	s.Require().NoError(s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-2", "Guest")))
+	_, err = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.NoError(err)
+}
+
+// Game cooldown tests
+
+func (s *ControllerSuite) TestStartGameWaitsForCooldownAfterCompletion() {
+	cfg := DefaultConfig()
+	cfg.GameCooldown = 30 * time.Second
+	s.controller = NewController(s.storage, s.gameController, s.clock, s.random, cfg, testutil.NopLogger())
+
+	s.random.QueueString("ABC123", "GAME00000001", "GAME00000002")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	first, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, first.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, first.ID, host.ID, pos)
+	}
+	s.Require().NoError(s.controller.CompleteGame(s.ctx, lobby.Code))
+
+	s.clock.Advance(10 * time.Second)
+	_, err = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.ErrorIs(err, model.ErrLobbyOnCooldown)
+	s.ErrorContains(err, "20s remaining")
+
+	s.clock.Advance(20 * time.Second)
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal(model.GameID("GAME00000002"), g.ID)
+}
+
+// Export/import tests
+
+func (s *ControllerSuite) TestExportedLobbyImportsIntoFreshStore() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	guest := s.createPlayer("guest-1", "Guest")
+	_ = s.storage.SavePlayer(s.ctx, &host)
+	_ = s.storage.SavePlayer(s.ctx, &guest)
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, guest)
+	g, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Require().NoError(s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, 'C'))
+	s.Require().NoError(s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, model.Position{Row: 0, Col: 0}))
+
+	export, err := s.controller.ExportLobby(s.ctx, lobby.Code)
+	s.Require().NoError(err)
+	s.Len(export.Players, 2)
+	s.Len(export.Boards, 2)
+	data, err := json.Marshal(export)
+	s.Require().NoError(err)
+
+	// Import through a new set of controllers over an empty store
+	var decoded model.LobbyExport
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.SetupTest()
+	imported, err := s.controller.ImportLobby(s.ctx, &decoded)
+	s.Require().NoError(err)
+
+	s.Equal(export.Lobby, imported)
+	reexport, err := s.controller.ExportLobby(s.ctx, imported.Code)
+	s.Require().NoError(err)
+	s.Equal(export.Players, reexport.Players)
+	s.Equal(export.Game, reexport.Game)
+	s.ElementsMatch(export.Boards, reexport.Boards)
+
+	// The game carries on where it left off
+	s.Require().NoError(s.gameController.PlaceLetter(s.ctx, g.ID, guest.ID, model.Position{Row: 1, Col: 1}))
+	s.NoError(s.gameController.AnnounceLetter(s.ctx, g.ID, guest.ID, 'A'))
+}
+
+func (s *ControllerSuite) TestImportLobbyRekeysTakenCodeAndGameID() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	export, err := s.controller.ExportLobby(s.ctx, lobby.Code)
+	s.Require().NoError(err)
+
+	// Members can't be in two lobbies at once
+	_, err = s.controller.ImportLobby(s.ctx, export)
+	s.ErrorIs(err, model.ErrAlreadyInLobby)
+
+	// Once the host leaves, someone else takes the lobby code
+	s.Require().NoError(s.controller.LeaveLobby(s.ctx, lobby.Code, host.ID))
+	s.random.QueueString("ABC123", "XYZ789", "GAME87654321")
+	_, err = s.controller.CreateLobby(s.ctx, s.createPlayer("other-1", "Other"))
+	s.Require().NoError(err)
+
+	imported, err := s.controller.ImportLobby(s.ctx, export)
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("XYZ789"), imported.Code)
+	s.Require().NotNil(imported.CurrentGame)
+	s.Equal(model.GameID("GAME87654321"), *imported.CurrentGame)
+
+	original, err := s.gameController.GetGame(s.ctx, g.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.Code, original.LobbyCode)
+	moved, err := s.gameController.GetGame(s.ctx, *imported.CurrentGame)
+	s.Require().NoError(err)
+	s.Equal(imported.Code, moved.LobbyCode)
+	s.NotEqual(original.SpectateToken, moved.SpectateToken)
+}
+
+func (s *ControllerSuite) TestImportLobbyRejectsInvalidExport() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	guest := s.createPlayer("guest-1", "Guest")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, guest)
+	_, err := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	export, err := s.controller.ExportLobby(s.ctx, lobby.Code)
+	s.Require().NoError(err)
+	s.SetupTest()
+
+	cases := map[string]func(e *model.LobbyExport){
+		"bad code":  func(e *model.LobbyExport) { e.Lobby.Code = "abc" },
+		"bad size":  func(e *model.LobbyExport) { e.Lobby.Config.GridSize = 100 },
+		"no host":   func(e *model.LobbyExport) { e.Lobby.Members[0].IsHost = false },
+		"two hosts": func(e *model.LobbyExport) { e.Lobby.Members[1].IsHost = true },
+		"wrong game": func(e *model.LobbyExport) {
+			id := model.GameID("OTHER")
+			e.Lobby.CurrentGame = &id
+		},
+		"board off grid": func(e *model.LobbyExport) { e.Boards[0].Cells = e.Boards[0].Cells[:1] },
+	}
+	for name, corrupt := range cases {
+		data, _ := json.Marshal(export)
+		var e model.LobbyExport
+		s.Require().NoError(json.Unmarshal(data, &e))
+		corrupt(&e)
+
+		_, err := s.controller.ImportLobby(s.ctx, &e)
+		s.ErrorIs(err, model.ErrInvalidLobbyExport, name)
+
+		// Nothing is left behind
+		exists, _ := s.storage.LobbyExists(s.ctx, "ABC123")
+		s.False(exists, name)
+		_, err = s.storage.GetGame(s.ctx, "GAME12345678")
+		s.ErrorIs(err, model.ErrGameNotFound, name)
+		_, err = s.storage.GetPlayer(s.ctx, host.ID)
+		s.ErrorIs(err, model.ErrPlayerNotFound, name)
+	}
+}
+
+// GetActiveGame tests
+
+func (s *ControllerSuite) TestGetActiveGameReturnsInProgressGame() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	code, gameID, err := s.controller.GetActiveGame(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.Code, code)
+	s.Equal(g.ID, gameID)
+}
+
+func (s *ControllerSuite) TestGetActiveGameEmptyWhenLobbyWaiting() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	code, gameID, err := s.controller.GetActiveGame(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.Code, code)
+	s.Empty(gameID)
+}
+
+func (s *ControllerSuite) TestGetActiveGameClearedAfterAbandon() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_, _ = s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	_ = s.controller.AbandonGame(s.ctx, lobby.Code, host.ID)
+
+	_, gameID, err := s.controller.GetActiveGame(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Empty(gameID)
+}
+
+func (s *ControllerSuite) TestGetActiveGameClearedOnceGameComplete() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	_ = s.controller.UpdateConfig(s.ctx, lobby.Code, host.ID, model.LobbyConfig{GridSize: 2})
+	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+
+	positions := []model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 1, Col: 0}, {Row: 1, Col: 1}}
+	for i, pos := range positions {
+		_ = s.gameController.AnnounceLetter(s.ctx, g.ID, host.ID, rune('A'+i))
+		_ = s.gameController.PlaceLetter(s.ctx, g.ID, host.ID, pos)
+	}
+
+	_, gameID, err := s.controller.GetActiveGame(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Empty(gameID)
+}
+
+func (s *ControllerSuite) TestGetActiveGameEmptyOnceGameExpires() {
+	s.random.QueueString("ABC123", "GAME12345678")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	g, _ := s.controller.StartGame(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(s.storage.DeleteGame(s.ctx, g.ID))
+
+	code, gameID, err := s.controller.GetActiveGame(s.ctx, host.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.Code, code)
+	s.Empty(gameID)
+}
+
+func (s *ControllerSuite) TestGetActiveGameEmptyWhenNotInLobby() {
+	code, gameID, err := s.controller.GetActiveGame(s.ctx, "nobody")
+	s.Require().NoError(err)
+	s.Empty(code)
+	s.Empty(gameID)
+}
+
+// Event tests
+
+func (s *ControllerSuite) TestGetEventsRecordsLifecycleInOrder() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	s.clock.Advance(time.Minute)
+	player := s.createPlayer("player-1", "Player")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+	_ = s.controller.LeaveLobby(s.ctx, lobby.Code, player.ID)
+
+	events, err := s.controller.GetEvents(s.ctx, lobby.Code, time.Time{})
+	s.Require().NoError(err)
+	s.Require().Len(events, 3)
+	s.Equal(model.EventLobbyCreated, events[0].Type)
+	s.Equal(model.EventPlayerJoined, events[1].Type)
+	s.Equal(model.EventPlayerLeft, events[2].Type)
+	s.Equal(player.ID, events[2].PlayerID)
+}
+
+func (s *ControllerSuite) TestGetEventsFiltersBySince() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+
+	s.clock.Advance(time.Minute)
+	joinedAt := s.clock.Now()
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, s.createPlayer("player-1", "Player"))
+
+	events, err := s.controller.GetEvents(s.ctx, lobby.Code, joinedAt)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(model.EventPlayerJoined, events[0].Type)
+
+	_, err = s.controller.GetEvents(s.ctx, lobby.Code, joinedAt.Add(time.Second))
+	s.ErrorIs(err, model.ErrNoLobbyEvents)
+}
+
+func (s *ControllerSuite) TestGetEventsFailsForUnknownLobby() {
+	_, err := s.controller.GetEvents(s.ctx, "NOPE99", time.Time{})
+	s.ErrorIs(err, model.ErrNoLobbyEvents)
+}
+
+func (s *ControllerSuite) TestSpectatorChatVisibleOnlyToSpectatorsAndHost() {
+	s.random.QueueString("ABC123")
+	host := s.createPlayer("host-1", "Host")
+	lobby, _ := s.controller.CreateLobby(s.ctx, host)
+	player := s.createPlayer("player-1", "Player")
+	spectator := s.createPlayer("spectator-1", "Spectator")
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, player)
+	_ = s.controller.JoinLobby(s.ctx, lobby.Code, spectator)
+	s.Require().NoError(s.controller.SetRole(s.ctx, lobby.Code, spectator.ID, model.RoleSpectator))
+
+	_, _, err := s.controller.SendSpectatorChat(s.ctx, lobby.Code, player.ID, "hello")
+	s.ErrorIs(err, model.ErrNotInSpectatorChat)
+	_, _, err = s.controller.SendSpectatorChat(s.ctx, lobby.Code, spectator.ID, "   ")
+	s.ErrorIs(err, model.ErrInvalidChatMessage)
+
+	_, chat, err := s.controller.SendSpectatorChat(s.ctx, lobby.Code, spectator.ID, " nice move ")
+	s.Require().NoError(err)
+	s.Equal(model.SpectatorChatPayload{DisplayName: "Spectator", Message: "nice move"}, *chat)
+
+	messages, err := s.controller.GetSpectatorChat(s.ctx, lobby.Code, host.ID)
+	s.Require().NoError(err)
+	s.Equal([]model.SpectatorChatPayload{*chat}, messages)
+	messages, err = s.controller.GetSpectatorChat(s.ctx, lobby.Code, player.ID)
+	s.Require().NoError(err)
+	s.Empty(messages)
+
+	events, err := s.controller.GetVisibleEvents(s.ctx, lobby.Code, player.ID, time.Time{})
+	s.Require().NoError(err)
+	for _, event := range events {
+		s.NotEqual(model.EventSpectatorChat, event.Type)
+	}
+}
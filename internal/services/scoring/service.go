@@ -1,26 +1,238 @@
 package scoring
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 )
 
+// Tie-break strategies for resolving a shared top score
+const (
+	TieBreakNone  = "none"  // Ties have no winner
+	TieBreakSpeed = "speed" // The tied player with the lowest cumulative placement latency wins
+)
+
+// Word value modes, selecting how a word's score is computed before the
+// full line multiplier
+const (
+	WordValueLength     = "length"      // One point per letter
+	WordValueTile       = "tile"        // The sum of the letters' tile values
+	WordValueTileLength = "tile+length" // The sum of the tile values times the word's length
+)
+
+// FullLineMultiplier multiplies the score of a word filling an entire row or column
+const FullLineMultiplier = 2
+
+// Config holds configuration for scoring
+type Config struct {
+	// IsolatedCellPenalty is subtracted for each letter not part of any scored word
+	IsolatedCellPenalty int
+	// TieBreak selects how a shared top score is resolved (TieBreakNone or TieBreakSpeed)
+	// Empty is treated as TieBreakNone
+	TieBreak string
+	// DedupeWords counts only the highest-scoring instance of each distinct
+	// word on a board; other instances are kept but marked Deduped
+	DedupeWords bool
+	// SymmetryBonus is awarded to a full board whose rows all read the same
+	// left-to-right and right-to-left; 0 disables it
+	SymmetryBonus int
+	// PerfectBoardBonus is awarded to a full board whose every row and every
+	// column is a dictionary word; 0 disables it
+	PerfectBoardBonus int
+	// BestWordOnly scores each board by its single highest-scoring word,
+	// which is marked Best; the other words are still reported
+	BestWordOnly bool
+	// WordValue selects how each word is valued (WordValueLength,
+	// WordValueTile or WordValueTileLength); empty is treated as WordValueLength
+	WordValue string
+	// TileValues gives each letter's points in the tile modes; letters
+	// missing from it are worth 1
+	TileValues map[rune]int
+	// UniqueWordBonus is awarded for each distinct word that only one
+	// player found across the game; 0 disables it
+	UniqueWordBonus int
+	// StealBonus is awarded for each turn a player was first to complete a
+	// row spelling a word; 0 disables it
+	StealBonus int
+}
+
+// DefaultTileValues returns the standard English Scrabble tile values
+func DefaultTileValues() map[rune]int {
+	values := make(map[rune]int, 26)
+	for points, letters := range map[int]string{
+		1:  "AEILNORSTU",
+		2:  "DG",
+		3:  "BCMP",
+		4:  "FHVWY",
+		5:  "K",
+		8:  "JX",
+		10: "QZ",
+	} {
+		for _, l := range letters {
+			values[l] = points
+		}
+	}
+	return values
+}
+
+// DefaultConfig returns the default scoring configuration
+func DefaultConfig() Config {
+	return Config{
+		IsolatedCellPenalty: 0,
+		TieBreak:            TieBreakNone,
+		DedupeWords:         false,
+		SymmetryBonus:       0,
+		PerfectBoardBonus:   0,
+		UniqueWordBonus:     0,
+		StealBonus:          0,
+		BestWordOnly:        false,
+		WordValue:           WordValueLength,
+		TileValues:          DefaultTileValues(),
+	}
+}
+
+// wordValueMode returns the configured word value mode, defaulting to length
+func (c Config) wordValueMode() string {
+	if c.WordValue == "" {
+		return WordValueLength
+	}
+	return c.WordValue
+}
+
+// tilePoints sums the tile values of letters
+func (c Config) tilePoints(letters []rune) int {
+	points := 0
+	for _, l := range letters {
+		if v, ok := c.TileValues[l]; ok {
+			points += v
+		} else {
+			points++
+		}
+	}
+	return points
+}
+
+// wordScore values a word of the given letters, before the full line multiplier
+func (c Config) wordScore(letters []rune) (score, tilePoints int) {
+	switch c.wordValueMode() {
+	case WordValueTile:
+		tilePoints = c.tilePoints(letters)
+		return tilePoints, tilePoints
+	case WordValueTileLength:
+		tilePoints = c.tilePoints(letters)
+		return tilePoints * len(letters), tilePoints
+	default:
+		return len(letters), 0
+	}
+}
+
+// Options holds per-game scoring rules chosen in the lobby config
+type Options struct {
+	// RequireEdgeAnchored only counts words that touch the edge of the board
+	RequireEdgeAnchored bool
+	// Steals counts the steal bonuses each player earned during the game
+	Steals map[model.PlayerID]int
+}
+
+// Rules describes the scoring rules in effect, for showing to players
+type Rules struct {
+	MinWordLength       int
+	FullLineMultiplier  int
+	Diagonals           bool // Diagonal words are never scored
+	RequireEdgeAnchored bool
+	IsolatedCellPenalty int
+	SymmetryBonus       int
+	PerfectBoardBonus   int
+	UniqueWordBonus     int
+	StealBonus          int
+	DedupeWords         bool
+	BestWordOnly        bool
+	TieBreak            string
+	WordValue           string
+}
+
+// Rules resolves the scoring rules for the given per-game options
+func (c Config) Rules(opts Options) Rules {
+	tieBreak := c.TieBreak
+	if tieBreak == "" {
+		tieBreak = TieBreakNone
+	}
+	return Rules{
+		MinWordLength:       dictionary.MinWordLength,
+		FullLineMultiplier:  FullLineMultiplier,
+		Diagonals:           false,
+		RequireEdgeAnchored: opts.RequireEdgeAnchored,
+		IsolatedCellPenalty: c.IsolatedCellPenalty,
+		SymmetryBonus:       c.SymmetryBonus,
+		PerfectBoardBonus:   c.PerfectBoardBonus,
+		UniqueWordBonus:     c.UniqueWordBonus,
+		StealBonus:          c.StealBonus,
+		DedupeWords:         c.DedupeWords,
+		BestWordOnly:        c.BestWordOnly,
+		TieBreak:            tieBreak,
+		WordValue:           c.wordValueMode(),
+	}
+}
+
+// OptionsForGame returns the scoring options a game was started with
+func OptionsForGame(g *model.Game) Options {
+	var steals map[model.PlayerID]int
+	if len(g.StealBonuses) > 0 {
+		steals = make(map[model.PlayerID]int)
+		for _, steal := range g.StealBonuses {
+			steals[steal.PlayerID]++
+		}
+	}
+	return Options{
+		RequireEdgeAnchored: g.RequireEdgeAnchored,
+		Steals:              steals,
+	}
+}
+
 // Service provides scoring functionality for completed boards
 type Service struct {
 	dictionary *dictionary.Service
+	config     Config
 }
 
 // New creates a new ScoringService
-func New(dictionary *dictionary.Service) *Service {
+func New(dictionary *dictionary.Service, cfg Config) *Service {
 	return &Service{
 		dictionary: dictionary,
+		config:     cfg,
 	}
 }
 
-// ScoreBoard calculates the final score for a completed board
+// Config returns the scoring configuration
+func (s *Service) Config() Config {
+	return s.config
+}
+
+// CheckDictionary returns ErrDictionaryNotLoaded if there is no dictionary to
+// score against, since every board would otherwise silently score zero
+func (s *Service) CheckDictionary() error {
+	if !s.dictionary.IsLoaded() || s.dictionary.WordCount() == 0 {
+		return model.ErrDictionaryNotLoaded
+	}
+	return nil
+}
+
+// IsWord reports whether word is in the dictionary
+func (s *Service) IsWord(word string) bool {
+	return s.dictionary.IsValidWord(word)
+}
+
+// ScoreBoard calculates the final score for a completed board using default options
 func (s *Service) ScoreBoard(board *model.Board) *model.BoardScore {
+	return s.ScoreBoardWithOptions(board, Options{})
+}
+
+// ScoreBoardWithOptions calculates the final score for a completed board
+func (s *Service) ScoreBoardWithOptions(board *model.Board, opts Options) *model.BoardScore {
 	result := &model.BoardScore{
 		PlayerID: board.PlayerID,
 		Words:    []model.WordMatch{},
@@ -29,7 +241,8 @@ func (s *Service) ScoreBoard(board *model.Board) *model.BoardScore {
 	// Find words in rows (horizontal)
 	for row := 0; row < board.Size; row++ {
 		letters := board.GetRow(row)
-		words := s.findBestWordsInLine(letters, board.Size)
+		onEdge := row == 0 || row == board.Size-1
+		words := s.findBestWordsInLine(letters, board.Size, opts.RequireEdgeAnchored && !onEdge)
 		for _, w := range words {
 			result.Words = append(result.Words, model.WordMatch{
 				Word:       w.word,
@@ -37,6 +250,7 @@ func (s *Service) ScoreBoard(board *model.Board) *model.BoardScore {
 				Horizontal: true,
 				Length:     w.length,
 				Score:      w.score,
+				TilePoints: w.tilePoints,
 			})
 			result.TotalScore += w.score
 		}
@@ -45,7 +259,8 @@ func (s *Service) ScoreBoard(board *model.Board) *model.BoardScore {
 	// Find words in columns (vertical)
 	for col := 0; col < board.Size; col++ {
 		letters := board.GetCol(col)
-		words := s.findBestWordsInLine(letters, board.Size)
+		onEdge := col == 0 || col == board.Size-1
+		words := s.findBestWordsInLine(letters, board.Size, opts.RequireEdgeAnchored && !onEdge)
 		for _, w := range words {
 			result.Words = append(result.Words, model.WordMatch{
 				Word:       w.word,
@@ -53,25 +268,304 @@ func (s *Service) ScoreBoard(board *model.Board) *model.BoardScore {
 				Horizontal: false,
 				Length:     w.length,
 				Score:      w.score,
+				TilePoints: w.tilePoints,
 			})
 			result.TotalScore += w.score
 		}
 	}
 
+	// Repeated words only score once
+	if s.config.DedupeWords {
+		result.TotalScore -= dedupeWords(result.Words)
+	}
+
+	// Only the single best word counts
+	if s.config.BestWordOnly {
+		result.TotalScore = markBestWord(result.Words)
+	}
+
+	// Penalise letters that don't contribute to any scored word
+	if s.config.IsolatedCellPenalty != 0 {
+		result.IsolatedCells = countIsolatedCells(board, result.Words)
+		result.Penalty = result.IsolatedCells * s.config.IsolatedCellPenalty
+		result.TotalScore -= result.Penalty
+	}
+
+	// Reward boards that mirror themselves
+	if s.config.SymmetryBonus != 0 && isSymmetric(board) {
+		result.SymmetryBonus = s.config.SymmetryBonus
+		result.TotalScore += result.SymmetryBonus
+	}
+
+	// Reward boards where every line reads as a word
+	if s.config.PerfectBoardBonus != 0 && s.isPerfect(board) {
+		result.PerfectBonus = s.config.PerfectBoardBonus
+		result.TotalScore += result.PerfectBonus
+	}
+
+	// Reward being first to finish a row word during play
+	if s.config.StealBonus != 0 {
+		result.StealBonus = opts.Steals[board.PlayerID] * s.config.StealBonus
+		result.TotalScore += result.StealBonus
+	}
+
+	return result
+}
+
+// ExplainBoard scores a board and reports how the score was reached: every
+// word found in each line and why it did or didn't score, and each step
+// taken to reach the total. It applies the same rules as ScoreBoardWithOptions.
+func (s *Service) ExplainBoard(board *model.Board, opts Options) *model.ScoreExplanation {
+	result := &model.ScoreExplanation{
+		Score:      *s.ScoreBoardWithOptions(board, opts),
+		Candidates: []model.WordCandidate{},
+		Steps:      []model.ScoringStep{},
+	}
+
+	for row := 0; row < board.Size; row++ {
+		onEdge := row == 0 || row == board.Size-1
+		for _, c := range s.evaluateLine(board.GetRow(row), board.Size, opts.RequireEdgeAnchored && !onEdge) {
+			result.Candidates = append(result.Candidates, explainCandidate(c, model.Position{Row: row, Col: c.start}, true))
+		}
+	}
+	for col := 0; col < board.Size; col++ {
+		onEdge := col == 0 || col == board.Size-1
+		for _, c := range s.evaluateLine(board.GetCol(col), board.Size, opts.RequireEdgeAnchored && !onEdge) {
+			result.Candidates = append(result.Candidates, explainCandidate(c, model.Position{Row: c.start, Col: col}, false))
+		}
+	}
+
+	total := 0
+	addStep := func(description string, points int) {
+		total += points
+		result.Steps = append(result.Steps, model.ScoringStep{Description: description, Points: points, Total: total})
+	}
+	for _, w := range result.Score.Words {
+		switch {
+		case w.Deduped:
+			addStep(fmt.Sprintf("%s at %s is a repeat, so not counted", w.Word, describeStart(w)), 0)
+		case s.config.BestWordOnly && !w.Best:
+			addStep(fmt.Sprintf("%s at %s is not the best word, so not counted", w.Word, describeStart(w)), 0)
+		case w.Length == board.Size:
+			addStep(fmt.Sprintf("%s at %s fills the line: %s x%d", w.Word, describeStart(w), s.describeValue(w), FullLineMultiplier), w.Score)
+		default:
+			addStep(fmt.Sprintf("%s at %s: %s", w.Word, describeStart(w), s.describeValue(w)), w.Score)
+		}
+	}
+	if result.Score.Penalty != 0 {
+		addStep(fmt.Sprintf("%d isolated letters x%d penalty", result.Score.IsolatedCells, s.config.IsolatedCellPenalty), -result.Score.Penalty)
+	}
+	if result.Score.SymmetryBonus != 0 {
+		addStep("symmetry bonus: every row reads the same both ways", result.Score.SymmetryBonus)
+	}
+	if result.Score.PerfectBonus != 0 {
+		addStep("perfect board bonus: every row and column is a word", result.Score.PerfectBonus)
+	}
+	if result.Score.StealBonus != 0 {
+		steals := opts.Steals[board.PlayerID]
+		addStep(fmt.Sprintf("%d steal bonuses x%d: first to complete a row word in a turn", steals, s.config.StealBonus), result.Score.StealBonus)
+	}
+
 	return result
 }
 
+// explainCandidate converts a line candidate to a model.WordCandidate
+// starting at start on the board
+func explainCandidate(c wordCandidate, start model.Position, horizontal bool) model.WordCandidate {
+	cells := make([]model.Position, c.length)
+	for i := range cells {
+		if horizontal {
+			cells[i] = model.Position{Row: start.Row, Col: start.Col + i}
+		} else {
+			cells[i] = model.Position{Row: start.Row + i, Col: start.Col}
+		}
+	}
+	return model.WordCandidate{
+		Word:       c.word,
+		StartPos:   start,
+		Horizontal: horizontal,
+		Cells:      cells,
+		Score:      c.score,
+		Outcome:    c.outcome,
+		SubsumedBy: c.subsumedBy,
+	}
+}
+
+// describeValue explains a word's value before the full line multiplier,
+// e.g. "11 tile points x2 letters"
+func (s *Service) describeValue(w model.WordMatch) string {
+	switch s.config.wordValueMode() {
+	case WordValueTile:
+		return fmt.Sprintf("%d tile points", w.TilePoints)
+	case WordValueTileLength:
+		return fmt.Sprintf("%d tile points x%d letters", w.TilePoints, w.Length)
+	default:
+		return fmt.Sprintf("%d letters", w.Length)
+	}
+}
+
+// describeStart names where a word starts, e.g. "row 1, col 2 across"
+func describeStart(w model.WordMatch) string {
+	direction := "down"
+	if w.Horizontal {
+		direction = "across"
+	}
+	return fmt.Sprintf("row %d, col %d %s", w.StartPos.Row+1, w.StartPos.Col+1, direction)
+}
+
+// isSymmetric reports whether the board is full and every row is a palindrome
+func isSymmetric(board *model.Board) bool {
+	if !board.IsFull() {
+		return false
+	}
+	for row := 0; row < board.Size; row++ {
+		letters := board.GetRow(row)
+		for i, j := 0, len(letters)-1; i < j; i, j = i+1, j-1 {
+			if letters[i] != letters[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isPerfect reports whether the board is full and every row and every
+// column, read whole, is a dictionary word
+func (s *Service) isPerfect(board *model.Board) bool {
+	if !board.IsFull() {
+		return false
+	}
+	for i := 0; i < board.Size; i++ {
+		if !s.dictionary.IsValidWord(string(board.GetRow(i))) || !s.dictionary.IsValidWord(string(board.GetCol(i))) {
+			return false
+		}
+	}
+	return true
+}
+
+// dedupeWords marks every instance of a word except the highest-scoring one
+// as Deduped (the first found wins a tie) and returns the score dropped
+func dedupeWords(words []model.WordMatch) int {
+	best := make(map[string]int, len(words))
+	for i, w := range words {
+		if j, ok := best[w.Word]; !ok || w.Score > words[j].Score {
+			best[w.Word] = i
+		}
+	}
+
+	dropped := 0
+	for i := range words {
+		if best[words[i].Word] != i {
+			words[i].Deduped = true
+			dropped += words[i].Score
+		}
+	}
+	return dropped
+}
+
+// markBestWord marks the highest-scoring counted word as Best (the first
+// found wins a tie) and returns its score, or 0 if there are no words
+func markBestWord(words []model.WordMatch) int {
+	best := -1
+	for i, w := range words {
+		if w.Deduped {
+			continue
+		}
+		if best < 0 || w.Score > words[best].Score {
+			best = i
+		}
+	}
+	if best < 0 {
+		return 0
+	}
+	words[best].Best = true
+	return words[best].Score
+}
+
+// awardUniqueWords adds bonus to each score for every distinct counted
+// word that is counted on no other player's board. With bestOnly, only each
+// board's best word counts.
+func awardUniqueWords(scores []model.BoardScore, bonus int, bestOnly bool) {
+	finders := make(map[string]int)
+	boardWords := make([]map[string]bool, len(scores))
+	for i, score := range scores {
+		boardWords[i] = make(map[string]bool)
+		for _, w := range score.Words {
+			if w.Deduped || (bestOnly && !w.Best) || boardWords[i][w.Word] {
+				continue
+			}
+			boardWords[i][w.Word] = true
+			finders[w.Word]++
+		}
+	}
+
+	for i := range scores {
+		for word := range boardWords[i] {
+			if finders[word] == 1 {
+				scores[i].UniqueWords++
+			}
+		}
+		scores[i].UniqueBonus = scores[i].UniqueWords * bonus
+		scores[i].TotalScore += scores[i].UniqueBonus
+	}
+}
+
+// countIsolatedCells counts filled cells not covered by any of the given words
+func countIsolatedCells(board *model.Board, words []model.WordMatch) int {
+	covered := make([][]bool, board.Size)
+	for i := range covered {
+		covered[i] = make([]bool, board.Size)
+	}
+	for _, w := range words {
+		for i := 0; i < w.Length; i++ {
+			if w.Horizontal {
+				covered[w.StartPos.Row][w.StartPos.Col+i] = true
+			} else {
+				covered[w.StartPos.Row+i][w.StartPos.Col] = true
+			}
+		}
+	}
+
+	count := 0
+	for row := 0; row < board.Size; row++ {
+		for col := 0; col < board.Size; col++ {
+			if c := board.Cells[row][col]; c != 0 && c != model.BlockedCell && !covered[row][col] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 // wordCandidate represents a potential word found in a line
 type wordCandidate struct {
-	word   string
-	start  int
-	length int
-	score  int
+	word       string
+	start      int
+	length     int
+	score      int
+	tilePoints int // Sum of the letters' tile values, in the tile modes
+	outcome    model.CandidateOutcome
+	// subsumedBy is the selected word this candidate overlaps, if subsumed
+	subsumedBy string
 }
 
 // findBestWordsInLine finds the best non-overlapping set of words in a line
 // Uses greedy algorithm: prefer longer words first
-func (s *Service) findBestWordsInLine(letters []rune, gridSize int) []wordCandidate {
+// If requireEndAnchor is set, only words starting or ending at an end of the
+// line are considered (used for edge anchoring on lines inside the border)
+func (s *Service) findBestWordsInLine(letters []rune, gridSize int, requireEndAnchor bool) []wordCandidate {
+	var selected []wordCandidate
+	for _, c := range s.evaluateLine(letters, gridSize, requireEndAnchor) {
+		if c.outcome == model.CandidateScored {
+			selected = append(selected, c)
+		}
+	}
+	return selected
+}
+
+// evaluateLine finds every valid word in a line and decides which ones
+// score, recording why each of the others was dropped
+func (s *Service) evaluateLine(letters []rune, gridSize int, requireEndAnchor bool) []wordCandidate {
 	// Find all valid words
 	validWords := s.dictionary.FindAllValidWords(letters)
 	if len(validWords) == 0 {
@@ -82,54 +576,74 @@ func (s *Service) findBestWordsInLine(letters []rune, gridSize int) []wordCandid
 	candidates := make([]wordCandidate, 0, len(validWords))
 	for _, vw := range validWords {
 		length := vw.End - vw.Start
-		score := length
+		score, tilePoints := s.config.wordScore(letters[vw.Start:vw.End])
 		if length == gridSize {
-			score = length * 2 // Full line bonus
+			score *= FullLineMultiplier // Full line bonus
+		}
+		c := wordCandidate{
+			word:       vw.Word,
+			start:      vw.Start,
+			length:     length,
+			score:      score,
+			tilePoints: tilePoints,
+			outcome:    model.CandidateScored,
+		}
+		if requireEndAnchor && vw.Start != 0 && vw.End != len(letters) {
+			c.outcome = model.CandidateNotAnchored // Floating word that doesn't touch the border
 		}
-		candidates = append(candidates, wordCandidate{
-			word:   vw.Word,
-			start:  vw.Start,
-			length: length,
-			score:  score,
-		})
+		candidates = append(candidates, c)
 	}
 
 	// Sort by length descending (greedy: prefer longer words)
-	sort.Slice(candidates, func(i, j int) bool {
+	sort.SliceStable(candidates, func(i, j int) bool {
 		return candidates[i].length > candidates[j].length
 	})
 
-	// Greedy selection: pick non-overlapping words
-	used := make([]bool, len(letters))
-	var selected []wordCandidate
+	// Greedy selection: pick non-overlapping words. usedBy records which
+	// selected word covers each position
+	usedBy := make([]string, len(letters))
+	for i := range candidates {
+		c := &candidates[i]
+		if c.outcome != model.CandidateScored {
+			continue
+		}
 
-	for _, c := range candidates {
 		// Check if any position in this word is already used
-		overlaps := false
-		for i := c.start; i < c.start+c.length; i++ {
-			if used[i] {
-				overlaps = true
+		for pos := c.start; pos < c.start+c.length; pos++ {
+			if usedBy[pos] != "" {
+				c.outcome = model.CandidateSubsumed
+				c.subsumedBy = usedBy[pos]
 				break
 			}
 		}
 
-		if !overlaps {
-			selected = append(selected, c)
+		if c.outcome == model.CandidateScored {
 			// Mark positions as used
-			for i := c.start; i < c.start+c.length; i++ {
-				used[i] = true
+			for pos := c.start; pos < c.start+c.length; pos++ {
+				usedBy[pos] = c.word
 			}
 		}
 	}
 
-	return selected
+	return candidates
 }
 
-// ScoreMultipleBoards scores all boards and returns results sorted by score
+// ScoreMultipleBoards scores all boards using default options and returns
+// results sorted by score
 func (s *Service) ScoreMultipleBoards(boards []*model.Board) []model.BoardScore {
+	return s.ScoreMultipleBoardsWithOptions(boards, Options{})
+}
+
+// ScoreMultipleBoardsWithOptions scores all boards and returns results sorted by score
+func (s *Service) ScoreMultipleBoardsWithOptions(boards []*model.Board, opts Options) []model.BoardScore {
 	scores := make([]model.BoardScore, 0, len(boards))
 	for _, board := range boards {
-		scores = append(scores, *s.ScoreBoard(board))
+		scores = append(scores, *s.ScoreBoardWithOptions(board, opts))
+	}
+
+	// Reward words nobody else found
+	if s.config.UniqueWordBonus != 0 {
+		awardUniqueWords(scores, s.config.UniqueWordBonus, s.config.BestWordOnly)
 	}
 
 	// Sort by score descending
@@ -140,6 +654,27 @@ func (s *Service) ScoreMultipleBoards(boards []*model.Board) []model.BoardScore
 	return scores
 }
 
+// AggregateWords counts, for each word scored across the given boards, how
+// many boards it scored on. A word repeated on one board counts once.
+func (s *Service) AggregateWords(scores []model.BoardScore) map[string]model.WordFrequency {
+	result := make(map[string]model.WordFrequency)
+	for _, score := range scores {
+		seen := make(map[string]bool, len(score.Words))
+		for _, w := range score.Words {
+			freq := result[w.Word]
+			freq.Score = max(freq.Score, w.Score)
+			if !seen[w.Word] {
+				seen[w.Word] = true
+				freq.Word = w.Word
+				freq.Count++
+				freq.Players = append(freq.Players, score.PlayerID)
+			}
+			result[w.Word] = freq
+		}
+	}
+	return result
+}
+
 // DetermineWinner returns the winner's PlayerID, or empty string if tie
 func (s *Service) DetermineWinner(scores []model.BoardScore) model.PlayerID {
 	if len(scores) == 0 {
@@ -161,11 +696,115 @@ func (s *Service) DetermineWinner(scores []model.BoardScore) model.PlayerID {
 	return scores[0].PlayerID
 }
 
+// ResolveWinner determines the winner using the configured tie-break strategy
+// scores must be sorted by total score descending. tied reports whether the top
+// score was shared, even if the tie-break named a winner.
+func (s *Service) ResolveWinner(scores []model.BoardScore, latency map[model.PlayerID]time.Duration) (winner model.PlayerID, tied bool) {
+	if len(scores) == 0 {
+		return "", false
+	}
+
+	var tiedPlayers []model.PlayerID
+	for _, score := range scores {
+		if score.TotalScore == scores[0].TotalScore {
+			tiedPlayers = append(tiedPlayers, score.PlayerID)
+		}
+	}
+	if len(tiedPlayers) == 1 {
+		return tiedPlayers[0], false
+	}
+
+	if s.config.TieBreak != TieBreakSpeed {
+		return "", true
+	}
+
+	// Fastest placer wins, unless they are themselves tied on latency
+	fastest := tiedPlayers[0]
+	unique := true
+	for _, playerID := range tiedPlayers[1:] {
+		switch {
+		case latency[playerID] < latency[fastest]:
+			fastest = playerID
+			unique = true
+		case latency[playerID] == latency[fastest]:
+			unique = false
+		}
+	}
+	if !unique {
+		return "", true
+	}
+	return fastest, true
+}
+
+// FormatResult describes the outcome of a game in one line, e.g. "Alice
+// wins with 24 points; runner-up Bob 20". scores must be sorted by total
+// score descending. Players missing from playerNames are shown by ID.
+func (s *Service) FormatResult(scores []model.BoardScore, playerNames map[model.PlayerID]string) string {
+	if len(scores) == 0 {
+		return "No scores"
+	}
+
+	name := func(playerID model.PlayerID) string {
+		if n := playerNames[playerID]; n != "" {
+			return n
+		}
+		return string(playerID)
+	}
+	// sharing returns the names of the players on scores[from]'s score
+	sharing := func(from int) []string {
+		var names []string
+		for _, score := range scores[from:] {
+			if score.TotalScore != scores[from].TotalScore {
+				break
+			}
+			names = append(names, name(score.PlayerID))
+		}
+		return names
+	}
+
+	top := sharing(0)
+	if len(scores) == 1 {
+		return fmt.Sprintf("%s finishes with %s", top[0], points(scores[0].TotalScore))
+	}
+	if len(top) > 1 {
+		return fmt.Sprintf("%s tie with %s", joinNames(top), points(scores[0].TotalScore))
+	}
+
+	result := fmt.Sprintf("%s wins with %s", top[0], points(scores[0].TotalScore))
+	runnersUp := sharing(1)
+	label := "runner-up"
+	if len(runnersUp) > 1 {
+		label = "runners-up"
+	}
+	return fmt.Sprintf("%s; %s %s %d", result, label, joinNames(runnersUp), scores[1].TotalScore)
+}
+
+// points formats a score with its unit, e.g. "1 point" or "24 points"
+func points(score int) string {
+	if score == 1 {
+		return "1 point"
+	}
+	return fmt.Sprintf("%d points", score)
+}
+
+// joinNames lists names as "A", "A and B" or "A, B and C"
+func joinNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
 // Interface for dependency injection
 type ServiceInterface interface {
 	ScoreBoard(board *model.Board) *model.BoardScore
+	ScoreBoardWithOptions(board *model.Board, opts Options) *model.BoardScore
+	ExplainBoard(board *model.Board, opts Options) *model.ScoreExplanation
 	ScoreMultipleBoards(boards []*model.Board) []model.BoardScore
+	ScoreMultipleBoardsWithOptions(boards []*model.Board, opts Options) []model.BoardScore
 	DetermineWinner(scores []model.BoardScore) model.PlayerID
+	ResolveWinner(scores []model.BoardScore, latency map[model.PlayerID]time.Duration) (model.PlayerID, bool)
+	FormatResult(scores []model.BoardScore, playerNames map[model.PlayerID]string) string
 }
 
 var _ ServiceInterface = (*Service)(nil)
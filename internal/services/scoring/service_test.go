@@ -2,6 +2,7 @@ package scoring
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -23,8 +24,8 @@ func TestServiceSuite(t *testing.T) {
 
 func (s *ServiceSuite) SetupTest() {
 	storage := memory.New()
-	s.dictService = dictionary.New(storage, testutil.NopLogger())
-	s.service = New(s.dictService)
+	s.dictService = dictionary.New(storage, model.DefaultAlphabet(), testutil.NopLogger())
+	s.service = New(s.dictService, DefaultConfig())
 }
 
 func (s *ServiceSuite) loadDictionary(words []string) {
@@ -45,6 +46,39 @@ func (s *ServiceSuite) createBoard(size int, rows ...string) *model.Board {
 	return board
 }
 
+func (s *ServiceSuite) TestCheckDictionaryFailsWhenNotLoaded() {
+	s.ErrorIs(s.service.CheckDictionary(), model.ErrDictionaryNotLoaded)
+}
+
+func (s *ServiceSuite) TestCheckDictionaryFailsWhenEmpty() {
+	s.loadDictionary([]string{})
+	s.ErrorIs(s.service.CheckDictionary(), model.ErrDictionaryNotLoaded)
+}
+
+func (s *ServiceSuite) TestCheckDictionarySucceedsWhenLoaded() {
+	s.loadDictionary([]string{"cat"})
+	s.NoError(s.service.CheckDictionary())
+}
+
+func (s *ServiceSuite) TestRulesReflectConfigAndOptions() {
+	cfg := Config{IsolatedCellPenalty: 1, TieBreak: TieBreakSpeed, SymmetryBonus: 3, PerfectBoardBonus: 4, UniqueWordBonus: 2, DedupeWords: true, BestWordOnly: true}
+	rules := cfg.Rules(Options{RequireEdgeAnchored: true})
+
+	s.Equal(2, rules.MinWordLength)
+	s.Equal(2, rules.FullLineMultiplier)
+	s.False(rules.Diagonals)
+	s.True(rules.RequireEdgeAnchored)
+	s.Equal(1, rules.IsolatedCellPenalty)
+	s.Equal(3, rules.SymmetryBonus)
+	s.Equal(4, rules.PerfectBoardBonus)
+	s.Equal(2, rules.UniqueWordBonus)
+	s.True(rules.DedupeWords)
+	s.True(rules.BestWordOnly)
+	s.Equal(TieBreakSpeed, rules.TieBreak)
+
+	s.Equal(TieBreakNone, Config{}.Rules(Options{}).TieBreak)
+}
+
 // Basic scoring tests
 
 func (s *ServiceSuite) TestScoreEmptyBoard() {
@@ -265,6 +299,183 @@ func (s *ServiceSuite) TestDetermineWinnerEmpty() {
 	s.Empty(winner)
 }
 
+// AggregateWords tests
+
+func (s *ServiceSuite) TestAggregateWordsCountsEachBoardOnce() {
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", Words: []model.WordMatch{{Word: "CAT", Score: 6}, {Word: "AT", Score: 2}, {Word: "AT", Score: 2}}},
+		{PlayerID: "player-2", Words: []model.WordMatch{{Word: "CAT", Score: 3}}},
+		{PlayerID: "player-3", Words: []model.WordMatch{}},
+	}
+
+	words := s.service.AggregateWords(scores)
+
+	s.Len(words, 2)
+	s.Equal(2, words["CAT"].Count)
+	s.Equal(6, words["CAT"].Score)
+	s.Equal([]model.PlayerID{"player-1", "player-2"}, words["CAT"].Players)
+	s.Equal(1, words["AT"].Count)
+}
+
+// Edge anchoring tests
+
+func (s *ServiceSuite) TestFloatingWordScoresByDefault() {
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(5,
+		".....",
+		".CAT.",
+		".....",
+		".....",
+		".....",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Len(result.Words, 1)
+	s.Equal(3, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestRequireEdgeAnchoredIgnoresFloatingWord() {
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(5,
+		".....",
+		".CAT.",
+		".....",
+		".....",
+		".....",
+	)
+
+	result := s.service.ScoreBoardWithOptions(board, Options{RequireEdgeAnchored: true})
+
+	s.Empty(result.Words)
+	s.Equal(0, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestRequireEdgeAnchoredCountsWordsTouchingEdge() {
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(5,
+		".....",
+		"..CAT",
+		".....",
+		".....",
+		".CAT.",
+	)
+
+	result := s.service.ScoreBoardWithOptions(board, Options{RequireEdgeAnchored: true})
+
+	// One word ends at the right edge, the other lies along the bottom row
+	s.Len(result.Words, 2)
+	s.Equal(6, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestRequireEdgeAnchoredVerticalWords() {
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(5,
+		".C...",
+		".A.C.",
+		".T.A.",
+		"...T.",
+		".....",
+	)
+
+	result := s.service.ScoreBoardWithOptions(board, Options{RequireEdgeAnchored: true})
+
+	// Only the column starting at the top edge counts
+	s.Require().Len(result.Words, 1)
+	s.Equal(model.Position{Row: 0, Col: 1}, result.Words[0].StartPos)
+	s.False(result.Words[0].Horizontal)
+}
+
+// ResolveWinner tests
+
+func (s *ServiceSuite) TestFormatResultClearWinner() {
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 24},
+		{PlayerID: "player-2", TotalScore: 20},
+		{PlayerID: "player-3", TotalScore: 20},
+		{PlayerID: "player-4", TotalScore: 3},
+	}
+	names := map[model.PlayerID]string{"player-1": "Alice", "player-2": "Bob", "player-3": "Carol"}
+
+	s.Equal("Alice wins with 24 points; runners-up Bob and Carol 20", s.service.FormatResult(scores, names))
+	s.Equal("Alice wins with 24 points; runner-up Bob 20", s.service.FormatResult(scores[:2], names))
+}
+
+func (s *ServiceSuite) TestFormatResultTie() {
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 20},
+		{PlayerID: "player-2", TotalScore: 20},
+		{PlayerID: "player-3", TotalScore: 20},
+		{PlayerID: "player-4", TotalScore: 12},
+	}
+	names := map[model.PlayerID]string{"player-1": "Alice", "player-2": "Bob"}
+
+	// Players without a name are shown by ID
+	s.Equal("Alice, Bob and player-3 tie with 20 points", s.service.FormatResult(scores, names))
+}
+
+func (s *ServiceSuite) TestFormatResultSinglePlayer() {
+	names := map[model.PlayerID]string{"player-1": "Alice"}
+
+	s.Equal("Alice finishes with 1 point", s.service.FormatResult([]model.BoardScore{{PlayerID: "player-1", TotalScore: 1}}, names))
+	s.Equal("No scores", s.service.FormatResult(nil, names))
+}
+
+func (s *ServiceSuite) TestResolveWinnerTieWithoutTieBreak() {
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 20},
+		{PlayerID: "player-2", TotalScore: 20},
+	}
+	latency := map[model.PlayerID]time.Duration{"player-1": 5 * time.Second, "player-2": time.Second}
+
+	winner, tied := s.service.ResolveWinner(scores, latency)
+	s.Empty(winner)
+	s.True(tied)
+}
+
+func (s *ServiceSuite) TestResolveWinnerSpeedTieBreakPicksFasterPlacer() {
+	s.service = New(s.dictService, Config{TieBreak: TieBreakSpeed})
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 20},
+		{PlayerID: "player-2", TotalScore: 20},
+		{PlayerID: "player-3", TotalScore: 10},
+	}
+	latency := map[model.PlayerID]time.Duration{
+		"player-1": 5 * time.Second,
+		"player-2": time.Second,
+		"player-3": 0,
+	}
+
+	winner, tied := s.service.ResolveWinner(scores, latency)
+	s.Equal(model.PlayerID("player-2"), winner)
+	s.True(tied)
+}
+
+func (s *ServiceSuite) TestResolveWinnerSpeedTieBreakWithEqualLatency() {
+	s.service = New(s.dictService, Config{TieBreak: TieBreakSpeed})
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 20},
+		{PlayerID: "player-2", TotalScore: 20},
+	}
+	latency := map[model.PlayerID]time.Duration{"player-1": time.Second, "player-2": time.Second}
+
+	winner, tied := s.service.ResolveWinner(scores, latency)
+	s.Empty(winner)
+	s.True(tied)
+}
+
+func (s *ServiceSuite) TestResolveWinnerClearWinnerIsNotTied() {
+	s.service = New(s.dictService, Config{TieBreak: TieBreakSpeed})
+	scores := []model.BoardScore{
+		{PlayerID: "player-1", TotalScore: 20},
+		{PlayerID: "player-2", TotalScore: 15},
+	}
+
+	winner, tied := s.service.ResolveWinner(scores, nil)
+	s.Equal(model.PlayerID("player-1"), winner)
+	s.False(tied)
+}
+
 // Edge cases
 
 func (s *ServiceSuite) TestScoreDictionaryNotLoaded() {
@@ -305,3 +516,417 @@ func (s *ServiceSuite) TestScoreNoValidWords() {
 	s.Empty(result.Words)
 	s.Equal(0, result.TotalScore)
 }
+
+// Isolated cell penalty tests
+
+func (s *ServiceSuite) TestIsolatedCellPenaltyDefaultsToZero() {
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(3,
+		"CAT",
+		"XQZ",
+		"JKV",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(0, result.Penalty)
+	s.Equal(6, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestIsolatedCellPenaltyAppliesToJunkLetters() {
+	s.service = New(s.dictService, Config{IsolatedCellPenalty: 1})
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(3,
+		"CAT",
+		"AQZ",
+		"TKV",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	// CAT across row 0 and down col 0 (6 each); Q, Z, K, V are isolated
+	s.Equal(4, result.IsolatedCells)
+	s.Equal(4, result.Penalty)
+	s.Equal(12-4, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestIsolatedCellPenaltyIgnoresEmptyCells() {
+	s.service = New(s.dictService, Config{IsolatedCellPenalty: 2})
+	s.loadDictionary([]string{"cat"})
+	board := s.createBoard(3,
+		"CAT",
+		"Q..",
+		"...",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(1, result.IsolatedCells)
+	s.Equal(2, result.Penalty)
+	s.Equal(4, result.TotalScore)
+}
+
+// Symmetry bonus tests
+
+func (s *ServiceSuite) TestSymmetryBonusDefaultsToZero() {
+	s.loadDictionary([]string{"tot", "ere"})
+	board := s.createBoard(3,
+		"TOT",
+		"ERE",
+		"TOT",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(0, result.SymmetryBonus)
+	s.Equal(18, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestSymmetryBonusAwardedForPalindromicBoard() {
+	s.service = New(s.dictService, Config{SymmetryBonus: 5})
+	s.loadDictionary([]string{"tot", "ere"})
+	board := s.createBoard(3,
+		"TOT",
+		"ERE",
+		"TOT",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	// Three full-line row words (6 each) plus the bonus
+	s.Equal(5, result.SymmetryBonus)
+	s.Equal(18+5, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestSymmetryBonusNotAwardedForAsymmetricBoard() {
+	s.service = New(s.dictService, Config{SymmetryBonus: 5})
+	s.loadDictionary([]string{"tot", "cat"})
+	board := s.createBoard(3,
+		"TOT",
+		"CAT",
+		"TOT",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(0, result.SymmetryBonus)
+}
+
+func (s *ServiceSuite) TestSymmetryBonusRequiresFullBoard() {
+	s.service = New(s.dictService, Config{SymmetryBonus: 5})
+	s.loadDictionary([]string{"tot"})
+	board := s.createBoard(3,
+		"TOT",
+		"...",
+		"...",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(0, result.SymmetryBonus)
+}
+
+// Perfect board bonus tests
+
+func (s *ServiceSuite) TestPerfectBoardBonusAwardedWhenEveryLineIsAWord() {
+	s.service = New(s.dictService, Config{PerfectBoardBonus: 10})
+	s.loadDictionary([]string{"cat", "are", "ten"})
+	board := s.createBoard(3,
+		"CAT",
+		"ARE",
+		"TEN",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	// Six full-line words (6 each) plus the bonus
+	s.Equal(10, result.PerfectBonus)
+	s.Equal(36+10, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestPerfectBoardBonusNotAwardedWithAnInvalidLine() {
+	s.service = New(s.dictService, Config{PerfectBoardBonus: 10})
+	s.loadDictionary([]string{"cat", "are", "ten"})
+	board := s.createBoard(3,
+		"CAT",
+		"ARE",
+		"TEX",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(0, result.PerfectBonus)
+}
+
+// Unique word bonus tests
+
+func (s *ServiceSuite) TestUniqueWordBonusOffByDefault() {
+	s.loadDictionary([]string{"cat", "dog"})
+	board1 := s.createBoard(3, "DOG", "...", "...")
+	board2 := s.createBoard(3, "CAT", "...", "...")
+	board2.PlayerID = "player-2"
+
+	scores := s.service.ScoreMultipleBoards([]*model.Board{board1, board2})
+
+	for _, score := range scores {
+		s.Equal(0, score.UniqueWords)
+		s.Equal(0, score.UniqueBonus)
+		s.Equal(6, score.TotalScore)
+	}
+}
+
+func (s *ServiceSuite) TestUniqueWordBonusOnlyForWordsNobodyElseFound() {
+	s.service = New(s.dictService, Config{UniqueWordBonus: 4})
+	s.loadDictionary([]string{"cat", "dog"})
+	board1 := s.createBoard(3,
+		"CAT",
+		"...",
+		"DOG",
+	)
+	board2 := s.createBoard(3,
+		"CAT",
+		"...",
+		"...",
+	)
+	board2.PlayerID = "player-2"
+
+	scores := s.service.ScoreMultipleBoards([]*model.Board{board1, board2})
+
+	s.Require().Len(scores, 2)
+	// Only player-1 spelled DOG; both spelled CAT
+	s.Equal(model.PlayerID("player-1"), scores[0].PlayerID)
+	s.Equal(1, scores[0].UniqueWords)
+	s.Equal(4, scores[0].UniqueBonus)
+	s.Equal(12+4, scores[0].TotalScore)
+	s.Equal(0, scores[1].UniqueWords)
+	s.Equal(0, scores[1].UniqueBonus)
+	s.Equal(6, scores[1].TotalScore)
+}
+
+func (s *ServiceSuite) TestUniqueWordBonusOnlyCountsBestWords() {
+	s.service = New(s.dictService, Config{UniqueWordBonus: 4, BestWordOnly: true})
+	s.loadDictionary([]string{"cat", "at"})
+	board1 := s.createBoard(3,
+		"AT.",
+		"...",
+		"...",
+	)
+	board2 := s.createBoard(3,
+		"CAT",
+		"...",
+		"AT.",
+	)
+	board2.PlayerID = "player-2"
+
+	scores := s.service.ScoreMultipleBoards([]*model.Board{board1, board2})
+
+	// player-2's AT isn't scored, so player-1's AT is still unique
+	s.Require().Len(scores, 2)
+	for _, score := range scores {
+		s.Equal(1, score.UniqueWords, score.PlayerID)
+		s.Equal(4, score.UniqueBonus, score.PlayerID)
+	}
+}
+
+// Dedupe words tests
+
+func (s *ServiceSuite) TestRepeatedWordsScoreEachTimeByDefault() {
+	s.loadDictionary([]string{"at"})
+	board := s.createBoard(4,
+		"AT..",
+		"....",
+		"AT..",
+		"....",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Len(result.Words, 2)
+	s.Equal(4, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestDedupeWordsScoresRepeatedWordOnce() {
+	s.service = New(s.dictService, Config{DedupeWords: true})
+	s.loadDictionary([]string{"at"})
+	board := s.createBoard(4,
+		"AT..",
+		"....",
+		"AT..",
+		"....",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	// Both instances are reported, but only the first counts
+	s.Require().Len(result.Words, 2)
+	s.False(result.Words[0].Deduped)
+	s.True(result.Words[1].Deduped)
+	s.Equal(2, result.TotalScore)
+}
+
+// Best word only tests
+
+func (s *ServiceSuite) TestBestWordOnlyScoresHighestWord() {
+	s.service = New(s.dictService, Config{BestWordOnly: true})
+	s.loadDictionary([]string{"cat", "at"})
+	board := s.createBoard(3,
+		"CAT",
+		".T.",
+		"...",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	// CAT fills its row for 6, AT scores 2; only CAT counts
+	s.Require().Len(result.Words, 2)
+	s.Equal("CAT", result.Words[0].Word)
+	s.True(result.Words[0].Best)
+	s.False(result.Words[1].Best)
+	s.Equal(6, result.TotalScore)
+}
+
+func (s *ServiceSuite) TestBestWordOnlyOffByDefault() {
+	s.loadDictionary([]string{"cat", "at"})
+	board := s.createBoard(3,
+		"CAT",
+		".T.",
+		"...",
+	)
+
+	result := s.service.ScoreBoard(board)
+
+	s.Equal(8, result.TotalScore)
+	for _, w := range result.Words {
+		s.False(w.Best)
+	}
+}
+
+// Word value tests
+
+func (s *ServiceSuite) TestTileValueScoresRareLettersHigher() {
+	s.service = New(s.dictService, Config{WordValue: WordValueTile, TileValues: DefaultTileValues()})
+	s.loadDictionary([]string{"qi", "to"})
+
+	qi := s.service.ScoreBoard(s.createBoard(3, "QI.", "...", "..."))
+	to := s.service.ScoreBoard(s.createBoard(3, "TO.", "...", "..."))
+
+	// Q is worth 10 and I 1, against 1 each for T and O
+	s.Require().Len(qi.Words, 1)
+	s.Equal(11, qi.Words[0].TilePoints)
+	s.Equal(11, qi.TotalScore)
+	s.Equal(2, to.TotalScore)
+	s.Greater(qi.TotalScore, to.TotalScore)
+}
+
+func (s *ServiceSuite) TestTileLengthMultipliesTilesByLength() {
+	s.service = New(s.dictService, Config{WordValue: WordValueTileLength, TileValues: DefaultTileValues()})
+	s.loadDictionary([]string{"qi", "cat"})
+
+	result := s.service.ScoreBoard(s.createBoard(3, "QI.", "...", "CAT"))
+
+	// QI: 11 x2 letters; CAT: 5 x3 letters, doubled for filling its row
+	s.Equal(22+30, result.TotalScore)
+	explanation := s.service.ExplainBoard(s.createBoard(3, "QI.", "...", "CAT"), Options{})
+	s.Contains(explanation.Steps[0].Description, "11 tile points x2 letters")
+}
+
+func (s *ServiceSuite) TestLengthValueByDefault() {
+	s.loadDictionary([]string{"qi"})
+
+	result := s.service.ScoreBoard(s.createBoard(3, "QI.", "...", "..."))
+
+	s.Equal(2, result.TotalScore)
+	s.Zero(result.Words[0].TilePoints)
+	s.Equal(WordValueLength, Config{}.Rules(Options{}).WordValue)
+}
+
+// Blocked cell tests
+
+func (s *ServiceSuite) TestBlockedCellSplitsRowIntoSegments() {
+	s.service = New(s.dictService, Config{IsolatedCellPenalty: 1})
+	s.loadDictionary([]string{"at", "go", "atego"})
+
+	board := s.createBoard(5, ".....", ".....", "ATEGO", ".....", ".....")
+	board.Block([]model.Position{{Row: 2, Col: 2}})
+	result := s.service.ScoreBoard(board)
+
+	// ATEGO no longer fits across the block, leaving AT and GO either side
+	s.Require().Len(result.Words, 2)
+	s.Equal("AT", result.Words[0].Word)
+	s.Equal(model.Position{Row: 2, Col: 0}, result.Words[0].StartPos)
+	s.Equal("GO", result.Words[1].Word)
+	s.Equal(model.Position{Row: 2, Col: 3}, result.Words[1].StartPos)
+	s.Equal(4, result.TotalScore)
+	s.Zero(result.IsolatedCells) // The block itself isn't a stray letter
+}
+
+// Cell highlight tests
+
+func (s *ServiceSuite) TestCellWordsMarkWordCells() {
+	s.loadDictionary([]string{"cat"})
+
+	result := s.service.ScoreBoard(s.createBoard(5, "CAT..", ".....", ".....", ".....", "....."))
+
+	s.Equal([]model.CellWord{
+		{Pos: model.Position{Row: 0, Col: 0}, Word: "CAT", Horizontal: true},
+		{Pos: model.Position{Row: 0, Col: 1}, Word: "CAT", Horizontal: true},
+		{Pos: model.Position{Row: 0, Col: 2}, Word: "CAT", Horizontal: true},
+	}, result.CellWords())
+}
+
+func (s *ServiceSuite) TestCellWordsSkipUncountedWords() {
+	s.service = New(s.dictService, Config{DedupeWords: true})
+	s.loadDictionary([]string{"at"})
+
+	result := s.service.ScoreBoard(s.createBoard(3, "AT.", "...", "AT."))
+
+	// The repeated AT on the last row doesn't score, so isn't highlighted
+	cells := result.CellWords()
+	s.Require().Len(cells, 2)
+	s.Equal(0, cells[0].Pos.Row)
+	s.Equal(0, cells[1].Pos.Row)
+}
+
+// Explain tests
+
+func (s *ServiceSuite) TestExplainBoardReportsSubsumedWords() {
+	s.loadDictionary([]string{"cat", "at"})
+	board := s.createBoard(3, "CAT", "...", "...")
+
+	explanation := s.service.ExplainBoard(board, Options{})
+
+	s.Equal(6, explanation.Score.TotalScore)
+	s.Require().Len(explanation.Candidates, 2)
+	cat, at := explanation.Candidates[0], explanation.Candidates[1]
+	s.Equal("CAT", cat.Word)
+	s.Equal(model.CandidateScored, cat.Outcome)
+	s.Equal([]model.Position{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 0, Col: 2}}, cat.Cells)
+	s.Equal("AT", at.Word)
+	s.Equal(model.CandidateSubsumed, at.Outcome)
+	s.Equal("CAT", at.SubsumedBy)
+
+	s.Require().Len(explanation.Steps, 1)
+	s.Equal(6, explanation.Steps[0].Points)
+	s.Contains(explanation.Steps[0].Description, "fills the line")
+}
+
+func (s *ServiceSuite) TestExplainBoardStepsMatchTotal() {
+	s.loadDictionary([]string{"cat", "at"})
+	s.service = New(s.dictService, Config{IsolatedCellPenalty: 1, DedupeWords: true})
+	board := s.createBoard(4, "CAT.", ".AT.", "CAT.", "...Q")
+
+	explanation := s.service.ExplainBoard(board, Options{RequireEdgeAnchored: true})
+
+	s.Require().NotEmpty(explanation.Steps)
+	s.Equal(explanation.Score.TotalScore, explanation.Steps[len(explanation.Steps)-1].Total)
+
+	// The AT in the second row floats away from the edge
+	var floating *model.WordCandidate
+	for i, c := range explanation.Candidates {
+		if c.Word == "AT" && c.StartPos == (model.Position{Row: 1, Col: 1}) {
+			floating = &explanation.Candidates[i]
+		}
+	}
+	s.Require().NotNil(floating)
+	s.Equal(model.CandidateNotAnchored, floating.Outcome)
+}
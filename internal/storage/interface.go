@@ -8,6 +8,9 @@ import (
 
 // Storage defines the interface for data persistence
 type Storage interface {
+	// Ping checks the backend is reachable
+	Ping(ctx context.Context) error
+
 	// Player operations
 	SavePlayer(ctx context.Context, player *model.Player) error
 	GetPlayer(ctx context.Context, id model.PlayerID) (*model.Player, error)
@@ -17,18 +20,45 @@ type Storage interface {
 	SaveRegisteredPlayer(ctx context.Context, rp *model.RegisteredPlayer) error
 	GetRegisteredPlayer(ctx context.Context, playerID model.PlayerID) (*model.RegisteredPlayer, error)
 	GetRegisteredPlayerByUsername(ctx context.Context, username string) (*model.RegisteredPlayer, error)
+	DeleteRegisteredPlayer(ctx context.Context, playerID model.PlayerID) error
+
+	// Player stats operations
+	// RecordPlayerGameResult atomically adds a completed game to a player's stats
+	RecordPlayerGameResult(ctx context.Context, playerID model.PlayerID, result model.PlayerGameResult) error
+	// GetPlayerStats returns zeroed stats if the player has none recorded
+	GetPlayerStats(ctx context.Context, playerID model.PlayerID) (*model.PlayerStats, error)
 
 	// Lobby operations
+	// CreateLobby saves a new lobby, checking its code is unused in the same
+	// step. Returns ErrLobbyCodeTaken if it is taken
+	CreateLobby(ctx context.Context, lobby *model.Lobby) error
 	SaveLobby(ctx context.Context, lobby *model.Lobby) error
 	GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lobby, error)
 	DeleteLobby(ctx context.Context, code model.LobbyCode) error
+	// RenameLobby moves a lobby and its events to newCode and repoints its
+	// members' lobby index in one atomic step. check is called with the lobby
+	// as stored at that moment, and an error from it aborts the rename.
+	// Returns ErrLobbyNotFound if oldCode doesn't exist and ErrLobbyCodeTaken
+	// if newCode does
+	RenameLobby(ctx context.Context, oldCode, newCode model.LobbyCode, check func(*model.Lobby) error) error
 	LobbyExists(ctx context.Context, code model.LobbyCode) (bool, error)
+	// GetLobbyForPlayer returns the lobby the player is a member of, or an
+	// empty code if there is none
 	GetLobbyForPlayer(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, error)
+	CountLobbies(ctx context.Context) (int, error)
+
+	// Lobby event operations
+	AppendLobbyEvent(ctx context.Context, event *model.Event) error
+	GetLobbyEvents(ctx context.Context, code model.LobbyCode) ([]*model.Event, error)
 
 	// Game operations
+	// CreateGame saves a new game, checking its ID is unused in the same
+	// step. Returns ErrGameExists if it is taken
+	CreateGame(ctx context.Context, game *model.Game) error
 	SaveGame(ctx context.Context, game *model.Game) error
 	GetGame(ctx context.Context, id model.GameID) (*model.Game, error)
 	DeleteGame(ctx context.Context, id model.GameID) error
+	CountGames(ctx context.Context) (int, error)
 
 	// Board operations
 	SaveBoard(ctx context.Context, board *model.Board) error
@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
+
+// SnapshotConfig controls persisting the in-memory store to a JSON file, so
+// single-instance deployments without Redis survive restarts
+type SnapshotConfig struct {
+	// Path is the snapshot file; empty disables snapshots
+	Path string
+	// Interval is how often to save a snapshot while running (0 only saves
+	// when explicitly asked, e.g. on shutdown)
+	Interval time.Duration
+
+	// Entries older than these TTLs, matching the Redis TTLs, are dropped
+	// when a snapshot is loaded (0 keeps them)
+	GuestPlayerTTL time.Duration
+	LobbyTTL       time.Duration
+	GameTTL        time.Duration
+}
+
+// DefaultSnapshotConfig returns snapshot defaults, with the same TTLs as
+// the Redis storage. Path is left empty, so snapshots are off.
+func DefaultSnapshotConfig() SnapshotConfig {
+	return SnapshotConfig{
+		Interval:       time.Minute,
+		GuestPlayerTTL: 24 * time.Hour,
+		LobbyTTL:       24 * time.Hour,
+		GameTTL:        24 * time.Hour,
+	}
+}
+
+// snapshot is the on-disk form of the store. The dictionary isn't included,
+// since it is reloaded from its word list on startup.
+type snapshot struct {
+	SavedAt           time.Time
+	Players           []*model.Player
+	RegisteredPlayers []*model.RegisteredPlayer
+	PlayerStats       []*model.PlayerStats
+	Lobbies           []*model.Lobby
+	LobbyEvents       map[model.LobbyCode][]*model.Event
+	Games             []*model.Game
+	Boards            []*model.Board
+}
+
+// SaveSnapshot writes every entity in the store to path as JSON. The file
+// is replaced atomically, so a crash mid-save leaves the previous snapshot.
+// The stored values are the store's own copies, only changed under the
+// write lock, so encoding them under the read lock sees a consistent state.
+func (s *Storage) SaveSnapshot(path string) error {
+	s.mu.RLock()
+	snap := snapshot{
+		SavedAt:     time.Now(),
+		LobbyEvents: s.lobbyEvents,
+	}
+	for _, p := range s.players {
+		snap.Players = append(snap.Players, p)
+	}
+	for _, rp := range s.registeredPlayers {
+		snap.RegisteredPlayers = append(snap.RegisteredPlayers, rp)
+	}
+	for _, stats := range s.playerStats {
+		snap.PlayerStats = append(snap.PlayerStats, stats)
+	}
+	for _, l := range s.lobbies {
+		snap.Lobbies = append(snap.Lobbies, l)
+	}
+	for _, g := range s.games {
+		snap.Games = append(snap.Games, g)
+	}
+	for _, b := range s.boards {
+		snap.Boards = append(snap.Boards, b)
+	}
+	data, err := json.Marshal(snap)
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadSnapshot replaces the store's contents with the snapshot at path,
+// dropping guest players, lobbies and games that would have expired by now
+// under cfg's TTLs, along with the events and boards that belong to them.
+// A missing file is not an error, so the first start begins empty.
+func (s *Storage) LoadSnapshot(path string, cfg SnapshotConfig, now time.Time) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return err
+	}
+
+	expired := func(at time.Time, ttl time.Duration) bool {
+		return ttl > 0 && now.Sub(at) >= ttl
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.players = make(map[model.PlayerID]*model.Player)
+	s.registeredPlayers = make(map[model.PlayerID]*model.RegisteredPlayer)
+	s.usernameIndex = make(map[string]model.PlayerID)
+	s.playerStats = make(map[model.PlayerID]*model.PlayerStats)
+	s.lobbies = make(map[model.LobbyCode]*model.Lobby)
+	s.lobbyEvents = make(map[model.LobbyCode][]*model.Event)
+	s.games = make(map[model.GameID]*model.Game)
+	s.boards = make(map[boardKey]*model.Board)
+
+	for _, p := range snap.Players {
+		if p.IsGuest && expired(p.CreatedAt, cfg.GuestPlayerTTL) {
+			continue
+		}
+		s.players[p.ID] = p
+	}
+	for _, rp := range snap.RegisteredPlayers {
+		s.registeredPlayers[rp.PlayerID] = rp
+		s.usernameIndex[rp.Username] = rp.PlayerID
+	}
+	for _, stats := range snap.PlayerStats {
+		s.playerStats[stats.PlayerID] = stats
+	}
+	for _, l := range snap.Lobbies {
+		if expired(l.UpdatedAt, cfg.LobbyTTL) {
+			continue
+		}
+		s.lobbies[l.Code] = l
+		if events, ok := snap.LobbyEvents[l.Code]; ok {
+			s.lobbyEvents[l.Code] = events
+		}
+	}
+	for _, g := range snap.Games {
+		if expired(g.UpdatedAt, cfg.GameTTL) {
+			continue
+		}
+		s.games[g.ID] = g
+	}
+	for _, b := range snap.Boards {
+		if _, ok := s.games[b.GameID]; !ok {
+			continue
+		}
+		s.boards[boardKey{gameID: b.GameID, playerID: b.PlayerID}] = b
+	}
+	return nil
+}
+
+// RunSnapshots saves a snapshot to cfg.Path every cfg.Interval until ctx is
+// done. Failures are logged and retried at the next interval.
+func (s *Storage) RunSnapshots(ctx context.Context, cfg SnapshotConfig, logger *slog.Logger) {
+	if cfg.Path == "" || cfg.Interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.SaveSnapshot(cfg.Path); err != nil {
+				logger.Error("failed to save memory snapshot",
+					slog.String("path", cfg.Path),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
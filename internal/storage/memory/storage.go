@@ -2,20 +2,26 @@ package memory
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/storage"
 )
 
-// Storage is an in-memory implementation of the storage interface
+// Storage is an in-memory implementation of the storage interface.
+// Players, lobbies, games and boards are copied in and out, like the Redis storage
+// round-trips them, so callers mutating what they loaded never touch the
+// stored values outside the lock.
 type Storage struct {
 	mu sync.RWMutex
 
 	players           map[model.PlayerID]*model.Player
 	registeredPlayers map[model.PlayerID]*model.RegisteredPlayer
 	usernameIndex     map[string]model.PlayerID
+	playerStats       map[model.PlayerID]*model.PlayerStats
 	lobbies           map[model.LobbyCode]*model.Lobby
+	lobbyEvents       map[model.LobbyCode][]*model.Event
 	games             map[model.GameID]*model.Game
 	boards            map[boardKey]*model.Board
 	dictionaryWords   []string
@@ -32,21 +38,45 @@ func New() *Storage {
 		players:           make(map[model.PlayerID]*model.Player),
 		registeredPlayers: make(map[model.PlayerID]*model.RegisteredPlayer),
 		usernameIndex:     make(map[string]model.PlayerID),
+		playerStats:       make(map[model.PlayerID]*model.PlayerStats),
 		lobbies:           make(map[model.LobbyCode]*model.Lobby),
+		lobbyEvents:       make(map[model.LobbyCode][]*model.Event),
 		games:             make(map[model.GameID]*model.Game),
 		boards:            make(map[boardKey]*model.Board),
 	}
 }
 
+// clone deep-copies v through its JSON form
+func clone[T any](v *T) (*T, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var c T
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
 // Ensure Storage implements the interface
 var _ storage.Storage = (*Storage)(nil)
 
+// Ping always succeeds, since in-memory storage is always reachable
+func (s *Storage) Ping(ctx context.Context) error {
+	return nil
+}
+
 // Player operations
 
 func (s *Storage) SavePlayer(ctx context.Context, player *model.Player) error {
+	stored, err := clone(player)
+	if err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.players[player.ID] = player
+	s.players[player.ID] = stored
 	return nil
 }
 
@@ -57,7 +87,7 @@ func (s *Storage) GetPlayer(ctx context.Context, id model.PlayerID) (*model.Play
 	if !ok {
 		return nil, model.ErrPlayerNotFound
 	}
-	return player, nil
+	return clone(player)
 }
 
 func (s *Storage) DeletePlayer(ctx context.Context, id model.PlayerID) error {
@@ -70,9 +100,13 @@ func (s *Storage) DeletePlayer(ctx context.Context, id model.PlayerID) error {
 // Registered player operations
 
 func (s *Storage) SaveRegisteredPlayer(ctx context.Context, rp *model.RegisteredPlayer) error {
+	stored, err := clone(rp)
+	if err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.registeredPlayers[rp.PlayerID] = rp
+	s.registeredPlayers[rp.PlayerID] = stored
 	s.usernameIndex[rp.Username] = rp.PlayerID
 	return nil
 }
@@ -84,7 +118,7 @@ func (s *Storage) GetRegisteredPlayer(ctx context.Context, playerID model.Player
 	if !ok {
 		return nil, model.ErrPlayerNotFound
 	}
-	return rp, nil
+	return clone(rp)
 }
 
 func (s *Storage) GetRegisteredPlayerByUsername(ctx context.Context, username string) (*model.RegisteredPlayer, error) {
@@ -98,15 +132,77 @@ func (s *Storage) GetRegisteredPlayerByUsername(ctx context.Context, username st
 	if !ok {
 		return nil, model.ErrPlayerNotFound
 	}
-	return rp, nil
+	return clone(rp)
+}
+
+func (s *Storage) DeleteRegisteredPlayer(ctx context.Context, playerID model.PlayerID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rp, ok := s.registeredPlayers[playerID]; ok {
+		delete(s.usernameIndex, rp.Username)
+		delete(s.registeredPlayers, playerID)
+	}
+	delete(s.playerStats, playerID)
+	return nil
+}
+
+// Player stats operations
+
+func (s *Storage) RecordPlayerGameResult(ctx context.Context, playerID model.PlayerID, result model.PlayerGameResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.playerStats[playerID]
+	if !ok {
+		stats = &model.PlayerStats{PlayerID: playerID}
+		s.playerStats[playerID] = stats
+	}
+	stats.GamesPlayed++
+	if result.Won {
+		stats.GamesWon++
+	}
+	stats.TotalScore += result.Score
+	if result.BestWordScore > stats.BestWordScore {
+		stats.BestWord = result.BestWord
+		stats.BestWordScore = result.BestWordScore
+	}
+	return nil
+}
+
+func (s *Storage) GetPlayerStats(ctx context.Context, playerID model.PlayerID) (*model.PlayerStats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stats, ok := s.playerStats[playerID]
+	if !ok {
+		return &model.PlayerStats{PlayerID: playerID}, nil
+	}
+	result := *stats
+	return &result, nil
 }
 
 // Lobby operations
 
+func (s *Storage) CreateLobby(ctx context.Context, lobby *model.Lobby) error {
+	stored, err := clone(lobby)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.lobbies[lobby.Code]; ok {
+		return model.ErrLobbyCodeTaken
+	}
+	s.lobbies[lobby.Code] = stored
+	return nil
+}
+
 func (s *Storage) SaveLobby(ctx context.Context, lobby *model.Lobby) error {
+	stored, err := clone(lobby)
+	if err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.lobbies[lobby.Code] = lobby
+	s.lobbies[lobby.Code] = stored
 	return nil
 }
 
@@ -117,13 +213,42 @@ func (s *Storage) GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lo
 	if !ok {
 		return nil, model.ErrLobbyNotFound
 	}
-	return lobby, nil
+	return clone(lobby)
 }
 
 func (s *Storage) DeleteLobby(ctx context.Context, code model.LobbyCode) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.lobbies, code)
+	delete(s.lobbyEvents, code)
+	return nil
+}
+
+func (s *Storage) RenameLobby(ctx context.Context, oldCode, newCode model.LobbyCode, check func(*model.Lobby) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lobby, ok := s.lobbies[oldCode]
+	if !ok {
+		return model.ErrLobbyNotFound
+	}
+	if _, taken := s.lobbies[newCode]; taken {
+		return model.ErrLobbyCodeTaken
+	}
+	current, err := clone(lobby)
+	if err != nil {
+		return err
+	}
+	if err := check(current); err != nil {
+		return err
+	}
+	lobby.Code = newCode
+	s.lobbies[newCode] = lobby
+	delete(s.lobbies, oldCode)
+
+	if events, ok := s.lobbyEvents[oldCode]; ok {
+		s.lobbyEvents[newCode] = events
+		delete(s.lobbyEvents, oldCode)
+	}
 	return nil
 }
 
@@ -146,12 +271,54 @@ func (s *Storage) GetLobbyForPlayer(ctx context.Context, playerID model.PlayerID
 	return "", nil
 }
 
+func (s *Storage) CountLobbies(ctx context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.lobbies), nil
+}
+
+// Lobby event operations
+
+func (s *Storage) AppendLobbyEvent(ctx context.Context, event *model.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lobbyEvents[event.LobbyCode] = append(s.lobbyEvents[event.LobbyCode], event)
+	return nil
+}
+
+func (s *Storage) GetLobbyEvents(ctx context.Context, code model.LobbyCode) ([]*model.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := s.lobbyEvents[code]
+	result := make([]*model.Event, len(events))
+	copy(result, events)
+	return result, nil
+}
+
 // Game operations
 
+func (s *Storage) CreateGame(ctx context.Context, game *model.Game) error {
+	stored, err := clone(game)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.games[game.ID]; ok {
+		return model.ErrGameExists
+	}
+	s.games[game.ID] = stored
+	return nil
+}
+
 func (s *Storage) SaveGame(ctx context.Context, game *model.Game) error {
+	stored, err := clone(game)
+	if err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.games[game.ID] = game
+	s.games[game.ID] = stored
 	return nil
 }
 
@@ -162,7 +329,7 @@ func (s *Storage) GetGame(ctx context.Context, id model.GameID) (*model.Game, er
 	if !ok {
 		return nil, model.ErrGameNotFound
 	}
-	return game, nil
+	return clone(game)
 }
 
 func (s *Storage) DeleteGame(ctx context.Context, id model.GameID) error {
@@ -172,13 +339,23 @@ func (s *Storage) DeleteGame(ctx context.Context, id model.GameID) error {
 	return nil
 }
 
+func (s *Storage) CountGames(ctx context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.games), nil
+}
+
 // Board operations
 
 func (s *Storage) SaveBoard(ctx context.Context, board *model.Board) error {
+	stored, err := clone(board)
+	if err != nil {
+		return err
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	key := boardKey{gameID: board.GameID, playerID: board.PlayerID}
-	s.boards[key] = board
+	s.boards[key] = stored
 	return nil
 }
 
@@ -190,7 +367,7 @@ func (s *Storage) GetBoard(ctx context.Context, gameID model.GameID, playerID mo
 	if !ok {
 		return nil, model.ErrBoardNotFound
 	}
-	return board, nil
+	return clone(board)
 }
 
 func (s *Storage) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]*model.Board, error) {
@@ -199,7 +376,11 @@ func (s *Storage) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]
 	var boards []*model.Board
 	for key, board := range s.boards {
 		if key.gameID == gameID {
-			boards = append(boards, board)
+			c, err := clone(board)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, c)
 		}
 	}
 	return boards, nil
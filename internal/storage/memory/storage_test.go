@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,6 +27,10 @@ func (s *StorageSuite) SetupTest() {
 	s.ctx = context.Background()
 }
 
+func (s *StorageSuite) TestPing() {
+	s.NoError(s.storage.Ping(s.ctx))
+}
+
 // Player tests
 
 func (s *StorageSuite) TestSaveAndGetPlayer() {
@@ -96,6 +102,63 @@ func (s *StorageSuite) TestGetRegisteredPlayerByUsernameNotFound() {
 	s.ErrorIs(err, model.ErrPlayerNotFound)
 }
 
+func (s *StorageSuite) TestDeleteRegisteredPlayer() {
+	rp := &model.RegisteredPlayer{PlayerID: "player-1", Username: "alice", PasswordHash: "hash123"}
+	_ = s.storage.SaveRegisteredPlayer(s.ctx, rp)
+
+	err := s.storage.DeleteRegisteredPlayer(s.ctx, "player-1")
+	s.Require().NoError(err)
+
+	_, err = s.storage.GetRegisteredPlayer(s.ctx, "player-1")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+	_, err = s.storage.GetRegisteredPlayerByUsername(s.ctx, "alice")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+
+	// Deleting again is a no-op
+	s.NoError(s.storage.DeleteRegisteredPlayer(s.ctx, "player-1"))
+}
+
+// Player stats tests
+
+func (s *StorageSuite) TestRecordPlayerGameResultAccumulates() {
+	s.Require().NoError(s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{
+		Score: 10, Won: true, BestWord: "CAT", BestWordScore: 3,
+	}))
+	s.Require().NoError(s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{
+		Score: 4, BestWord: "AT", BestWordScore: 2,
+	}))
+
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-1"), stats.PlayerID)
+	s.Equal(2, stats.GamesPlayed)
+	s.Equal(1, stats.GamesWon)
+	s.Equal(14, stats.TotalScore)
+	// A lower-scoring word doesn't replace the best
+	s.Equal("CAT", stats.BestWord)
+	s.Equal(3, stats.BestWordScore)
+}
+
+func (s *StorageSuite) TestGetPlayerStatsEmpty() {
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-1"), stats.PlayerID)
+	s.Zero(stats.GamesPlayed)
+	s.Empty(stats.BestWord)
+}
+
+func (s *StorageSuite) TestDeleteRegisteredPlayerClearsStats() {
+	rp := &model.RegisteredPlayer{PlayerID: "player-1", Username: "alice", PasswordHash: "hash123"}
+	_ = s.storage.SaveRegisteredPlayer(s.ctx, rp)
+	_ = s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{Score: 5})
+
+	s.Require().NoError(s.storage.DeleteRegisteredPlayer(s.ctx, "player-1"))
+
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Zero(stats.GamesPlayed)
+}
+
 // Lobby tests
 
 func (s *StorageSuite) TestSaveAndGetLobby() {
@@ -133,6 +196,19 @@ func (s *StorageSuite) TestLobbyExists() {
 	s.False(exists)
 }
 
+func (s *StorageSuite) TestCreateLobbyRejectsTakenCode() {
+	lobby := &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting}
+	s.Require().NoError(s.storage.CreateLobby(s.ctx, lobby))
+
+	other := &model.Lobby{Code: "ABC123", State: model.LobbyStateInGame}
+	err := s.storage.CreateLobby(s.ctx, other)
+	s.ErrorIs(err, model.ErrLobbyCodeTaken)
+
+	retrieved, err := s.storage.GetLobby(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyStateWaiting, retrieved.State)
+}
+
 func (s *StorageSuite) TestDeleteLobby() {
 	lobby := &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting}
 	_ = s.storage.SaveLobby(s.ctx, lobby)
@@ -144,6 +220,110 @@ func (s *StorageSuite) TestDeleteLobby() {
 	s.ErrorIs(err, model.ErrLobbyNotFound)
 }
 
+func (s *StorageSuite) TestRenameLobby() {
+	lobby := &model.Lobby{
+		Code:  "ABC123",
+		State: model.LobbyStateWaiting,
+		Members: []model.LobbyMember{
+			{Player: model.Player{ID: "p1", DisplayName: "Alice"}, Role: model.RolePlayer, IsHost: true},
+		},
+	}
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, lobby))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123", PlayerID: "p1"}))
+
+	s.Require().NoError(s.storage.RenameLobby(s.ctx, "ABC123", "XYZ789", allowRename))
+
+	_, err := s.storage.GetLobby(s.ctx, "ABC123")
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+
+	renamed, err := s.storage.GetLobby(s.ctx, "XYZ789")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("XYZ789"), renamed.Code)
+	s.Len(renamed.Members, 1)
+
+	code, err := s.storage.GetLobbyForPlayer(s.ctx, "p1")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("XYZ789"), code)
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "XYZ789")
+	s.Require().NoError(err)
+	s.Len(events, 1)
+	events, err = s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Empty(events)
+}
+
+func (s *StorageSuite) TestRenameLobbyNotFound() {
+	err := s.storage.RenameLobby(s.ctx, "NONEXISTENT", "XYZ789", allowRename)
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+}
+
+func (s *StorageSuite) TestRenameLobbyLeavesLobbyWhenAborted() {
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateInGame}))
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "XYZ789"}))
+
+	err := s.storage.RenameLobby(s.ctx, "ABC123", "XYZ789", allowRename)
+	s.ErrorIs(err, model.ErrLobbyCodeTaken)
+
+	err = s.storage.RenameLobby(s.ctx, "ABC123", "NEW456", func(lobby *model.Lobby) error {
+		s.Equal(model.LobbyStateInGame, lobby.State)
+		return model.ErrGameInProgress
+	})
+	s.ErrorIs(err, model.ErrGameInProgress)
+
+	exists, err := s.storage.LobbyExists(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.True(exists)
+	exists, err = s.storage.LobbyExists(s.ctx, "NEW456")
+	s.Require().NoError(err)
+	s.False(exists)
+}
+
+func allowRename(*model.Lobby) error { return nil }
+
+// Lobby event tests
+
+func (s *StorageSuite) TestAppendAndGetLobbyEvents() {
+	created := &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123", PlayerID: "p1"}
+	joined := &model.Event{Type: model.EventPlayerJoined, LobbyCode: "ABC123", PlayerID: "p2"}
+	other := &model.Event{Type: model.EventLobbyCreated, LobbyCode: "XYZ789", PlayerID: "p3"}
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, created))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, joined))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, other))
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(model.EventLobbyCreated, events[0].Type)
+	s.Equal(model.EventPlayerJoined, events[1].Type)
+	s.Equal(model.PlayerID("p2"), events[1].PlayerID)
+}
+
+func (s *StorageSuite) TestDeleteLobbyRemovesEvents() {
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting})
+	_ = s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123"})
+
+	s.Require().NoError(s.storage.DeleteLobby(s.ctx, "ABC123"))
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Empty(events)
+}
+
+func (s *StorageSuite) TestCountLobbies() {
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting})
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "XYZ789", State: model.LobbyStateWaiting})
+
+	count, err := s.storage.CountLobbies(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	_ = s.storage.DeleteLobby(s.ctx, "ABC123")
+	count, err = s.storage.CountLobbies(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
 // Game tests
 
 func (s *StorageSuite) TestSaveAndGetGame() {
@@ -164,6 +344,44 @@ func (s *StorageSuite) TestSaveAndGetGame() {
 	s.Equal(game.State, retrieved.State)
 }
 
+func (s *StorageSuite) TestCountGames() {
+	_ = s.storage.SaveGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "ABC123"})
+	_ = s.storage.SaveGame(s.ctx, &model.Game{ID: "game-2", LobbyCode: "ABC123"})
+
+	count, err := s.storage.CountGames(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	_ = s.storage.DeleteGame(s.ctx, "game-1")
+	count, err = s.storage.CountGames(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *StorageSuite) TestCreateGameClaimsIDOnce() {
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- s.storage.CreateGame(s.ctx, &model.Game{ID: "game-1", GridSize: i + 1})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+		} else {
+			s.ErrorIs(err, model.ErrGameExists)
+		}
+	}
+	s.Equal(1, created)
+}
+
 func (s *StorageSuite) TestGetGameNotFound() {
 	_, err := s.storage.GetGame(s.ctx, "nonexistent")
 	s.ErrorIs(err, model.ErrGameNotFound)
@@ -234,3 +452,117 @@ func (s *StorageSuite) TestGetDictionaryWordsNotLoaded() {
 	_, err := s.storage.GetDictionaryWords(s.ctx)
 	s.ErrorIs(err, model.ErrDictionaryNotLoaded)
 }
+
+// Snapshot tests
+
+func (s *StorageSuite) TestSnapshotPreservesLobbyAndGame() {
+	now := time.Now()
+	gameID := model.GameID("game-1")
+	player := &model.Player{ID: "p1", DisplayName: "Alice", IsGuest: true, CreatedAt: now}
+	lobby := &model.Lobby{
+		Code:        "ABC123",
+		State:       model.LobbyStateInGame,
+		Members:     []model.LobbyMember{{Player: *player, Role: model.RolePlayer, IsHost: true}},
+		Config:      model.DefaultLobbyConfig(),
+		CurrentGame: &gameID,
+		UpdatedAt:   now,
+	}
+	board := model.NewBoard(gameID, "p1", 3)
+	board.Set(model.Position{Row: 1, Col: 1}, 'Q')
+
+	s.Require().NoError(s.storage.SavePlayer(s.ctx, player))
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, lobby))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventGameStarted, LobbyCode: "ABC123", GameID: gameID}))
+	s.Require().NoError(s.storage.SaveGame(s.ctx, &model.Game{ID: gameID, LobbyCode: "ABC123", State: model.GameStatePlacing, GridSize: 3, Players: []model.PlayerID{"p1"}, CurrentLetter: 'Q', UpdatedAt: now}))
+	s.Require().NoError(s.storage.SaveBoard(s.ctx, board))
+
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+	s.Require().NoError(s.storage.SaveSnapshot(path))
+
+	restored := New()
+	s.Require().NoError(restored.LoadSnapshot(path, DefaultSnapshotConfig(), now.Add(time.Hour)))
+
+	retrievedLobby, err := restored.GetLobby(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyStateInGame, retrievedLobby.State)
+	s.Require().NotNil(retrievedLobby.CurrentGame)
+	s.Equal(gameID, *retrievedLobby.CurrentGame)
+
+	code, err := restored.GetLobbyForPlayer(s.ctx, "p1")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("ABC123"), code)
+
+	game, err := restored.GetGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(model.GameStatePlacing, game.State)
+	s.Equal('Q', game.CurrentLetter)
+
+	retrievedBoard, err := restored.GetBoard(s.ctx, gameID, "p1")
+	s.Require().NoError(err)
+	s.Equal('Q', retrievedBoard.Get(model.Position{Row: 1, Col: 1}))
+
+	events, err := restored.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Len(events, 1)
+}
+
+func (s *StorageSuite) TestSnapshotRacesWithCallerMutations() {
+	s.Require().NoError(s.storage.SaveGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "ABC123", State: model.GameStatePlacing}))
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			_ = s.storage.SaveSnapshot(path)
+		}
+	}()
+	game, err := s.storage.GetGame(s.ctx, "game-1")
+	s.Require().NoError(err)
+	for i := 0; i < 50; i++ {
+		// Mutating a loaded game must not touch the stored copy being encoded
+		game.CurrentTurn = i
+		game.CurrentLetter = 'A' + rune(i%26)
+	}
+	wg.Wait()
+
+	stored, err := s.storage.GetGame(s.ctx, "game-1")
+	s.Require().NoError(err)
+	s.Zero(stored.CurrentTurn)
+}
+
+func (s *StorageSuite) TestLoadSnapshotDropsExpiredEntries() {
+	now := time.Now()
+	_ = s.storage.SavePlayer(s.ctx, &model.Player{ID: "guest", IsGuest: true, CreatedAt: now})
+	_ = s.storage.SavePlayer(s.ctx, &model.Player{ID: "registered", CreatedAt: now})
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", UpdatedAt: now})
+	_ = s.storage.SaveGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "ABC123", UpdatedAt: now})
+	_ = s.storage.SaveBoard(s.ctx, model.NewBoard("game-1", "guest", 3))
+
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+	s.Require().NoError(s.storage.SaveSnapshot(path))
+
+	restored := New()
+	s.Require().NoError(restored.LoadSnapshot(path, DefaultSnapshotConfig(), now.Add(25*time.Hour)))
+
+	_, err := restored.GetPlayer(s.ctx, "guest")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+	_, err = restored.GetPlayer(s.ctx, "registered")
+	s.NoError(err)
+	_, err = restored.GetLobby(s.ctx, "ABC123")
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+	_, err = restored.GetGame(s.ctx, "game-1")
+	s.ErrorIs(err, model.ErrGameNotFound)
+	_, err = restored.GetBoard(s.ctx, "game-1", "guest")
+	s.ErrorIs(err, model.ErrBoardNotFound)
+}
+
+func (s *StorageSuite) TestLoadSnapshotMissingFileStartsEmpty() {
+	err := s.storage.LoadSnapshot(filepath.Join(s.T().TempDir(), "missing.json"), DefaultSnapshotConfig(), time.Now())
+	s.Require().NoError(err)
+
+	count, err := s.storage.CountLobbies(s.ctx)
+	s.Require().NoError(err)
+	s.Zero(count)
+}
@@ -16,6 +16,10 @@ type Config struct {
 	LobbyTTL       time.Duration
 	GameTTL        time.Duration
 	BoardTTL       time.Duration
+
+	// BinaryBoards stores boards with model.Board's compact binary encoding
+	// instead of JSON. Boards already stored as JSON remain readable.
+	BinaryBoards bool
 }
 
 // DefaultConfig returns sensible defaults for Redis configuration
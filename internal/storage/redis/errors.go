@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
+)
+
+// classifyHook wraps errors that may clear up on their own with
+// storage.ErrTransient, so callers can tell them apart from permanent ones
+type classifyHook struct{}
+
+func (classifyHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := next(ctx, network, addr)
+		return conn, classifyError(err)
+	}
+}
+
+func (classifyHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		return classifyError(next(ctx, cmd))
+	}
+}
+
+func (classifyHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		return classifyError(next(ctx, cmds))
+	}
+}
+
+// classifyError wraps err with storage.ErrTransient if retrying might help.
+// redis.Nil and the caller's own cancellation are left alone.
+func classifyError(err error) error {
+	if err == nil || storage.IsTransient(err) || !isTransient(err) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", storage.ErrTransient, err)
+}
+
+// isTransient reports whether err is a failure the server can't have acted
+// on: a dial failure, no free pooled connection, or a reply refusing the
+// command while the server is unavailable. A timeout, reset or EOF on an
+// established connection may come after the command ran, so it isn't.
+func isTransient(err error) bool {
+	if errors.Is(err, redis.Nil) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, redis.ErrPoolTimeout) {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return redis.IsLoadingError(err) ||
+		redis.IsReadOnlyError(err) ||
+		redis.IsClusterDownError(err) ||
+		redis.IsTryAgainError(err) ||
+		redis.IsMasterDownError(err) ||
+		redis.IsMaxClientsError(err)
+}
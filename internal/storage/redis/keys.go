@@ -21,6 +21,11 @@ func registeredPlayerKey(playerID model.PlayerID) string {
 	return fmt.Sprintf("%s:registered_player:%s", keyPrefix, playerID)
 }
 
+// playerStatsKey returns the Redis key for the HASH of a player's stats
+func playerStatsKey(playerID model.PlayerID) string {
+	return fmt.Sprintf("%s:player_stats:%s", keyPrefix, playerID)
+}
+
 // usernameIndexKey returns the Redis key for the username -> player_id index
 func usernameIndexKey(username string) string {
 	return fmt.Sprintf("%s:idx:username:%s", keyPrefix, username)
@@ -31,16 +36,31 @@ func lobbyKey(code model.LobbyCode) string {
 	return fmt.Sprintf("%s:lobby:%s", keyPrefix, code)
 }
 
+// lobbyKeyPattern returns the SCAN pattern matching all Lobby keys
+func lobbyKeyPattern() string {
+	return fmt.Sprintf("%s:lobby:*", keyPrefix)
+}
+
 // playerLobbyIndexKey returns the Redis key for the player -> lobby_code index
 func playerLobbyIndexKey(playerID model.PlayerID) string {
 	return fmt.Sprintf("%s:idx:player_lobby:%s", keyPrefix, playerID)
 }
 
+// lobbyEventsKey returns the Redis key for the LIST of events recorded for a lobby
+func lobbyEventsKey(code model.LobbyCode) string {
+	return fmt.Sprintf("%s:lobby_events:%s", keyPrefix, code)
+}
+
 // gameKey returns the Redis key for a Game
 func gameKey(id model.GameID) string {
 	return fmt.Sprintf("%s:game:%s", keyPrefix, id)
 }
 
+// gameKeyPattern returns the SCAN pattern matching all Game keys
+func gameKeyPattern() string {
+	return fmt.Sprintf("%s:game:*", keyPrefix)
+}
+
 // boardKey returns the Redis key for a Board
 func boardKey(gameID model.GameID, playerID model.PlayerID) string {
 	return fmt.Sprintf("%s:board:%s:%s", keyPrefix, gameID, playerID)
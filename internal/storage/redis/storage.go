@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -29,6 +30,7 @@ func New(cfg Config) (*Storage, error) {
 	opts.MinIdleConns = cfg.MinIdleConns
 
 	client := redis.NewClient(opts)
+	client.AddHook(classifyHook{})
 
 	// Verify connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -46,6 +48,7 @@ func New(cfg Config) (*Storage, error) {
 
 // NewWithClient creates a Redis storage with an existing client (for testing)
 func NewWithClient(client *redis.Client, cfg Config) *Storage {
+	client.AddHook(classifyHook{})
 	return &Storage{
 		client: client,
 		cfg:    cfg,
@@ -60,6 +63,11 @@ func (s *Storage) Close() error {
 // Ensure Storage implements the interface
 var _ storage.Storage = (*Storage)(nil)
 
+// Ping checks the Redis server is reachable
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
 // Player operations
 
 func (s *Storage) SavePlayer(ctx context.Context, player *model.Player) error {
@@ -147,8 +155,102 @@ func (s *Storage) GetRegisteredPlayerByUsername(ctx context.Context, username st
 	return s.GetRegisteredPlayer(ctx, model.PlayerID(playerIDStr))
 }
 
+func (s *Storage) DeleteRegisteredPlayer(ctx context.Context, playerID model.PlayerID) error {
+	rp, err := s.GetRegisteredPlayer(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, model.ErrPlayerNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	pipe := s.client.Pipeline()
+	pipe.Del(ctx, registeredPlayerKey(playerID))
+	pipe.Del(ctx, usernameIndexKey(rp.Username))
+	pipe.Del(ctx, playerStatsKey(playerID))
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Player stats operations
+
+// recordPlayerGameResultScript increments the counters in a player's stats
+// hash and replaces the best word only if the new one scores higher, so
+// concurrent game completions can't lose updates
+var recordPlayerGameResultScript = redis.NewScript(`
+redis.call("HINCRBY", KEYS[1], "games_played", 1)
+redis.call("HINCRBY", KEYS[1], "games_won", ARGV[1])
+redis.call("HINCRBY", KEYS[1], "total_score", ARGV[2])
+local best = tonumber(redis.call("HGET", KEYS[1], "best_word_score") or "0")
+if tonumber(ARGV[4]) > best then
+	redis.call("HSET", KEYS[1], "best_word", ARGV[3], "best_word_score", ARGV[4])
+end
+return 1
+`)
+
+func (s *Storage) RecordPlayerGameResult(ctx context.Context, playerID model.PlayerID, result model.PlayerGameResult) error {
+	won := 0
+	if result.Won {
+		won = 1
+	}
+	keys := []string{playerStatsKey(playerID)}
+	return recordPlayerGameResultScript.Run(ctx, s.client, keys, won, result.Score, result.BestWord, result.BestWordScore).Err()
+}
+
+func (s *Storage) GetPlayerStats(ctx context.Context, playerID model.PlayerID) (*model.PlayerStats, error) {
+	fields, err := s.client.HGetAll(ctx, playerStatsKey(playerID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.PlayerStats{
+		PlayerID: playerID,
+		BestWord: fields["best_word"],
+	}
+	for name, dst := range map[string]*int{
+		"games_played":    &stats.GamesPlayed,
+		"games_won":       &stats.GamesWon,
+		"total_score":     &stats.TotalScore,
+		"best_word_score": &stats.BestWordScore,
+	} {
+		if v, ok := fields[name]; ok {
+			if *dst, err = strconv.Atoi(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return stats, nil
+}
+
 // Lobby operations
 
+// CreateLobby claims the lobby's key with SETNX before writing the members'
+// lobby index, so an existing lobby is never overwritten
+func (s *Storage) CreateLobby(ctx context.Context, lobby *model.Lobby) error {
+	data, err := json.Marshal(lobby)
+	if err != nil {
+		return err
+	}
+
+	created, err := s.client.SetNX(ctx, lobbyKey(lobby.Code), data, s.cfg.LobbyTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !created {
+		return model.ErrLobbyCodeTaken
+	}
+
+	pipe := s.client.Pipeline()
+	for _, member := range lobby.Members {
+		pipe.Set(ctx, playerLobbyIndexKey(member.Player.ID), string(lobby.Code), s.cfg.LobbyTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.client.Del(ctx, lobbyKey(lobby.Code))
+		return err
+	}
+	return nil
+}
+
 func (s *Storage) SaveLobby(ctx context.Context, lobby *model.Lobby) error {
 	data, err := json.Marshal(lobby)
 	if err != nil {
@@ -195,12 +297,77 @@ func (s *Storage) DeleteLobby(ctx context.Context, code model.LobbyCode) error {
 			pipe.Del(ctx, playerLobbyIndexKey(member.Player.ID))
 		}
 		pipe.Del(ctx, lobbyKey(code))
+		pipe.Del(ctx, lobbyEventsKey(code))
 		_, err = pipe.Exec(ctx)
 		return err
 	}
 
-	// If lobby not found, just try to delete the key
-	return s.client.Del(ctx, lobbyKey(code)).Err()
+	// If lobby not found, just try to delete the keys
+	return s.client.Del(ctx, lobbyKey(code), lobbyEventsKey(code)).Err()
+}
+
+// maxRenameAttempts bounds how often RenameLobby retries when the lobby
+// changes underneath it
+const maxRenameAttempts = 5
+
+// RenameLobby watches both codes so the rename is abandoned and retried if
+// the lobby is saved, or the new code claimed, while it is being moved
+func (s *Storage) RenameLobby(ctx context.Context, oldCode, newCode model.LobbyCode, check func(*model.Lobby) error) error {
+	rename := func(tx *redis.Tx) error {
+		data, err := tx.Get(ctx, lobbyKey(oldCode)).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return model.ErrLobbyNotFound
+		} else if err != nil {
+			return err
+		}
+		var lobby model.Lobby
+		if err := json.Unmarshal(data, &lobby); err != nil {
+			return err
+		}
+		if err := check(&lobby); err != nil {
+			return err
+		}
+
+		taken, err := tx.Exists(ctx, lobbyKey(newCode)).Result()
+		if err != nil {
+			return err
+		}
+		if taken > 0 {
+			return model.ErrLobbyCodeTaken
+		}
+		// RENAME fails on a missing key, and a lobby may have no events yet
+		hasEvents, err := tx.Exists(ctx, lobbyEventsKey(oldCode)).Result()
+		if err != nil {
+			return err
+		}
+
+		lobby.Code = newCode
+		data, err = json.Marshal(&lobby)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, lobbyKey(newCode), data, s.cfg.LobbyTTL)
+			pipe.Del(ctx, lobbyKey(oldCode))
+			for _, member := range lobby.Members {
+				pipe.Set(ctx, playerLobbyIndexKey(member.Player.ID), string(newCode), s.cfg.LobbyTTL)
+			}
+			if hasEvents > 0 {
+				pipe.Rename(ctx, lobbyEventsKey(oldCode), lobbyEventsKey(newCode))
+			}
+			return nil
+		})
+		return err
+	}
+
+	var err error
+	for attempt := 0; attempt < maxRenameAttempts; attempt++ {
+		err = s.client.Watch(ctx, rename, lobbyKey(oldCode), lobbyKey(newCode), lobbyEventsKey(oldCode))
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return err
 }
 
 func (s *Storage) LobbyExists(ctx context.Context, code model.LobbyCode) (bool, error) {
@@ -211,19 +378,98 @@ func (s *Storage) LobbyExists(ctx context.Context, code model.LobbyCode) (bool,
 	return exists > 0, nil
 }
 
+// clearStaleIndexScript deletes a player's lobby index only if it still
+// points at the stale lobby, so a lobby joined in the meantime is kept
+var clearStaleIndexScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// GetLobbyForPlayer looks the player up in the lobby index. An entry left
+// behind by a lobby that has expired, or that the player has left, is
+// removed and reported as no lobby
 func (s *Storage) GetLobbyForPlayer(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, error) {
-	lobbyCode, err := s.client.Get(ctx, playerLobbyIndexKey(playerID)).Result()
+	indexKey := playerLobbyIndexKey(playerID)
+	lobbyCode, err := s.client.Get(ctx, indexKey).Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return "", nil
 		}
 		return "", err
 	}
-	return model.LobbyCode(lobbyCode), nil
+
+	lobby, err := s.GetLobby(ctx, model.LobbyCode(lobbyCode))
+	if err != nil && !errors.Is(err, model.ErrLobbyNotFound) {
+		return "", err
+	}
+	if err == nil && lobby.GetMember(playerID) != nil {
+		return lobby.Code, nil
+	}
+
+	if err := clearStaleIndexScript.Run(ctx, s.client, []string{indexKey}, lobbyCode).Err(); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+func (s *Storage) CountLobbies(ctx context.Context) (int, error) {
+	return s.countKeys(ctx, lobbyKeyPattern())
+}
+
+// Lobby event operations
+
+func (s *Storage) AppendLobbyEvent(ctx context.Context, event *model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	// Events live as long as the lobby they belong to
+	key := lobbyEventsKey(event.LobbyCode)
+	pipe := s.client.Pipeline()
+	pipe.RPush(ctx, key, data)
+	pipe.Expire(ctx, key, s.cfg.LobbyTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (s *Storage) GetLobbyEvents(ctx context.Context, code model.LobbyCode) ([]*model.Event, error) {
+	items, err := s.client.LRange(ctx, lobbyEventsKey(code), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*model.Event, 0, len(items))
+	for _, item := range items {
+		var event model.Event
+		if err := json.Unmarshal([]byte(item), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, nil
 }
 
 // Game operations
 
+func (s *Storage) CreateGame(ctx context.Context, game *model.Game) error {
+	data, err := json.Marshal(game)
+	if err != nil {
+		return err
+	}
+
+	created, err := s.client.SetNX(ctx, gameKey(game.ID), data, s.cfg.GameTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !created {
+		return model.ErrGameExists
+	}
+	return nil
+}
+
 func (s *Storage) SaveGame(ctx context.Context, game *model.Game) error {
 	data, err := json.Marshal(game)
 	if err != nil {
@@ -253,10 +499,28 @@ func (s *Storage) DeleteGame(ctx context.Context, id model.GameID) error {
 	return s.client.Del(ctx, gameKey(id)).Err()
 }
 
+func (s *Storage) CountGames(ctx context.Context) (int, error) {
+	return s.countKeys(ctx, gameKeyPattern())
+}
+
+// countKeys counts keys matching a pattern using SCAN, so expired entries
+// are never counted and the server is not blocked as it would be by KEYS
+func (s *Storage) countKeys(ctx context.Context, pattern string) (int, error) {
+	count := 0
+	iter := s.client.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		count++
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // Board operations
 
 func (s *Storage) SaveBoard(ctx context.Context, board *model.Board) error {
-	data, err := json.Marshal(board)
+	data, err := s.encodeBoard(board)
 	if err != nil {
 		return err
 	}
@@ -282,11 +546,7 @@ func (s *Storage) GetBoard(ctx context.Context, gameID model.GameID, playerID mo
 		return nil, err
 	}
 
-	var board model.Board
-	if err := json.Unmarshal(data, &board); err != nil {
-		return nil, err
-	}
-	return &board, nil
+	return decodeBoard(data)
 }
 
 func (s *Storage) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]*model.Board, error) {
@@ -313,16 +573,40 @@ func (s *Storage) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]
 		if val == nil {
 			continue // Board may have expired
 		}
-		var board model.Board
-		if err := json.Unmarshal([]byte(val.(string)), &board); err != nil {
+		board, err := decodeBoard([]byte(val.(string)))
+		if err != nil {
 			continue // Skip invalid data
 		}
-		boards = append(boards, &board)
+		boards = append(boards, board)
 	}
 
 	return boards, nil
 }
 
+// encodeBoard serializes a board in the configured format
+func (s *Storage) encodeBoard(board *model.Board) ([]byte, error) {
+	if s.cfg.BinaryBoards {
+		return board.MarshalBinary()
+	}
+	return json.Marshal(board)
+}
+
+// decodeBoard reads a board in either format, so boards saved before
+// BinaryBoards was switched on (or off) can still be read
+func decodeBoard(data []byte) (*model.Board, error) {
+	var board model.Board
+	if len(data) > 0 && data[0] == '{' {
+		if err := json.Unmarshal(data, &board); err != nil {
+			return nil, err
+		}
+		return &board, nil
+	}
+	if err := board.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
 func (s *Storage) DeleteBoardsForGame(ctx context.Context, gameID model.GameID) error {
 	indexKey := boardsForGameIndexKey(gameID)
 
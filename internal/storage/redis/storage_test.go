@@ -2,6 +2,10 @@ package redis
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
 	"testing"
 	"time"
 
@@ -10,6 +14,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
 )
 
 type StorageSuite struct {
@@ -49,6 +54,35 @@ func (s *StorageSuite) TearDownTest() {
 	}
 }
 
+func (s *StorageSuite) TestPing() {
+	s.Require().NoError(s.storage.Ping(s.ctx))
+
+	s.mini.Close()
+	s.mini = nil
+	s.Error(s.storage.Ping(s.ctx))
+}
+
+func (s *StorageSuite) TestConnectionErrorsAreTransient() {
+	_, err := s.storage.GetLobby(s.ctx, "NOPE00")
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+	s.False(storage.IsTransient(err))
+
+	s.mini.Close()
+	s.mini = nil
+	_, err = s.storage.GetLobby(s.ctx, "NOPE00")
+	s.True(storage.IsTransient(err))
+}
+
+func (s *StorageSuite) TestAmbiguousNetworkErrorsAreNotTransient() {
+	dial := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	s.True(isTransient(dial))
+
+	// The command may already have run when the reply times out
+	read := &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}
+	s.False(isTransient(read))
+	s.False(isTransient(io.EOF))
+}
+
 // Player tests
 
 func (s *StorageSuite) TestSaveAndGetPlayer() {
@@ -141,6 +175,63 @@ func (s *StorageSuite) TestGetRegisteredPlayerByUsernameNotFound() {
 	s.ErrorIs(err, model.ErrPlayerNotFound)
 }
 
+func (s *StorageSuite) TestDeleteRegisteredPlayer() {
+	rp := &model.RegisteredPlayer{PlayerID: "player-1", Username: "alice", PasswordHash: "hash123"}
+	_ = s.storage.SaveRegisteredPlayer(s.ctx, rp)
+
+	err := s.storage.DeleteRegisteredPlayer(s.ctx, "player-1")
+	s.Require().NoError(err)
+
+	_, err = s.storage.GetRegisteredPlayer(s.ctx, "player-1")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+	_, err = s.storage.GetRegisteredPlayerByUsername(s.ctx, "alice")
+	s.ErrorIs(err, model.ErrPlayerNotFound)
+
+	// Deleting again is a no-op
+	s.NoError(s.storage.DeleteRegisteredPlayer(s.ctx, "player-1"))
+}
+
+// Player stats tests
+
+func (s *StorageSuite) TestRecordPlayerGameResultAccumulates() {
+	s.Require().NoError(s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{
+		Score: 10, Won: true, BestWord: "CAT", BestWordScore: 3,
+	}))
+	s.Require().NoError(s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{
+		Score: 4, BestWord: "AT", BestWordScore: 2,
+	}))
+
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-1"), stats.PlayerID)
+	s.Equal(2, stats.GamesPlayed)
+	s.Equal(1, stats.GamesWon)
+	s.Equal(14, stats.TotalScore)
+	// A lower-scoring word doesn't replace the best
+	s.Equal("CAT", stats.BestWord)
+	s.Equal(3, stats.BestWordScore)
+}
+
+func (s *StorageSuite) TestGetPlayerStatsEmpty() {
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Equal(model.PlayerID("player-1"), stats.PlayerID)
+	s.Zero(stats.GamesPlayed)
+	s.Empty(stats.BestWord)
+}
+
+func (s *StorageSuite) TestDeleteRegisteredPlayerClearsStats() {
+	rp := &model.RegisteredPlayer{PlayerID: "player-1", Username: "alice", PasswordHash: "hash123"}
+	_ = s.storage.SaveRegisteredPlayer(s.ctx, rp)
+	_ = s.storage.RecordPlayerGameResult(s.ctx, "player-1", model.PlayerGameResult{Score: 5})
+
+	s.Require().NoError(s.storage.DeleteRegisteredPlayer(s.ctx, "player-1"))
+
+	stats, err := s.storage.GetPlayerStats(s.ctx, "player-1")
+	s.Require().NoError(err)
+	s.Zero(stats.GamesPlayed)
+}
+
 // Lobby tests
 
 func (s *StorageSuite) TestSaveAndGetLobby() {
@@ -178,6 +269,19 @@ func (s *StorageSuite) TestLobbyExists() {
 	s.False(exists)
 }
 
+func (s *StorageSuite) TestCreateLobbyRejectsTakenCode() {
+	lobby := &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting}
+	s.Require().NoError(s.storage.CreateLobby(s.ctx, lobby))
+
+	other := &model.Lobby{Code: "ABC123", State: model.LobbyStateInGame}
+	err := s.storage.CreateLobby(s.ctx, other)
+	s.ErrorIs(err, model.ErrLobbyCodeTaken)
+
+	retrieved, err := s.storage.GetLobby(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyStateWaiting, retrieved.State)
+}
+
 func (s *StorageSuite) TestDeleteLobby() {
 	lobby := &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting}
 	_ = s.storage.SaveLobby(s.ctx, lobby)
@@ -197,6 +301,151 @@ func (s *StorageSuite) TestLobbyTTL() {
 	s.True(ttl > 0, "Lobby should have TTL")
 }
 
+func (s *StorageSuite) TestRenameLobby() {
+	lobby := &model.Lobby{
+		Code:  "ABC123",
+		State: model.LobbyStateWaiting,
+		Members: []model.LobbyMember{
+			{Player: model.Player{ID: "p1", DisplayName: "Alice"}, Role: model.RolePlayer, IsHost: true},
+		},
+	}
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, lobby))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123", PlayerID: "p1"}))
+
+	s.Require().NoError(s.storage.RenameLobby(s.ctx, "ABC123", "XYZ789", allowRename))
+
+	_, err := s.storage.GetLobby(s.ctx, "ABC123")
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+
+	renamed, err := s.storage.GetLobby(s.ctx, "XYZ789")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("XYZ789"), renamed.Code)
+	s.Len(renamed.Members, 1)
+
+	code, err := s.storage.GetLobbyForPlayer(s.ctx, "p1")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("XYZ789"), code)
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "XYZ789")
+	s.Require().NoError(err)
+	s.Len(events, 1)
+	events, err = s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Empty(events)
+}
+
+func (s *StorageSuite) TestGetLobbyForPlayerClearsStaleIndex() {
+	lobby := &model.Lobby{
+		Code:    "ABC123",
+		Members: []model.LobbyMember{{Player: model.Player{ID: "p1"}}, {Player: model.Player{ID: "p2"}}},
+	}
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, lobby))
+
+	// p2 leaves, which doesn't rewrite their index entry
+	lobby.Members = lobby.Members[:1]
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, lobby))
+	code, err := s.storage.GetLobbyForPlayer(s.ctx, "p2")
+	s.Require().NoError(err)
+	s.Empty(code)
+	s.False(s.mini.Exists(playerLobbyIndexKey("p2")))
+
+	// The lobby expires, leaving p1's entry behind
+	s.mini.Del(lobbyKey("ABC123"))
+	code, err = s.storage.GetLobbyForPlayer(s.ctx, "p1")
+	s.Require().NoError(err)
+	s.Empty(code)
+	s.False(s.mini.Exists(playerLobbyIndexKey("p1")))
+}
+
+func (s *StorageSuite) TestRenameLobbyNotFound() {
+	err := s.storage.RenameLobby(s.ctx, "NONEXISTENT", "XYZ789", allowRename)
+	s.ErrorIs(err, model.ErrLobbyNotFound)
+}
+
+func (s *StorageSuite) TestRenameLobbyLeavesLobbyWhenAborted() {
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateInGame}))
+	s.Require().NoError(s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "XYZ789"}))
+
+	err := s.storage.RenameLobby(s.ctx, "ABC123", "XYZ789", allowRename)
+	s.ErrorIs(err, model.ErrLobbyCodeTaken)
+
+	err = s.storage.RenameLobby(s.ctx, "ABC123", "NEW456", func(lobby *model.Lobby) error {
+		s.Equal(model.LobbyStateInGame, lobby.State)
+		return model.ErrGameInProgress
+	})
+	s.ErrorIs(err, model.ErrGameInProgress)
+
+	exists, err := s.storage.LobbyExists(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.True(exists)
+	exists, err = s.storage.LobbyExists(s.ctx, "NEW456")
+	s.Require().NoError(err)
+	s.False(exists)
+}
+
+func allowRename(*model.Lobby) error { return nil }
+
+// Lobby event tests
+
+func (s *StorageSuite) TestAppendAndGetLobbyEvents() {
+	created := &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123", PlayerID: "p1"}
+	joined := &model.Event{Type: model.EventPlayerJoined, LobbyCode: "ABC123", PlayerID: "p2"}
+	other := &model.Event{Type: model.EventLobbyCreated, LobbyCode: "XYZ789", PlayerID: "p3"}
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, created))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, joined))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, other))
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(model.EventLobbyCreated, events[0].Type)
+	s.Equal(model.EventPlayerJoined, events[1].Type)
+	s.Equal(model.PlayerID("p2"), events[1].PlayerID)
+
+	ttl := s.mini.TTL(lobbyEventsKey("ABC123"))
+	s.True(ttl > 0, "Lobby events should have TTL")
+}
+
+func (s *StorageSuite) TestLobbyEventPayloadsKeepTheirType() {
+	placed := model.LetterPlacedPayload{PlayerID: "p1", Position: model.Position{Row: 1, Col: 2}, Letter: 'Q'}
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLetterPlaced, LobbyCode: "ABC123", Payload: placed}))
+	s.Require().NoError(s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventGameEnded, LobbyCode: "ABC123"}))
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(placed, events[0].Payload)
+	s.Nil(events[1].Payload)
+}
+
+func (s *StorageSuite) TestDeleteLobbyRemovesEvents() {
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting})
+	_ = s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123"})
+
+	s.Require().NoError(s.storage.DeleteLobby(s.ctx, "ABC123"))
+
+	events, err := s.storage.GetLobbyEvents(s.ctx, "ABC123")
+	s.Require().NoError(err)
+	s.Empty(events)
+}
+
+func (s *StorageSuite) TestCountLobbies() {
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "ABC123", State: model.LobbyStateWaiting})
+	_ = s.storage.SaveLobby(s.ctx, &model.Lobby{Code: "XYZ789", State: model.LobbyStateWaiting})
+
+	// Events and indexes are not counted as lobbies
+	_ = s.storage.AppendLobbyEvent(s.ctx, &model.Event{Type: model.EventLobbyCreated, LobbyCode: "ABC123"})
+
+	count, err := s.storage.CountLobbies(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	_ = s.storage.DeleteLobby(s.ctx, "ABC123")
+	count, err = s.storage.CountLobbies(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
 // Game tests
 
 func (s *StorageSuite) TestSaveAndGetGame() {
@@ -217,11 +466,37 @@ func (s *StorageSuite) TestSaveAndGetGame() {
 	s.Equal(game.State, retrieved.State)
 }
 
+func (s *StorageSuite) TestCountGames() {
+	_ = s.storage.SaveGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "ABC123"})
+	_ = s.storage.SaveGame(s.ctx, &model.Game{ID: "game-2", LobbyCode: "ABC123"})
+
+	count, err := s.storage.CountGames(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	_ = s.storage.DeleteGame(s.ctx, "game-1")
+	count, err = s.storage.CountGames(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
 func (s *StorageSuite) TestGetGameNotFound() {
 	_, err := s.storage.GetGame(s.ctx, "nonexistent")
 	s.ErrorIs(err, model.ErrGameNotFound)
 }
 
+func (s *StorageSuite) TestCreateGameRejectsTakenID() {
+	s.Require().NoError(s.storage.CreateGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "ABC123"}))
+	s.True(s.mini.TTL(gameKey("game-1")) > 0, "Created game should have TTL")
+
+	err := s.storage.CreateGame(s.ctx, &model.Game{ID: "game-1", LobbyCode: "XYZ789"})
+	s.ErrorIs(err, model.ErrGameExists)
+
+	retrieved, err := s.storage.GetGame(s.ctx, "game-1")
+	s.Require().NoError(err)
+	s.Equal(model.LobbyCode("ABC123"), retrieved.LobbyCode)
+}
+
 func (s *StorageSuite) TestGameTTL() {
 	game := &model.Game{ID: "game-1", State: model.GameStateAnnouncing}
 	_ = s.storage.SaveGame(s.ctx, game)
@@ -245,6 +520,36 @@ func (s *StorageSuite) TestSaveAndGetBoard() {
 	s.Equal('A', retrieved.Get(model.Position{Row: 0, Col: 0}))
 }
 
+func (s *StorageSuite) TestBinaryBoards() {
+	legacy := model.NewBoard("game-1", "player-1", 3)
+	legacy.Set(model.Position{Row: 1, Col: 1}, 'B')
+	s.Require().NoError(s.storage.SaveBoard(s.ctx, legacy))
+
+	// Switch to binary encoding against the same data
+	s.storage.cfg.BinaryBoards = true
+
+	board := model.NewBoard("game-1", "player-2", 3)
+	board.Set(model.Position{Row: 0, Col: 2}, 'É')
+	s.Require().NoError(s.storage.SaveBoard(s.ctx, board))
+
+	raw, err := s.mini.Get(boardKey("game-1", "player-2"))
+	s.Require().NoError(err)
+	s.NotEqual(byte('{'), raw[0])
+
+	retrieved, err := s.storage.GetBoard(s.ctx, "game-1", "player-2")
+	s.Require().NoError(err)
+	s.Equal(board, retrieved)
+
+	// The board saved as JSON is still readable
+	retrieved, err = s.storage.GetBoard(s.ctx, "game-1", "player-1")
+	s.Require().NoError(err)
+	s.Equal(legacy, retrieved)
+
+	boards, err := s.storage.GetBoardsForGame(s.ctx, "game-1")
+	s.Require().NoError(err)
+	s.Len(boards, 2)
+}
+
 func (s *StorageSuite) TestGetBoardNotFound() {
 	_, err := s.storage.GetBoard(s.ctx, "game-1", "nonexistent")
 	s.ErrorIs(err, model.ErrBoardNotFound)
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTransient marks a storage failure that may succeed if tried again and
+// that the backend can't have acted on, such as failing to connect. Backends
+// wrap such errors with it; every other error, including not-found errors,
+// is permanent.
+var ErrTransient = errors.New("transient storage error")
+
+// IsTransient reports whether err is worth retrying
+func IsTransient(err error) bool {
+	return errors.Is(err, ErrTransient)
+}
+
+// RetryConfig controls how transient storage errors are retried
+type RetryConfig struct {
+	// Attempts is the most times an operation is tried, including the first
+	// Values below 1 are treated as 1
+	Attempts int
+	// Backoff is the wait before the first retry, doubling for each retry
+	// after it
+	Backoff time.Duration
+}
+
+// DefaultRetryConfig returns the default retry configuration
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		Attempts: 3,
+		Backoff:  50 * time.Millisecond,
+	}
+}
+
+// Retry calls fn until it succeeds, fails with a permanent error or has been
+// tried cfg.Attempts times, returning its last error. Waiting between
+// attempts stops early if ctx is done.
+func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
+	_, err := retryValue(ctx, cfg, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// retryValue is Retry for operations that return a value
+func retryValue[T any](ctx context.Context, cfg RetryConfig, fn func() (T, error)) (T, error) {
+	backoff := cfg.Backoff
+	for attempt := 1; ; attempt++ {
+		v, err := fn()
+		if err == nil || !IsTransient(err) || attempt >= cfg.Attempts {
+			return v, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return v, err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
+
+// retryingStorage retries transient errors from another Storage. Only reads
+// and writes that set a whole value are retried: appends, counters and
+// renames could apply twice if an earlier attempt reached the backend.
+type retryingStorage struct {
+	inner Storage
+	cfg   RetryConfig
+}
+
+// WithRetry wraps s so each operation that fails with a transient error is
+// retried under cfg before the error is returned to the caller
+func WithRetry(s Storage, cfg RetryConfig) Storage {
+	return &retryingStorage{inner: s, cfg: cfg}
+}
+
+// Ping isn't retried, so health checks report an unreachable backend promptly
+func (r *retryingStorage) Ping(ctx context.Context) error {
+	return r.inner.Ping(ctx)
+}
+
+func (r *retryingStorage) SavePlayer(ctx context.Context, player *model.Player) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SavePlayer(ctx, player)
+	})
+}
+
+func (r *retryingStorage) GetPlayer(ctx context.Context, id model.PlayerID) (*model.Player, error) {
+	return retryValue(ctx, r.cfg, func() (*model.Player, error) {
+		return r.inner.GetPlayer(ctx, id)
+	})
+}
+
+func (r *retryingStorage) DeletePlayer(ctx context.Context, id model.PlayerID) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.DeletePlayer(ctx, id)
+	})
+}
+
+func (r *retryingStorage) SaveRegisteredPlayer(ctx context.Context, rp *model.RegisteredPlayer) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SaveRegisteredPlayer(ctx, rp)
+	})
+}
+
+func (r *retryingStorage) GetRegisteredPlayer(ctx context.Context, playerID model.PlayerID) (*model.RegisteredPlayer, error) {
+	return retryValue(ctx, r.cfg, func() (*model.RegisteredPlayer, error) {
+		return r.inner.GetRegisteredPlayer(ctx, playerID)
+	})
+}
+
+func (r *retryingStorage) GetRegisteredPlayerByUsername(ctx context.Context, username string) (*model.RegisteredPlayer, error) {
+	return retryValue(ctx, r.cfg, func() (*model.RegisteredPlayer, error) {
+		return r.inner.GetRegisteredPlayerByUsername(ctx, username)
+	})
+}
+
+func (r *retryingStorage) DeleteRegisteredPlayer(ctx context.Context, playerID model.PlayerID) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.DeleteRegisteredPlayer(ctx, playerID)
+	})
+}
+
+// RecordPlayerGameResult isn't retried, since it adds to the stats
+func (r *retryingStorage) RecordPlayerGameResult(ctx context.Context, playerID model.PlayerID, result model.PlayerGameResult) error {
+	return r.inner.RecordPlayerGameResult(ctx, playerID, result)
+}
+
+func (r *retryingStorage) GetPlayerStats(ctx context.Context, playerID model.PlayerID) (*model.PlayerStats, error) {
+	return retryValue(ctx, r.cfg, func() (*model.PlayerStats, error) {
+		return r.inner.GetPlayerStats(ctx, playerID)
+	})
+}
+
+// CreateLobby isn't retried, so a create that reached the backend is never
+// reported as ErrLobbyCodeTaken by its own retry
+func (r *retryingStorage) CreateLobby(ctx context.Context, lobby *model.Lobby) error {
+	return r.inner.CreateLobby(ctx, lobby)
+}
+
+func (r *retryingStorage) SaveLobby(ctx context.Context, lobby *model.Lobby) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SaveLobby(ctx, lobby)
+	})
+}
+
+func (r *retryingStorage) GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lobby, error) {
+	return retryValue(ctx, r.cfg, func() (*model.Lobby, error) {
+		return r.inner.GetLobby(ctx, code)
+	})
+}
+
+func (r *retryingStorage) DeleteLobby(ctx context.Context, code model.LobbyCode) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.DeleteLobby(ctx, code)
+	})
+}
+
+// RenameLobby isn't retried, since a retry after it succeeded finds the
+// old code gone
+func (r *retryingStorage) RenameLobby(ctx context.Context, oldCode, newCode model.LobbyCode, check func(*model.Lobby) error) error {
+	return r.inner.RenameLobby(ctx, oldCode, newCode, check)
+}
+
+func (r *retryingStorage) LobbyExists(ctx context.Context, code model.LobbyCode) (bool, error) {
+	return retryValue(ctx, r.cfg, func() (bool, error) {
+		return r.inner.LobbyExists(ctx, code)
+	})
+}
+
+func (r *retryingStorage) GetLobbyForPlayer(ctx context.Context, playerID model.PlayerID) (model.LobbyCode, error) {
+	return retryValue(ctx, r.cfg, func() (model.LobbyCode, error) {
+		return r.inner.GetLobbyForPlayer(ctx, playerID)
+	})
+}
+
+func (r *retryingStorage) CountLobbies(ctx context.Context) (int, error) {
+	return retryValue(ctx, r.cfg, func() (int, error) {
+		return r.inner.CountLobbies(ctx)
+	})
+}
+
+// AppendLobbyEvent isn't retried, so an event is never recorded twice
+func (r *retryingStorage) AppendLobbyEvent(ctx context.Context, event *model.Event) error {
+	return r.inner.AppendLobbyEvent(ctx, event)
+}
+
+func (r *retryingStorage) GetLobbyEvents(ctx context.Context, code model.LobbyCode) ([]*model.Event, error) {
+	return retryValue(ctx, r.cfg, func() ([]*model.Event, error) {
+		return r.inner.GetLobbyEvents(ctx, code)
+	})
+}
+
+// CreateGame isn't retried, so a create that reached the backend is never
+// reported as ErrGameExists by its own retry
+func (r *retryingStorage) CreateGame(ctx context.Context, game *model.Game) error {
+	return r.inner.CreateGame(ctx, game)
+}
+
+func (r *retryingStorage) SaveGame(ctx context.Context, game *model.Game) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SaveGame(ctx, game)
+	})
+}
+
+func (r *retryingStorage) GetGame(ctx context.Context, id model.GameID) (*model.Game, error) {
+	return retryValue(ctx, r.cfg, func() (*model.Game, error) {
+		return r.inner.GetGame(ctx, id)
+	})
+}
+
+func (r *retryingStorage) DeleteGame(ctx context.Context, id model.GameID) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.DeleteGame(ctx, id)
+	})
+}
+
+func (r *retryingStorage) CountGames(ctx context.Context) (int, error) {
+	return retryValue(ctx, r.cfg, func() (int, error) {
+		return r.inner.CountGames(ctx)
+	})
+}
+
+func (r *retryingStorage) SaveBoard(ctx context.Context, board *model.Board) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SaveBoard(ctx, board)
+	})
+}
+
+func (r *retryingStorage) GetBoard(ctx context.Context, gameID model.GameID, playerID model.PlayerID) (*model.Board, error) {
+	return retryValue(ctx, r.cfg, func() (*model.Board, error) {
+		return r.inner.GetBoard(ctx, gameID, playerID)
+	})
+}
+
+func (r *retryingStorage) GetBoardsForGame(ctx context.Context, gameID model.GameID) ([]*model.Board, error) {
+	return retryValue(ctx, r.cfg, func() ([]*model.Board, error) {
+		return r.inner.GetBoardsForGame(ctx, gameID)
+	})
+}
+
+func (r *retryingStorage) DeleteBoardsForGame(ctx context.Context, gameID model.GameID) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.DeleteBoardsForGame(ctx, gameID)
+	})
+}
+
+func (r *retryingStorage) GetDictionaryWords(ctx context.Context) ([]string, error) {
+	return retryValue(ctx, r.cfg, func() ([]string, error) {
+		return r.inner.GetDictionaryWords(ctx)
+	})
+}
+
+func (r *retryingStorage) SaveDictionaryWords(ctx context.Context, words []string) error {
+	return Retry(ctx, r.cfg, func() error {
+		return r.inner.SaveDictionaryWords(ctx, words)
+	})
+}
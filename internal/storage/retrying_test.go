@@ -0,0 +1,86 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/storage"
+	"github.com/mcoot/crosswordgame-go2/internal/storage/memory"
+)
+
+// flakyStorage fails the next failures lobby reads or event appends with err
+type flakyStorage struct {
+	storage.Storage
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyStorage) GetLobby(ctx context.Context, code model.LobbyCode) (*model.Lobby, error) {
+	f.calls++
+	if f.failures > 0 {
+		f.failures--
+		return nil, f.err
+	}
+	return f.Storage.GetLobby(ctx, code)
+}
+
+func (f *flakyStorage) AppendLobbyEvent(ctx context.Context, event *model.Event) error {
+	f.calls++
+	if f.failures > 0 {
+		f.failures--
+		return f.err
+	}
+	return f.Storage.AppendLobbyEvent(ctx, event)
+}
+
+func newFlakyStorage(t *testing.T, failures int, err error) *flakyStorage {
+	store := memory.New()
+	require.NoError(t, store.SaveLobby(t.Context(), &model.Lobby{Code: "ABC123"}))
+	return &flakyStorage{Storage: store, failures: failures, err: err}
+}
+
+var errConnReset = errors.New("connection reset")
+
+func TestWithRetryRecoversFromTransientErrors(t *testing.T) {
+	flaky := newFlakyStorage(t, 2, errors.Join(storage.ErrTransient, errConnReset))
+	store := storage.WithRetry(flaky, storage.RetryConfig{Attempts: 3})
+
+	lobby, err := store.GetLobby(t.Context(), "ABC123")
+	require.NoError(t, err)
+	assert.Equal(t, model.LobbyCode("ABC123"), lobby.Code)
+	assert.Equal(t, 3, flaky.calls)
+}
+
+func TestWithRetryGivesUpAfterAttempts(t *testing.T) {
+	flaky := newFlakyStorage(t, 5, errors.Join(storage.ErrTransient, errConnReset))
+	store := storage.WithRetry(flaky, storage.RetryConfig{Attempts: 3})
+
+	_, err := store.GetLobby(t.Context(), "ABC123")
+	assert.True(t, storage.IsTransient(err))
+	assert.ErrorIs(t, err, errConnReset)
+	assert.Equal(t, 3, flaky.calls)
+}
+
+func TestWithRetryDoesNotRetryPermanentErrors(t *testing.T) {
+	flaky := newFlakyStorage(t, 0, nil)
+	store := storage.WithRetry(flaky, storage.RetryConfig{Attempts: 3})
+
+	_, err := store.GetLobby(t.Context(), "NOPE00")
+	assert.ErrorIs(t, err, model.ErrLobbyNotFound)
+	assert.Equal(t, 1, flaky.calls)
+}
+
+func TestWithRetryDoesNotRetryAppends(t *testing.T) {
+	flaky := newFlakyStorage(t, 1, errors.Join(storage.ErrTransient, errConnReset))
+	store := storage.WithRetry(flaky, storage.RetryConfig{Attempts: 3})
+
+	err := store.AppendLobbyEvent(t.Context(), &model.Event{LobbyCode: "ABC123"})
+	assert.True(t, storage.IsTransient(err))
+	assert.Equal(t, 1, flaky.calls)
+}
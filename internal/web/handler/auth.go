@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 	"github.com/mcoot/crosswordgame-go2/internal/web/middleware"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/layout"
@@ -99,6 +101,11 @@ func (h *AuthHandler) CreateGuest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	session, err := h.authService.CreateGuestPlayer(r.Context(), displayName)
+	if errors.Is(err, model.ErrDisplayNameNotAllowed) {
+		middleware.SetFlash(w, "error", "That display name is not allowed")
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
 	if err != nil {
 		middleware.SetFlash(w, "error", "Failed to create guest player")
 		http.Redirect(w, r, "/", http.StatusSeeOther)
@@ -193,21 +200,28 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	session, err := h.authService.RegisterPlayer(r.Context(), username, password, displayName)
+	session, recoveryCode, err := h.authService.RegisterPlayer(r.Context(), username, password, displayName)
 	if err != nil {
 		// Check for specific errors
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "already exists") {
 			fieldErrors["username"] = "Username already taken"
 			h.renderRegisterError(w, r, "", username, displayName, fieldErrors)
+		} else if errors.Is(err, model.ErrDisplayNameNotAllowed) {
+			fieldErrors["display_name"] = "That display name is not allowed"
+			h.renderRegisterError(w, r, "", username, displayName, fieldErrors)
 		} else {
 			h.renderRegisterError(w, r, "Registration failed: "+errMsg, username, displayName, nil)
 		}
 		return
 	}
 
+	// The recovery code is only ever shown here
+	welcome := "Account created! Welcome, " + session.Player.DisplayName + "!" +
+		" Your password recovery code is " + recoveryCode + " - keep it somewhere safe, it won't be shown again."
+
 	h.setSessionCookie(w, session.Token)
-	middleware.SetFlash(w, "success", "Account created! Welcome, "+session.Player.DisplayName+"!")
+	middleware.SetFlash(w, "success", welcome)
 	http.Redirect(w, r, "/", http.StatusSeeOther)
 }
 
@@ -6,17 +6,18 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gorilla/mux"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/board"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 	"github.com/mcoot/crosswordgame-go2/internal/services/game"
 	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
 	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
 	"github.com/mcoot/crosswordgame-go2/internal/web/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/web/request"
 	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/components"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/layout"
@@ -25,25 +26,27 @@ import (
 
 // GameHandler handles game pages and actions
 type GameHandler struct {
-	lobbyController *lobby.Controller
-	gameController  *game.Controller
-	boardService    *board.Service
-	scoringService  *scoring.Service
-	botService      *bot.Service
-	hubManager      *sse.HubManager
-	broadcaster     *sse.Broadcaster
+	lobbyController   *lobby.Controller
+	gameController    *game.Controller
+	boardService      *board.Service
+	scoringService    *scoring.Service
+	botService        *bot.Service
+	dictionaryService *dictionary.Service
+	hubManager        *sse.HubManager
+	broadcaster       *sse.Broadcaster
 }
 
 // NewGameHandler creates a new GameHandler
-func NewGameHandler(lobbyController *lobby.Controller, gameController *game.Controller, boardService *board.Service, scoringService *scoring.Service, botService *bot.Service, hubManager *sse.HubManager, logger *slog.Logger) *GameHandler {
+func NewGameHandler(lobbyController *lobby.Controller, gameController *game.Controller, boardService *board.Service, scoringService *scoring.Service, botService *bot.Service, dictionaryService *dictionary.Service, hubManager *sse.HubManager, logger *slog.Logger) *GameHandler {
 	return &GameHandler{
-		lobbyController: lobbyController,
-		gameController:  gameController,
-		boardService:    boardService,
-		scoringService:  scoringService,
-		botService:      botService,
-		hubManager:      hubManager,
-		broadcaster:     sse.NewBroadcaster(hubManager, logger),
+		lobbyController:   lobbyController,
+		gameController:    gameController,
+		boardService:      boardService,
+		scoringService:    scoringService,
+		botService:        botService,
+		dictionaryService: dictionaryService,
+		hubManager:        hubManager,
+		broadcaster:       sse.NewBroadcaster(hubManager, logger),
 	}
 }
 
@@ -94,22 +97,43 @@ func (h *GameHandler) View(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Loading the game page acknowledges the start, for games whose first
+	// announce waits until everyone has it open
+	if isInGame && g.StartAcks != nil && !g.StartAcks[player.ID] {
+		if allReady, err := h.gameController.AckStart(r.Context(), g.ID, player.ID); err == nil && allReady {
+			h.allReady(r.Context(), code, g.ID)
+		}
+	}
+
 	// Get player's board (if they're a player)
 	var myBoard *model.Board
 	if isInGame {
 		myBoard, _ = h.boardService.GetBoard(r.Context(), g.ID, player.ID)
 	}
 
-	// Check if current player is the announcer
-	isAnnouncer := g.CurrentAnnouncer() == player.ID
+	// Check if current player is the announcer and has placed this turn
+	obligations := h.gameController.TurnObligations(g, player.ID)
+	isAnnouncer := obligations.IsMyTurn
+	hasPlaced := obligations.HasPlaced
 
-	// Check if player has placed this turn
-	hasPlaced := g.Placements[player.ID]
+	// Staged placement awaiting confirmation, if any
+	var pending *model.Position
+	if pos, ok := g.PendingPlacement[player.ID]; ok {
+		pending = &pos
+	}
+
+	// Letter hints for the announcer (informational only)
+	var letterScores map[rune]float64
+	if isAnnouncer && g.State == model.GameStateAnnouncing && h.dictionaryService != nil {
+		letterScores = h.dictionaryService.LetterScores()
+	}
 
-	// For spectators or scoring, get all boards
+	// For spectators or scoring, get all boards. Lobbies can withhold them
+	// from spectators until the game is scored
+	spectatorSeesBoards := isSpectator && lob.Config.SpectatorsSeeBoards()
 	var allBoards map[model.PlayerID]*model.Board
 	var boardsList []*model.Board
-	if isSpectator || g.State == model.GameStateScoring {
+	if spectatorSeesBoards || g.State == model.GameStateScoring {
 		boardsList, _ = h.boardService.GetBoardsForGame(r.Context(), g.ID)
 		allBoards = make(map[model.PlayerID]*model.Board)
 		for _, b := range boardsList {
@@ -117,12 +141,27 @@ func (h *GameHandler) View(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Calculate scores if game is complete
+	// Calculate scores if game is complete, unless they're awaiting reveal
 	var scores []model.BoardScore
+	var scoringUnavailable bool
 	var winner model.PlayerID
-	if g.State == model.GameStateScoring && len(boardsList) > 0 {
-		scores = h.scoringService.ScoreMultipleBoards(boardsList)
-		winner = h.scoringService.DetermineWinner(scores)
+	if g.State == model.GameStateScoring && !g.ScoresHidden() && len(boardsList) > 0 {
+		if err := h.scoringService.CheckDictionary(); err != nil {
+			scoringUnavailable = true
+		} else {
+			scores = h.scoringService.ScoreMultipleBoardsWithOptions(boardsList, scoring.OptionsForGame(g))
+			winner, _ = h.scoringService.ResolveWinner(scores, g.PlacementLatency)
+		}
+	}
+
+	// The result line comes from the game's summary, so it reads the same as
+	// the lobby history, tie-break included
+	var result string
+	if len(scores) > 0 {
+		if summary, err := h.gameSummary(r.Context(), lob, g.ID); err == nil {
+			result = summary.Result
+			winner = summary.Winner
+		}
 	}
 
 	// Build player names map from lobby members
@@ -131,6 +170,12 @@ func (h *GameHandler) View(w http.ResponseWriter, r *http.Request) {
 		playerNames[m.Player.ID] = m.Player.DisplayName
 	}
 
+	inSpectatorChat := lob.InSpectatorChat(player.ID)
+	var spectatorChat []model.SpectatorChatPayload
+	if inSpectatorChat {
+		spectatorChat, _ = h.lobbyController.GetSpectatorChat(r.Context(), lob.Code, player.ID)
+	}
+
 	flash := middleware.GetFlash(r.Context())
 	activeLobbyCode := middleware.GetActiveLobbyCode(r.Context())
 
@@ -141,17 +186,25 @@ func (h *GameHandler) View(w http.ResponseWriter, r *http.Request) {
 			Flash:           flash,
 			ActiveLobbyCode: activeLobbyCode,
 		},
-		Lobby:       lob,
-		Game:        g,
-		MyBoard:     myBoard,
-		IsAnnouncer: isAnnouncer,
-		HasPlaced:   hasPlaced,
-		IsSpectator: isSpectator || !isInGame,
-		IsHost:      isHost,
-		AllBoards:   allBoards,
-		Scores:      scores,
-		Winner:      winner,
-		PlayerNames: playerNames,
+		Lobby:              lob,
+		Game:               g,
+		MyBoard:            myBoard,
+		IsAnnouncer:        isAnnouncer,
+		HasPlaced:          hasPlaced,
+		Pending:            pending,
+		IsSpectator:        isSpectator || !isInGame,
+		IsHost:             isHost,
+		AllBoards:          allBoards,
+		Scores:             scores,
+		Winner:             winner,
+		Result:             result,
+		ScoresHidden:       g.ScoresHidden(),
+		ScoringUnavailable: scoringUnavailable,
+		PlayerNames:        playerNames,
+		LetterScores:       letterScores,
+		Rack:               g.Racks[player.ID],
+		InSpectatorChat:    inSpectatorChat,
+		SpectatorChat:      spectatorChat,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -171,20 +224,14 @@ func (h *GameHandler) Start(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	code := model.LobbyCode(vars["code"])
 
-	_, err := h.lobbyController.StartGame(r.Context(), code, player.ID)
+	g, err := h.lobbyController.StartGame(r.Context(), code, player.ID)
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not start game: "+err.Error())
 		http.Redirect(w, r, "/lobby/"+string(code), http.StatusSeeOther)
 		return
 	}
 
-	// Broadcast game started to all lobby clients
-	h.broadcaster.BroadcastGameStarted(code)
-
-	// Process bot actions after game start
-	if lob, err := h.lobbyController.GetLobby(r.Context(), code); err == nil && lob.CurrentGame != nil {
-		h.processBotActions(r.Context(), *lob.CurrentGame, code)
-	}
+	h.gameStarted(r.Context(), code, g)
 
 	// Use HX-Redirect for HTMX-aware client-side navigation
 	w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
@@ -208,13 +255,12 @@ func (h *GameHandler) Announce(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	letterStr := strings.ToUpper(strings.TrimSpace(r.FormValue("letter")))
-	if len(letterStr) != 1 {
-		middleware.SetFlash(w, "error", "Please select a letter")
+	form, err := request.DecodeAnnounce(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", err.Error())
 		http.Redirect(w, r, "/lobby/"+string(code)+"/game", http.StatusSeeOther)
 		return
 	}
-	letter := rune(letterStr[0])
 
 	// Get the current game
 	lob, err := h.lobbyController.GetLobby(r.Context(), code)
@@ -224,7 +270,7 @@ func (h *GameHandler) Announce(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.gameController.AnnounceLetter(r.Context(), *lob.CurrentGame, player.ID, letter)
+	err = h.gameController.AnnounceLetter(r.Context(), *lob.CurrentGame, player.ID, form.Letter)
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not announce letter: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
@@ -262,16 +308,52 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	row, err := strconv.Atoi(r.FormValue("row"))
+	form, err := request.DecodePlace(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", err.Error())
+		http.Redirect(w, r, "/lobby/"+string(code)+"/game", http.StatusSeeOther)
+		return
+	}
+
+	// Get the current game
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil || lob.CurrentGame == nil {
+		middleware.SetFlash(w, "error", "No game in progress")
+		http.Redirect(w, r, "/lobby/"+string(code), http.StatusSeeOther)
+		return
+	}
+
+	result, err := h.gameController.PlaceLetterWithResult(r.Context(), *lob.CurrentGame, player.ID, form.Pos)
 	if err != nil {
-		middleware.SetFlash(w, "error", "Invalid row")
+		middleware.SetFlash(w, "error", "Could not place letter: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.respondToPlacement(w, r, lob, player.ID, result.TurnAdvanced)
+}
+
+// AnnouncePlace handles the announcer announcing a letter and placing it in one action
+func (h *GameHandler) AnnouncePlace(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	if err := r.ParseForm(); err != nil {
+		middleware.SetFlash(w, "error", "Invalid form data")
 		http.Redirect(w, r, "/lobby/"+string(code)+"/game", http.StatusSeeOther)
 		return
 	}
 
-	col, err := strconv.Atoi(r.FormValue("col"))
+	form, err := request.DecodeAnnouncePlace(r.Form)
 	if err != nil {
-		middleware.SetFlash(w, "error", "Invalid column")
+		middleware.SetFlash(w, "error", err.Error())
 		http.Redirect(w, r, "/lobby/"+string(code)+"/game", http.StatusSeeOther)
 		return
 	}
@@ -284,30 +366,106 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pos := model.Position{Row: row, Col: col}
-	err = h.gameController.PlaceLetter(r.Context(), *lob.CurrentGame, player.ID, pos)
+	result, err := h.gameController.AnnounceAndPlace(r.Context(), *lob.CurrentGame, player.ID, form.Letter, form.Pos)
 	if err != nil {
-		middleware.SetFlash(w, "error", "Could not place letter: "+err.Error())
+		middleware.SetFlash(w, "error", "Could not announce letter: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Other players still need to place the announced letter
+	if !result.TurnAdvanced {
+		h.broadcaster.BroadcastLetterAnnounced(r.Context(), result.Game, code)
+	}
+
+	h.respondToPlacement(w, r, lob, player.ID, result.TurnAdvanced)
+}
+
+// ConfirmPlacement commits the player's staged placement
+func (h *GameHandler) ConfirmPlacement(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil || lob.CurrentGame == nil {
+		middleware.SetFlash(w, "error", "No game in progress")
+		http.Redirect(w, r, "/lobby/"+string(code), http.StatusSeeOther)
+		return
+	}
+
+	result, err := h.gameController.ConfirmPlacementWithResult(r.Context(), *lob.CurrentGame, player.ID)
+	if err != nil {
+		middleware.SetFlash(w, "error", "Could not confirm placement: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.respondToPlacement(w, r, lob, player.ID, result.TurnAdvanced)
+}
+
+// CancelPlacement discards the player's staged placement so they can choose again
+func (h *GameHandler) CancelPlacement(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	lob, err := h.lobbyController.GetLobby(r.Context(), code)
+	if err != nil || lob.CurrentGame == nil {
+		middleware.SetFlash(w, "error", "No game in progress")
+		http.Redirect(w, r, "/lobby/"+string(code), http.StatusSeeOther)
+		return
+	}
+
+	err = h.gameController.CancelPlacement(r.Context(), *lob.CurrentGame, player.ID)
+	if err != nil {
+		middleware.SetFlash(w, "error", "Could not cancel placement: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
+	h.respondToPlacement(w, r, lob, player.ID, false)
+}
+
+// respondToPlacement broadcasts the result of a placement action and returns
+// OOB swaps to update the acting player's UI immediately. turnAdvanced is
+// whether the action completed the turn.
+func (h *GameHandler) respondToPlacement(w http.ResponseWriter, r *http.Request, lob *model.Lobby, playerID model.PlayerID, turnAdvanced bool) {
+	code := lob.Code
+
 	// Get updated game and board state
 	g, _ := h.gameController.GetGame(r.Context(), *lob.CurrentGame)
-	board, _ := h.boardService.GetBoard(r.Context(), *lob.CurrentGame, player.ID)
+	board, _ := h.boardService.GetBoard(r.Context(), *lob.CurrentGame, playerID)
 
 	if g != nil {
 		// Broadcast placement count to other players via SSE
-		h.broadcaster.BroadcastPlacementUpdate(r.Context(), g, code, player.ID)
+		h.broadcaster.BroadcastPlacementUpdate(r.Context(), g, code, playerID)
 
 		// Check if game advanced state
-		switch g.State {
-		case model.GameStateScoring:
+		switch {
+		case !turnAdvanced:
+		case g.State == model.GameStateScoring:
 			h.broadcaster.BroadcastGameComplete(code)
-		case model.GameStateAnnouncing:
-			// All placed, new turn started - tell clients to refresh
+			h.scheduleAutoDismiss(code, g.ID)
+		case g.IsSimultaneous():
+			// The next turn starts with its letter already drawn
 			h.broadcaster.BroadcastTurnComplete(r.Context(), g, code)
+		default:
+			// All placed, new turn started - update clients in place
+			h.broadcaster.BroadcastScoreboardUpdate(r.Context(), g, code, getPlayerName(lob, g.CurrentAnnouncer()))
 		}
 	}
 
@@ -316,27 +474,39 @@ func (h *GameHandler) Place(w http.ResponseWriter, r *http.Request) {
 		h.processBotActions(r.Context(), *lob.CurrentGame, code)
 	}
 
-	// Return OOB swaps to update the placing player's UI immediately
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 
+	if g == nil || board == nil {
+		return
+	}
+
+	var pending *model.Position
+	if pos, ok := g.PendingPlacement[playerID]; ok {
+		pending = &pos
+	}
+	hasPlaced := g.Placements[playerID]
+
 	var buf bytes.Buffer
 
-	// 1. Updated game board (shows placed letter, disables remaining cells)
+	// 1. Updated game board (shows placed or staged letter)
 	buf.WriteString(`<div id="game-board" hx-swap-oob="true">`)
-	_ = components.GameBoard(code, board, g, true).Render(r.Context(), &buf)
+	_ = components.GameBoard(code, board, g, hasPlaced, pending).Render(r.Context(), &buf)
 	buf.WriteString(`</div>`)
 
 	// 2. Updated game status ("Waiting for other players...")
 	buf.WriteString(`<div id="game-status" hx-swap-oob="true">`)
 	announcerName := getPlayerName(lob, g.CurrentAnnouncer())
-	_ = components.GameStatus(g, false, true, announcerName).Render(r.Context(), &buf)
+	_ = components.GameStatus(g, false, hasPlaced, announcerName).Render(r.Context(), &buf)
 	buf.WriteString(`</div>`)
 
 	// 3. Updated placement count
-	if g != nil && g.State == model.GameStatePlacing {
+	if g.State == model.GameStatePlacing {
 		placedCount := countPlacements(g)
 		buf.WriteString(`<div id="placement-status" hx-swap-oob="true" class="text-muted">`)
 		buf.WriteString(strconv.Itoa(placedCount) + "/" + strconv.Itoa(len(g.Players)) + " players have placed")
+		if len(g.PendingPlacement) > 0 {
+			buf.WriteString(" (" + strconv.Itoa(len(g.PendingPlacement)) + " pending)")
+		}
 		buf.WriteString(`</div>`)
 	}
 
@@ -371,6 +541,31 @@ func (h *GameHandler) Abandon(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Reveal handles the host revealing the scores of a delayed-reveal game
+func (h *GameHandler) Reveal(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	if _, err := h.lobbyController.RevealScores(r.Context(), code, player.ID); err != nil {
+		middleware.SetFlash(w, "error", "Could not reveal scores: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Broadcast scores-revealed so all clients re-render the results together
+	h.broadcaster.BroadcastScoresRevealed(code)
+
+	w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // Dismiss handles dismissing game scores and returning to lobby
 func (h *GameHandler) Dismiss(w http.ResponseWriter, r *http.Request) {
 	player := middleware.GetPlayer(r.Context())
@@ -408,27 +603,25 @@ func (h *GameHandler) Dismiss(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Complete the game (saves summary to history and returns lobby to waiting state)
-	err = h.lobbyController.CompleteGame(r.Context(), code)
-	if err != nil {
-		middleware.SetFlash(w, "error", "Could not dismiss game: "+err.Error())
-		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
-		w.WriteHeader(http.StatusNoContent)
-		return
-	}
-
-	// If start_new flag is set, start a new game immediately
+	// If start_new flag is set, replay with the same players in one step
 	if startNew {
-		_, err = h.lobbyController.StartGame(r.Context(), code, player.ID)
+		g, err := h.lobbyController.Rematch(r.Context(), code, player.ID)
 		if err != nil {
 			middleware.SetFlash(w, "error", "Could not start new game: "+err.Error())
-			h.broadcaster.BroadcastGameDismissed(code)
-			w.Header().Set("HX-Redirect", "/lobby/"+string(code))
+			w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		// Broadcast game started so all clients go to game page
-		h.broadcaster.BroadcastGameStarted(code)
+		h.gameStarted(r.Context(), code, g)
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Complete the game (saves summary to history and returns lobby to waiting state)
+	err = h.lobbyController.CompleteGame(r.Context(), code)
+	if err != nil {
+		middleware.SetFlash(w, "error", "Could not dismiss game: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
 		w.WriteHeader(http.StatusNoContent)
 		return
@@ -442,18 +635,37 @@ func (h *GameHandler) Dismiss(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// processBotActions runs bot actions and broadcasts SSE updates
-func (h *GameHandler) processBotActions(ctx context.Context, gameID model.GameID, code model.LobbyCode) {
-	if h.botService == nil {
-		return
+// gameSummary returns the summary of a finished game: the one stored in the
+// lobby's history once the game has been completed, or else the summary it
+// will be completed with
+func (h *GameHandler) gameSummary(ctx context.Context, lob *model.Lobby, gameID model.GameID) (*model.GameSummary, error) {
+	for i := range lob.GameHistory {
+		if lob.GameHistory[i].ID == gameID {
+			return &lob.GameHistory[i], nil
+		}
 	}
+	return h.gameController.CreateGameSummary(ctx, gameID)
+}
 
-	actions, err := h.botService.ProcessBotActions(ctx, gameID)
-	if err != nil || len(actions) == 0 {
+// gameStarted announces a newly started game, starts its watchers and lets
+// any bots act. Games started by the host, by a rematch and by a join
+// filling the lobby all go through it.
+func (h *GameHandler) gameStarted(ctx context.Context, code model.LobbyCode, g *model.Game) {
+	h.broadcaster.BroadcastGameStarted(code)
+	h.watchAnnounceTimeout(code, g.ID)
+	h.watchGameDuration(g.ID)
+	h.watchStartAckTimeout(code, g)
+	h.processBotActions(ctx, g.ID, code)
+}
+
+// processBotActions runs bot actions, broadcasting an SSE update as each one
+// happens. With bot think time configured the actions play out in the background.
+func (h *GameHandler) processBotActions(ctx context.Context, gameID model.GameID, code model.LobbyCode) {
+	if h.botService == nil {
 		return
 	}
 
-	for _, action := range actions {
+	h.botService.RunBotActions(ctx, gameID, func(ctx context.Context, action bot.BotAction) {
 		switch action.Type {
 		case bot.ActionAnnounce:
 			g, err := h.gameController.GetGame(ctx, gameID)
@@ -472,8 +684,49 @@ func (h *GameHandler) processBotActions(ctx context.Context, gameID model.GameID
 			}
 		case bot.ActionGameComplete:
 			h.broadcaster.BroadcastGameComplete(code)
+			h.scheduleAutoDismiss(code, gameID)
 		}
-	}
+	})
+}
+
+// scheduleAutoDismiss sends everyone back to the lobby if the host leaves a
+// finished game's scores up past the configured auto-dismiss delay
+func (h *GameHandler) scheduleAutoDismiss(code model.LobbyCode, gameID model.GameID) {
+	h.lobbyController.ScheduleAutoDismiss(code, gameID, func() {
+		h.broadcaster.BroadcastGameDismissed(code)
+	})
+}
+
+// watchAnnounceTimeout passes the turn on whenever an announcer stalls past
+// the configured announce timeout, letting a bot that is handed it act
+func (h *GameHandler) watchAnnounceTimeout(code model.LobbyCode, gameID model.GameID) {
+	h.lobbyController.WatchAnnounceTimeout(gameID, func() {
+		h.broadcaster.BroadcastRefresh(code)
+		h.processBotActions(context.Background(), gameID, code)
+	})
+}
+
+// watchGameDuration abandons the game if it runs past the configured
+// maximum game duration
+func (h *GameHandler) watchGameDuration(gameID model.GameID) {
+	h.lobbyController.WatchGameDuration(gameID, func(code model.LobbyCode) {
+		h.broadcaster.BroadcastGameTimedOut(code)
+	})
+}
+
+// watchStartAckTimeout lets the first announce go ahead once the wait for
+// start acknowledgements times out
+func (h *GameHandler) watchStartAckTimeout(code model.LobbyCode, g *model.Game) {
+	h.gameController.WatchStartAckTimeout(g, func() {
+		h.allReady(context.Background(), code, g.ID)
+	})
+}
+
+// allReady tells clients the first letter can be announced, and lets a bot
+// announcer make it
+func (h *GameHandler) allReady(ctx context.Context, code model.LobbyCode, gameID model.GameID) {
+	h.broadcaster.BroadcastAllReady(code)
+	h.processBotActions(ctx, gameID, code)
 }
 
 // countPlacements counts how many players have placed in the current turn
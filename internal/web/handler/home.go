@@ -3,17 +3,24 @@ package handler
 import (
 	"net/http"
 
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
 	"github.com/mcoot/crosswordgame-go2/internal/web/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/web/templates/components"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/layout"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/pages"
 )
 
 // HomeHandler handles the home page
-type HomeHandler struct{}
+type HomeHandler struct {
+	lobbyController *lobby.Controller
+}
 
 // NewHomeHandler creates a new HomeHandler
-func NewHomeHandler() *HomeHandler {
-	return &HomeHandler{}
+func NewHomeHandler(lobbyController *lobby.Controller) *HomeHandler {
+	return &HomeHandler{
+		lobbyController: lobbyController,
+	}
 }
 
 // Home renders the home page
@@ -23,6 +30,14 @@ func (h *HomeHandler) Home(w http.ResponseWriter, r *http.Request) {
 	activeLobbyCode := middleware.GetActiveLobbyCode(r.Context())
 	next := r.URL.Query().Get("next")
 
+	// Offer to resume an in-progress game
+	var resumeLobbyCode model.LobbyCode
+	if player != nil {
+		if code, gameID, err := h.lobbyController.GetActiveGame(r.Context(), player.ID); err == nil && gameID != "" {
+			resumeLobbyCode = code
+		}
+	}
+
 	data := pages.HomeData{
 		PageData: layout.PageData{
 			Title:           "Home",
@@ -30,7 +45,9 @@ func (h *HomeHandler) Home(w http.ResponseWriter, r *http.Request) {
 			Flash:           flash,
 			ActiveLobbyCode: activeLobbyCode,
 		},
-		Next: next,
+		Next:            next,
+		ResumeLobbyCode: resumeLobbyCode,
+		GridSizes:       h.lobbyController.GridSizes(),
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -38,3 +55,21 @@ func (h *HomeHandler) Home(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 	}
 }
+
+// NotFound renders the not found page for paths with no route
+func (h *HomeHandler) NotFound(w http.ResponseWriter, r *http.Request) {
+	data := components.ErrorPageData{
+		PageData: layout.PageData{
+			Title:           "Not Found",
+			Player:          middleware.GetPlayer(r.Context()),
+			Flash:           middleware.GetFlash(r.Context()),
+			ActiveLobbyCode: middleware.GetActiveLobbyCode(r.Context()),
+		},
+		ErrorCode:    http.StatusNotFound,
+		ErrorMessage: "We couldn't find that page.",
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusNotFound)
+	_ = components.ErrorPage(data).Render(r.Context(), w)
+}
@@ -1,9 +1,10 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -13,6 +14,7 @@ import (
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
 	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
 	"github.com/mcoot/crosswordgame-go2/internal/web/middleware"
+	"github.com/mcoot/crosswordgame-go2/internal/web/request"
 	"github.com/mcoot/crosswordgame-go2/internal/web/sse"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/layout"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/pages"
@@ -25,16 +27,20 @@ type LobbyHandler struct {
 	botService      *bot.Service
 	hubManager      *sse.HubManager
 	broadcaster     *sse.Broadcaster
+	// games runs the games lobby changes start or hand to bots, the same way
+	// as games started from the game page
+	games *GameHandler
 }
 
 // NewLobbyHandler creates a new LobbyHandler
-func NewLobbyHandler(lobbyController *lobby.Controller, authService *auth.Service, botService *bot.Service, hubManager *sse.HubManager, logger *slog.Logger) *LobbyHandler {
+func NewLobbyHandler(lobbyController *lobby.Controller, authService *auth.Service, botService *bot.Service, games *GameHandler, hubManager *sse.HubManager, logger *slog.Logger) *LobbyHandler {
 	return &LobbyHandler{
 		lobbyController: lobbyController,
 		authService:     authService,
 		botService:      botService,
 		hubManager:      hubManager,
 		broadcaster:     sse.NewBroadcaster(hubManager, logger),
+		games:           games,
 	}
 }
 
@@ -52,11 +58,11 @@ func (h *LobbyHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gridSize := 5 // default
-	if gs := r.FormValue("grid_size"); gs != "" {
-		if parsed, err := strconv.Atoi(gs); err == nil && parsed >= 2 && parsed <= 7 {
-			gridSize = parsed
-		}
+	form, err := request.DecodeCreateLobby(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", err.Error())
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
 	}
 
 	lob, err := h.lobbyController.CreateLobby(r.Context(), *player)
@@ -66,9 +72,15 @@ func (h *LobbyHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Update config with grid size (non-fatal if it fails, continue with default config)
-	cfg := model.LobbyConfig{GridSize: gridSize}
-	_ = h.lobbyController.UpdateConfig(r.Context(), lob.Code, player.ID, cfg)
+	// Apply the chosen grid size; an out-of-bounds size keeps the default config
+	if form.GridSize != 0 {
+		cfg := model.LobbyConfig{GridSize: form.GridSize}
+		if err := h.lobbyController.UpdateConfig(r.Context(), lob.Code, player.ID, cfg); err != nil {
+			middleware.SetFlash(w, "error", "Lobby created, but "+err.Error())
+			http.Redirect(w, r, "/lobby/"+string(lob.Code), http.StatusSeeOther)
+			return
+		}
+	}
 
 	middleware.SetFlash(w, "success", "Lobby created!")
 	http.Redirect(w, r, "/lobby/"+string(lob.Code), http.StatusSeeOther)
@@ -97,6 +109,11 @@ func (h *LobbyHandler) JoinByForm(w http.ResponseWriter, r *http.Request) {
 
 	lobbyCode := model.LobbyCode(code)
 	err := h.lobbyController.JoinLobby(r.Context(), lobbyCode, *player)
+	if errors.Is(err, model.ErrAlreadyInLobby) {
+		// A repeated join just takes the player back to the lobby
+		http.Redirect(w, r, "/lobby/"+code, http.StatusSeeOther)
+		return
+	}
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not join lobby: "+err.Error())
 		http.Redirect(w, r, "/", http.StatusSeeOther)
@@ -107,6 +124,11 @@ func (h *LobbyHandler) JoinByForm(w http.ResponseWriter, r *http.Request) {
 	lob, _ := h.lobbyController.GetLobby(r.Context(), lobbyCode)
 	if lob != nil {
 		h.broadcaster.BroadcastMemberListUpdate(r.Context(), lob)
+		if lob.StartedByJoin(player.ID) {
+			h.onAutoStart(r.Context(), lobbyCode, *lob.CurrentGame)
+			http.Redirect(w, r, "/lobby/"+code+"/game", http.StatusSeeOther)
+			return
+		}
 	}
 
 	middleware.SetFlash(w, "success", "Joined lobby!")
@@ -151,6 +173,11 @@ func (h *LobbyHandler) View(w http.ResponseWriter, r *http.Request) {
 
 		// Broadcast member list update
 		h.broadcaster.BroadcastMemberListUpdate(r.Context(), lob)
+		if lob.StartedByJoin(player.ID) {
+			h.onAutoStart(r.Context(), code, *lob.CurrentGame)
+			http.Redirect(w, r, "/lobby/"+string(code)+"/game", http.StatusSeeOther)
+			return
+		}
 	}
 
 	flash := middleware.GetFlash(r.Context())
@@ -203,6 +230,11 @@ func (h *LobbyHandler) Leave(w http.ResponseWriter, r *http.Request) {
 		h.broadcaster.BroadcastMemberListUpdate(r.Context(), lob)
 	}
 
+	// The game may now be waiting on a bot, or on one playing for the leaver
+	if lob != nil && lob.CurrentGame != nil {
+		h.games.processBotActions(r.Context(), *lob.CurrentGame, code)
+	}
+
 	middleware.SetFlash(w, "info", "You left the lobby")
 	// Use HX-Redirect for HTMX-aware client-side navigation
 	w.Header().Set("HX-Redirect", "/")
@@ -225,15 +257,15 @@ func (h *LobbyHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gridSize := 5
-	if gs := r.FormValue("grid_size"); gs != "" {
-		if parsed, err := strconv.Atoi(gs); err == nil && parsed >= 2 && parsed <= 7 {
-			gridSize = parsed
-		}
+	cfg, err := request.DecodeLobbyConfig(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", "Could not update config: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
+		w.WriteHeader(http.StatusNoContent)
+		return
 	}
 
-	cfg := model.LobbyConfig{GridSize: gridSize}
-	err := h.lobbyController.UpdateConfig(r.Context(), code, player.ID, cfg)
+	err = h.lobbyController.UpdateConfig(r.Context(), code, player.ID, cfg)
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not update config: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
@@ -265,23 +297,15 @@ func (h *LobbyHandler) SetRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	targetPlayerID := model.PlayerID(r.FormValue("player_id"))
-	roleStr := r.FormValue("role")
-
-	var role model.LobbyMemberRole
-	switch roleStr {
-	case "player":
-		role = model.RolePlayer
-	case "spectator":
-		role = model.RoleSpectator
-	default:
-		middleware.SetFlash(w, "error", "Invalid role")
+	form, err := request.DecodeSetRole(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	err := h.lobbyController.SetRole(r.Context(), code, targetPlayerID, role)
+	err = h.lobbyController.SetRole(r.Context(), code, form.PlayerID, form.Role)
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not change role: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
@@ -296,6 +320,69 @@ func (h *LobbyHandler) SetRole(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// SetReady handles a member marking themselves ready (or not) for the next game
+func (h *LobbyHandler) SetReady(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form, err := request.DecodeSetReady(r.Form)
+	if err != nil {
+		middleware.SetFlash(w, "error", err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := h.lobbyController.SetReady(r.Context(), code, player.ID, form.Ready); err != nil {
+		middleware.SetFlash(w, "error", "Could not update ready state: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Broadcast refresh so each client's own ready button reflects its state
+	h.broadcaster.BroadcastRefresh(code)
+
+	// SSE broadcast handles the UI update, so just return 204
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SpectatorChat handles a message to the spectator chat, which only the
+// lobby's spectators and host see
+func (h *LobbyHandler) SpectatorChat(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	lob, chat, err := h.lobbyController.SendSpectatorChat(r.Context(), code, player.ID, r.FormValue("message"))
+	if err != nil {
+		middleware.SetFlash(w, "error", "Could not send message: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SSE appends the message to every chat log, including the sender's
+	h.broadcaster.BroadcastSpectatorChat(r.Context(), lob, chat)
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // TransferHost handles host transfer
 func (h *LobbyHandler) TransferHost(w http.ResponseWriter, r *http.Request) {
 	player := middleware.GetPlayer(r.Context())
@@ -330,6 +417,31 @@ func (h *LobbyHandler) TransferHost(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ShuffleSeats handles randomizing the member order before a game
+func (h *LobbyHandler) ShuffleSeats(w http.ResponseWriter, r *http.Request) {
+	player := middleware.GetPlayer(r.Context())
+	if player == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	code := model.LobbyCode(vars["code"])
+
+	if _, err := h.lobbyController.ShuffleSeats(r.Context(), code, player.ID); err != nil {
+		middleware.SetFlash(w, "error", "Could not shuffle seats: "+err.Error())
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Broadcast refresh so all clients see the new order
+	h.broadcaster.BroadcastRefresh(code)
+
+	// SSE broadcast handles the UI update, so just return 204
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // AddBot handles adding a bot to the lobby
 func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 	player := middleware.GetPlayer(r.Context())
@@ -347,11 +459,9 @@ func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 	}
 
 	strategy := r.FormValue("strategy")
-	if strategy == "" {
-		strategy = model.BotStrategyRandom
-	}
+	difficulty := model.BotDifficulty(r.FormValue("difficulty"))
 
-	_, err := h.botService.AddBotToLobby(r.Context(), code, player.ID, strategy)
+	botPlayer, err := h.botService.AddBotToLobby(r.Context(), code, player.ID, strategy, difficulty)
 	if err != nil {
 		middleware.SetFlash(w, "error", "Could not add bot: "+err.Error())
 		w.Header().Set("HX-Redirect", "/lobby/"+string(code))
@@ -359,6 +469,13 @@ func (h *LobbyHandler) AddBot(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if lob, err := h.lobbyController.GetLobby(r.Context(), code); err == nil && lob.StartedByJoin(botPlayer.ID) {
+		h.onAutoStart(r.Context(), code, *lob.CurrentGame)
+		w.Header().Set("HX-Redirect", "/lobby/"+string(code)+"/game")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
 	// Broadcast refresh so host controls render correctly with per-player context
 	h.broadcaster.BroadcastRefresh(code)
 
@@ -425,3 +542,13 @@ func (h *LobbyHandler) Events(w http.ResponseWriter, r *http.Request) {
 	// Serve SSE connection
 	sse.ServeSSE(w, r, hub, player.ID)
 }
+
+// onAutoStart hands a game started by a join filling the lobby to the game
+// handler, which announces and watches it as if the host had started it
+func (h *LobbyHandler) onAutoStart(ctx context.Context, code model.LobbyCode, gameID model.GameID) {
+	g, err := h.games.gameController.GetGame(ctx, gameID)
+	if err != nil {
+		return
+	}
+	h.games.gameStarted(ctx, code, g)
+}
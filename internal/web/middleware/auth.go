@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/mcoot/crosswordgame-go2/internal/middleware"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 )
@@ -34,6 +35,7 @@ func Auth(authService *auth.Service) func(http.Handler) http.Handler {
 				return
 			}
 
+			middleware.AnnotatePlayer(r.Context(), player.ID)
 			ctx := context.WithValue(r.Context(), playerContextKey, player)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -46,6 +48,9 @@ func OptionalAuth(authService *auth.Service) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			player := getPlayerFromSession(r, authService)
+			if player != nil {
+				middleware.AnnotatePlayer(r.Context(), player.ID)
+			}
 			ctx := context.WithValue(r.Context(), playerContextKey, player)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
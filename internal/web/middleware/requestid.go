@@ -0,0 +1,12 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/mcoot/crosswordgame-go2/internal/middleware"
+)
+
+// RequestID creates request ID middleware for the web interface
+func RequestID() func(http.Handler) http.Handler {
+	return middleware.RequestID()
+}
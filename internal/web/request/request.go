@@ -0,0 +1,219 @@
+// Package request decodes and validates web form submissions into typed values
+package request
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
+
+// ValidationError reports a form field that is missing or invalid
+// The message is suitable for showing to the player as a flash.
+type ValidationError struct {
+	Field   string
+	Message string
+	// Err is the underlying model error, if any
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func invalid(field, message string) error {
+	return &ValidationError{Field: field, Message: message}
+}
+
+// Announce is the form for announcing a letter
+type Announce struct {
+	Letter rune
+}
+
+// DecodeAnnounce parses the letter field, accepting one letter in either case
+func DecodeAnnounce(form url.Values) (Announce, error) {
+	letter, err := decodeLetter(form)
+	if err != nil {
+		return Announce{}, err
+	}
+	return Announce{Letter: letter}, nil
+}
+
+// Place is the form for placing the announced letter
+type Place struct {
+	Pos model.Position
+}
+
+// DecodePlace parses the row and col fields
+// Only negative values are rejected here; the game checks the grid size.
+func DecodePlace(form url.Values) (Place, error) {
+	pos, err := decodePosition(form)
+	if err != nil {
+		return Place{}, err
+	}
+	return Place{Pos: pos}, nil
+}
+
+// AnnouncePlace is the form for announcing a letter and placing it at once
+type AnnouncePlace struct {
+	Letter rune
+	Pos    model.Position
+}
+
+// DecodeAnnouncePlace parses the letter, row and col fields
+func DecodeAnnouncePlace(form url.Values) (AnnouncePlace, error) {
+	letter, err := decodeLetter(form)
+	if err != nil {
+		return AnnouncePlace{}, err
+	}
+	pos, err := decodePosition(form)
+	if err != nil {
+		return AnnouncePlace{}, err
+	}
+	return AnnouncePlace{Letter: letter, Pos: pos}, nil
+}
+
+// CreateLobby is the form for creating a lobby
+type CreateLobby struct {
+	GridSize int // 0 if not chosen, keeping the default
+}
+
+// DecodeCreateLobby parses the optional grid_size field
+// Grid size bounds are checked by the lobby controller.
+func DecodeCreateLobby(form url.Values) (CreateLobby, error) {
+	gridSize, err := decodeInt(form, "grid_size", "Grid size", false)
+	if err != nil {
+		return CreateLobby{}, err
+	}
+	return CreateLobby{GridSize: gridSize}, nil
+}
+
+// DecodeLobbyConfig parses the lobby settings form
+// Unchecked checkboxes turn settings off, and a blank player cap is unlimited.
+func DecodeLobbyConfig(form url.Values) (model.LobbyConfig, error) {
+	gridSize, err := decodeInt(form, "grid_size", "Grid size", true)
+	if err != nil {
+		return model.LobbyConfig{}, err
+	}
+	maxPlayers, err := decodeInt(form, "max_players", "Max players", false)
+	if err != nil {
+		return model.LobbyConfig{}, err
+	}
+	minHumans, err := decodeInt(form, "min_human_players", "Min human players", false)
+	if err != nil {
+		return model.LobbyConfig{}, err
+	}
+	seed, err := decodeInt(form, "seed", "Seed", false)
+	if err != nil {
+		return model.LobbyConfig{}, err
+	}
+
+	return model.LobbyConfig{
+		GridSize:            gridSize,
+		RequireConfirm:      form.Get("require_confirm") == "on",
+		RequireEdgeAnchored: form.Get("require_edge_anchored") == "on",
+		DelayedReveal:       form.Get("delayed_reveal") == "on",
+		MaxPlayers:          maxPlayers,
+		AutoStart:           form.Get("auto_start") == "on",
+		PlacementMode:       model.PlacementMode(form.Get("placement_mode")),
+		HideSpectatorBoards: form.Get("spectators_see_boards") != "on",
+		RequireReady:        form.Get("require_ready") == "on",
+		Mode:                model.GameMode(form.Get("mode")),
+		MinHumanPlayers:     minHumans,
+		HideLetterHistory:   form.Get("show_letter_history") != "on",
+		Seed:                int64(seed),
+		LateJoinRole:        model.LobbyMemberRole(form.Get("late_join_role")),
+	}, nil
+}
+
+// SetRole is the form for changing a member's role
+type SetRole struct {
+	PlayerID model.PlayerID
+	Role     model.LobbyMemberRole
+}
+
+// DecodeSetRole parses the player_id and role fields
+func DecodeSetRole(form url.Values) (SetRole, error) {
+	playerID := strings.TrimSpace(form.Get("player_id"))
+	if playerID == "" {
+		return SetRole{}, invalid("player_id", "Player is required")
+	}
+
+	var role model.LobbyMemberRole
+	switch form.Get("role") {
+	case "player":
+		role = model.RolePlayer
+	case "spectator":
+		role = model.RoleSpectator
+	default:
+		return SetRole{}, invalid("role", "Invalid role")
+	}
+
+	return SetRole{PlayerID: model.PlayerID(playerID), Role: role}, nil
+}
+
+// SetReady is the form for readying up or standing down
+type SetReady struct {
+	Ready bool
+}
+
+// DecodeSetReady parses the ready field, which must be "true" or "false"
+func DecodeSetReady(form url.Values) (SetReady, error) {
+	switch form.Get("ready") {
+	case "true":
+		return SetReady{Ready: true}, nil
+	case "false":
+		return SetReady{Ready: false}, nil
+	default:
+		return SetReady{}, invalid("ready", "Invalid ready state")
+	}
+}
+
+// decodeLetter parses a single letter, uppercased
+// Whether the letter is in the game's alphabet is checked by the game.
+func decodeLetter(form url.Values) (rune, error) {
+	letter, err := model.ParseLetter(form.Get("letter"))
+	if err != nil {
+		return 0, &ValidationError{Field: "letter", Message: "Please select a letter", Err: err}
+	}
+	return letter, nil
+}
+
+// decodePosition parses the row and col fields as board coordinates
+func decodePosition(form url.Values) (model.Position, error) {
+	row, err := strconv.Atoi(form.Get("row"))
+	if err != nil || row < 0 {
+		return model.Position{}, invalid("row", "Invalid row")
+	}
+	col, err := strconv.Atoi(form.Get("col"))
+	if err != nil || col < 0 {
+		return model.Position{}, invalid("col", "Invalid column")
+	}
+	return model.Position{Row: row, Col: col}, nil
+}
+
+// decodeInt parses a non-negative integer field
+// A blank optional field decodes as 0.
+func decodeInt(form url.Values, field, label string, required bool) (int, error) {
+	raw := strings.TrimSpace(form.Get(field))
+	if raw == "" {
+		if required {
+			return 0, invalid(field, label+" is required")
+		}
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, invalid(field, label+" must be a whole number")
+	}
+	if n < 0 {
+		return 0, invalid(field, label+" must not be negative")
+	}
+	return n, nil
+}
@@ -0,0 +1,163 @@
+package request
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mcoot/crosswordgame-go2/internal/model"
+)
+
+func requireFieldError(t *testing.T, err error, field string) {
+	t.Helper()
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, field, verr.Field)
+	assert.NotEmpty(t, verr.Message)
+}
+
+func TestDecodeAnnounce(t *testing.T) {
+	form, err := DecodeAnnounce(url.Values{"letter": {" q "}})
+	require.NoError(t, err)
+	assert.Equal(t, 'Q', form.Letter)
+
+	_, err = DecodeAnnounce(url.Values{})
+	requireFieldError(t, err, "letter")
+
+	_, err = DecodeAnnounce(url.Values{"letter": {"AB"}})
+	requireFieldError(t, err, "letter")
+	assert.ErrorIs(t, err, model.ErrInvalidLetter)
+
+	_, err = DecodeAnnounce(url.Values{"letter": {"7"}})
+	requireFieldError(t, err, "letter")
+}
+
+func TestDecodePlace(t *testing.T) {
+	form, err := DecodePlace(url.Values{"row": {"2"}, "col": {"0"}})
+	require.NoError(t, err)
+	assert.Equal(t, model.Position{Row: 2, Col: 0}, form.Pos)
+
+	tests := []struct {
+		name  string
+		form  url.Values
+		field string
+	}{
+		{"missing row", url.Values{"col": {"1"}}, "row"},
+		{"missing col", url.Values{"row": {"1"}}, "col"},
+		{"non-numeric row", url.Values{"row": {"x"}, "col": {"1"}}, "row"},
+		{"non-numeric col", url.Values{"row": {"1"}, "col": {"1.5"}}, "col"},
+		{"negative row", url.Values{"row": {"-1"}, "col": {"1"}}, "row"},
+		{"negative col", url.Values{"row": {"1"}, "col": {"-3"}}, "col"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodePlace(tt.form)
+			requireFieldError(t, err, tt.field)
+		})
+	}
+}
+
+func TestDecodeAnnouncePlace(t *testing.T) {
+	form, err := DecodeAnnouncePlace(url.Values{"letter": {"e"}, "row": {"1"}, "col": {"3"}})
+	require.NoError(t, err)
+	assert.Equal(t, 'E', form.Letter)
+	assert.Equal(t, model.Position{Row: 1, Col: 3}, form.Pos)
+
+	_, err = DecodeAnnouncePlace(url.Values{"row": {"1"}, "col": {"3"}})
+	requireFieldError(t, err, "letter")
+
+	_, err = DecodeAnnouncePlace(url.Values{"letter": {"E"}, "row": {"one"}, "col": {"3"}})
+	requireFieldError(t, err, "row")
+}
+
+func TestDecodeCreateLobby(t *testing.T) {
+	form, err := DecodeCreateLobby(url.Values{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, form.GridSize)
+
+	form, err = DecodeCreateLobby(url.Values{"grid_size": {"7"}})
+	require.NoError(t, err)
+	assert.Equal(t, 7, form.GridSize)
+
+	_, err = DecodeCreateLobby(url.Values{"grid_size": {"big"}})
+	requireFieldError(t, err, "grid_size")
+
+	_, err = DecodeCreateLobby(url.Values{"grid_size": {"-5"}})
+	requireFieldError(t, err, "grid_size")
+}
+
+func TestDecodeLobbyConfig(t *testing.T) {
+	cfg, err := DecodeLobbyConfig(url.Values{
+		"grid_size":             {"6"},
+		"require_confirm":       {"on"},
+		"delayed_reveal":        {"on"},
+		"max_players":           {"4"},
+		"auto_start":            {"on"},
+		"spectators_see_boards": {"on"},
+		"require_ready":         {"on"},
+		"show_letter_history":   {"on"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.LobbyConfig{
+		GridSize:       6,
+		RequireConfirm: true,
+		DelayedReveal:  true,
+		MaxPlayers:     4,
+		AutoStart:      true,
+		RequireReady:   true,
+	}, cfg)
+
+	// A blank player cap is unlimited
+	cfg, err = DecodeLobbyConfig(url.Values{"grid_size": {"5"}, "max_players": {""}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.MaxPlayers)
+	// Like the other checkboxes, leaving it unticked turns it off
+	assert.False(t, cfg.SpectatorsSeeBoards())
+	assert.False(t, cfg.ShowsLetterHistory())
+
+	_, err = DecodeLobbyConfig(url.Values{})
+	requireFieldError(t, err, "grid_size")
+
+	_, err = DecodeLobbyConfig(url.Values{"grid_size": {"five"}})
+	requireFieldError(t, err, "grid_size")
+
+	_, err = DecodeLobbyConfig(url.Values{"grid_size": {"5"}, "max_players": {"lots"}})
+	requireFieldError(t, err, "max_players")
+
+	_, err = DecodeLobbyConfig(url.Values{"grid_size": {"5"}, "max_players": {"-1"}})
+	requireFieldError(t, err, "max_players")
+}
+
+func TestDecodeSetRole(t *testing.T) {
+	form, err := DecodeSetRole(url.Values{"player_id": {"p1"}, "role": {"spectator"}})
+	require.NoError(t, err)
+	assert.Equal(t, model.PlayerID("p1"), form.PlayerID)
+	assert.Equal(t, model.RoleSpectator, form.Role)
+
+	_, err = DecodeSetRole(url.Values{"role": {"player"}})
+	requireFieldError(t, err, "player_id")
+
+	_, err = DecodeSetRole(url.Values{"player_id": {"p1"}})
+	requireFieldError(t, err, "role")
+
+	_, err = DecodeSetRole(url.Values{"player_id": {"p1"}, "role": {"host"}})
+	requireFieldError(t, err, "role")
+}
+
+func TestDecodeSetReady(t *testing.T) {
+	form, err := DecodeSetReady(url.Values{"ready": {"true"}})
+	require.NoError(t, err)
+	assert.True(t, form.Ready)
+
+	form, err = DecodeSetReady(url.Values{"ready": {"false"}})
+	require.NoError(t, err)
+	assert.False(t, form.Ready)
+
+	_, err = DecodeSetReady(url.Values{})
+	requireFieldError(t, err, "ready")
+
+	_, err = DecodeSetReady(url.Values{"ready": {"yes"}})
+	requireFieldError(t, err, "ready")
+}
@@ -3,12 +3,15 @@ package web
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/services/auth"
 	"github.com/mcoot/crosswordgame-go2/internal/services/board"
 	"github.com/mcoot/crosswordgame-go2/internal/services/bot"
+	"github.com/mcoot/crosswordgame-go2/internal/services/dictionary"
 	"github.com/mcoot/crosswordgame-go2/internal/services/game"
 	"github.com/mcoot/crosswordgame-go2/internal/services/lobby"
 	"github.com/mcoot/crosswordgame-go2/internal/services/scoring"
@@ -19,15 +22,20 @@ import (
 
 // RouterConfig holds configuration for the web router
 type RouterConfig struct {
-	Logger          *slog.Logger
-	AuthService     *auth.Service
-	LobbyController *lobby.Controller
-	GameController  *game.Controller
-	BoardService    *board.Service
-	ScoringService  *scoring.Service
-	BotService      *bot.Service
-	HubManager      *sse.HubManager
-	StaticDir       string // Path to static files directory
+	Logger            *slog.Logger
+	AuthService       *auth.Service
+	LobbyController   *lobby.Controller
+	GameController    *game.Controller
+	BoardService      *board.Service
+	ScoringService    *scoring.Service
+	BotService        *bot.Service
+	DictionaryService *dictionary.Service // Optional: for announcer letter hints
+	HubManager        *sse.HubManager
+	StaticDir         string // Path to static files directory
+
+	// SlowRequestThreshold is the duration above which requests are logged at WARN
+	// Optional: defaults to 500ms
+	SlowRequestThreshold time.Duration
 }
 
 // NewRouter creates a new web router with all routes configured
@@ -35,28 +43,30 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	r := mux.NewRouter()
 
 	// Create middleware
-	loggingMiddleware := middleware.Logging(cfg.Logger)
+	loggingMiddleware := middleware.Logging(cfg.Logger, cfg.SlowRequestThreshold)
 	recoveryMiddleware := middleware.Recovery(cfg.Logger)
+	requestIDMiddleware := middleware.RequestID()
 	flashMiddleware := middleware.Flash()
 	authMiddleware := middleware.Auth(cfg.AuthService)
 	optionalAuthMiddleware := middleware.OptionalAuth(cfg.AuthService)
 	activeLobbyMiddleware := middleware.ActiveLobby(cfg.LobbyController)
 
 	// Apply global middleware to all routes
+	r.Use(requestIDMiddleware)
 	r.Use(recoveryMiddleware)
 	r.Use(loggingMiddleware)
 
 	// Create SSE hub manager if not provided
 	hubManager := cfg.HubManager
 	if hubManager == nil {
-		hubManager = sse.NewHubManager(cfg.Logger)
+		hubManager = sse.NewHubManager(sse.DefaultHubConfig(), clock.New(), cfg.Logger)
 	}
 
 	// Create handlers
-	homeHandler := handler.NewHomeHandler()
+	homeHandler := handler.NewHomeHandler(cfg.LobbyController)
 	authHandler := handler.NewAuthHandler(cfg.AuthService)
-	lobbyHandler := handler.NewLobbyHandler(cfg.LobbyController, cfg.AuthService, cfg.BotService, hubManager, cfg.Logger)
-	gameHandler := handler.NewGameHandler(cfg.LobbyController, cfg.GameController, cfg.BoardService, cfg.ScoringService, cfg.BotService, hubManager, cfg.Logger)
+	gameHandler := handler.NewGameHandler(cfg.LobbyController, cfg.GameController, cfg.BoardService, cfg.ScoringService, cfg.BotService, cfg.DictionaryService, hubManager, cfg.Logger)
+	lobbyHandler := handler.NewLobbyHandler(cfg.LobbyController, cfg.AuthService, cfg.BotService, gameHandler, hubManager, cfg.Logger)
 
 	// Static files
 	if cfg.StaticDir != "" {
@@ -92,7 +102,10 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	protected.HandleFunc("/lobby/{code}/leave", lobbyHandler.Leave).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/config", lobbyHandler.UpdateConfig).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/role", lobbyHandler.SetRole).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/ready", lobbyHandler.SetReady).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/transfer-host", lobbyHandler.TransferHost).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/spectator-chat", lobbyHandler.SpectatorChat).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/shuffle-seats", lobbyHandler.ShuffleSeats).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/bots/add", lobbyHandler.AddBot).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/bots/remove", lobbyHandler.RemoveBot).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/events", lobbyHandler.Events).Methods(http.MethodGet)
@@ -102,8 +115,20 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	protected.HandleFunc("/lobby/{code}/game/start", gameHandler.Start).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/game/announce", gameHandler.Announce).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/game/place", gameHandler.Place).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/game/announce-place", gameHandler.AnnouncePlace).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/game/place/confirm", gameHandler.ConfirmPlacement).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/game/place/cancel", gameHandler.CancelPlacement).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/game/abandon", gameHandler.Abandon).Methods(http.MethodPost)
+	protected.HandleFunc("/lobby/{code}/game/reveal", gameHandler.Reveal).Methods(http.MethodPost)
 	protected.HandleFunc("/lobby/{code}/game/dismiss", gameHandler.Dismiss).Methods(http.MethodPost)
 
+	// Unmatched paths skip the router's middleware, so the not found page
+	// applies the public routes' middleware itself to show the player's nav
+	var notFound http.Handler = http.HandlerFunc(homeHandler.NotFound)
+	for _, mw := range []func(http.Handler) http.Handler{activeLobbyMiddleware, optionalAuthMiddleware, flashMiddleware, loggingMiddleware, recoveryMiddleware, requestIDMiddleware} {
+		notFound = mw(notFound)
+	}
+	r.NotFoundHandler = notFound
+
 	return r
 }
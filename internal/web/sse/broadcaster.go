@@ -3,7 +3,9 @@ package sse
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"log/slog"
+	"sort"
 	"strconv"
 
 	"github.com/mcoot/crosswordgame-go2/internal/model"
@@ -129,8 +131,13 @@ func (b *Broadcaster) BroadcastPlacementUpdate(ctx context.Context, game *model.
 	}
 	totalPlayers := len(game.Players)
 
+	status := strconv.Itoa(placedCount) + `/` + strconv.Itoa(totalPlayers) + ` players have placed`
+	if pending := len(game.PendingPlacement); pending > 0 {
+		status += ` (` + strconv.Itoa(pending) + ` pending)`
+	}
+
 	html := `<div id="placement-status" hx-swap-oob="true" class="text-muted">
-		` + strconv.Itoa(placedCount) + `/` + strconv.Itoa(totalPlayers) + ` players have placed
+		` + status + `
 	</div>`
 
 	hub.BroadcastEvent("placement-update", html)
@@ -148,6 +155,40 @@ func (b *Broadcaster) BroadcastTurnComplete(ctx context.Context, game *model.Gam
 	hub.BroadcastEvent("turn-complete", strconv.Itoa(game.CurrentTurn))
 }
 
+// BroadcastScoreboardUpdate broadcasts the new turn's status as OOB swaps so
+// clients update in place rather than reloading the whole game page.
+// Only the new announcer's page reloads, to show their letter picker.
+func (b *Broadcaster) BroadcastScoreboardUpdate(ctx context.Context, game *model.Game, lobbyCode model.LobbyCode, announcerName string) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	var status bytes.Buffer
+	err := components.GameStatus(game, false, false, announcerName).Render(ctx, &status)
+	if err != nil {
+		b.logger.Error("sse failed to render game status",
+			slog.String("lobby", string(lobbyCode)),
+			slog.Any("error", err))
+		return
+	}
+
+	var refresh bytes.Buffer
+	err = components.AnnouncerRefresh(lobbyCode, game.CurrentAnnouncer()).Render(ctx, &refresh)
+	if err != nil {
+		b.logger.Error("sse failed to render announcer refresh",
+			slog.String("lobby", string(lobbyCode)),
+			slog.Any("error", err))
+		return
+	}
+
+	// The placement count only applies while placing
+	html := WrapForOOBSwap("game-status", status.String()) +
+		`<div id="placement-status" hx-swap-oob="delete"></div>` +
+		refresh.String()
+	hub.BroadcastEvent("scoreboard-update", html)
+}
+
 // BroadcastGameComplete broadcasts that the game is complete
 // HTMX will trigger a page fetch via hx-trigger="sse:game-complete"
 func (b *Broadcaster) BroadcastGameComplete(lobbyCode model.LobbyCode) {
@@ -160,6 +201,126 @@ func (b *Broadcaster) BroadcastGameComplete(lobbyCode model.LobbyCode) {
 	hub.BroadcastEvent("game-complete", "complete")
 }
 
+// GameSummaryEvent is the JSON payload of the game-summary event
+type GameSummaryEvent struct {
+	GameID string             `json:"game_id"`
+	Winner string             `json:"winner,omitempty"` // Empty if tied
+	Tied   bool               `json:"tied,omitempty"`
+	Result string             `json:"result"` // Human-readable outcome
+	Scores []PlayerTotalEvent `json:"scores"` // Highest first
+}
+
+// PlayerTotalEvent is one player's final total in a GameSummaryEvent
+type PlayerTotalEvent struct {
+	PlayerID string `json:"player_id"`
+	Total    int    `json:"total"`
+}
+
+// BroadcastGameSummary broadcasts the results of a completed game as JSON
+// Unlike game-complete this carries the winner and totals, so lightweight
+// clients can show results without fetching the game. The web client ignores it.
+func (b *Broadcaster) BroadcastGameSummary(lobbyCode model.LobbyCode, summary *model.GameSummary) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	event := GameSummaryEvent{
+		GameID: string(summary.ID),
+		Winner: string(summary.Winner),
+		Tied:   summary.Tied,
+		Result: summary.Result,
+		Scores: make([]PlayerTotalEvent, 0, len(summary.FinalScores)),
+	}
+	for playerID, total := range summary.FinalScores {
+		event.Scores = append(event.Scores, PlayerTotalEvent{PlayerID: string(playerID), Total: total})
+	}
+	sort.Slice(event.Scores, func(i, j int) bool {
+		if event.Scores[i].Total != event.Scores[j].Total {
+			return event.Scores[i].Total > event.Scores[j].Total
+		}
+		return event.Scores[i].PlayerID < event.Scores[j].PlayerID
+	})
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		b.logger.Error("failed to encode game summary", slog.String("error", err.Error()))
+		return
+	}
+	hub.BroadcastEvent("game-summary", string(data))
+}
+
+// BoardRevealedEvent is the JSON payload of the reveal-board event
+type BoardRevealedEvent struct {
+	GameID     string     `json:"game_id"`
+	PlayerID   string     `json:"player_id"`
+	Cells      [][]string `json:"cells"` // Empty cells are empty strings
+	TotalScore int        `json:"total_score"`
+	Words      []string   `json:"words"`
+	Remaining  int        `json:"remaining"` // Scores still to reveal
+}
+
+// BroadcastBoardRevealed broadcasts one player's board and score as the host
+// reveals a delayed-reveal game's scores one at a time. The web client
+// ignores it.
+func (b *Broadcaster) BroadcastBoardRevealed(lobbyCode model.LobbyCode, gameID model.GameID, board *model.Board, score model.BoardScore, remaining int) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	event := BoardRevealedEvent{
+		GameID:     string(gameID),
+		PlayerID:   string(score.PlayerID),
+		Cells:      make([][]string, board.Size),
+		TotalScore: score.TotalScore,
+		Words:      make([]string, 0, len(score.Words)),
+		Remaining:  remaining,
+	}
+	for row := range board.Cells {
+		event.Cells[row] = make([]string, board.Size)
+		for col, r := range board.Cells[row] {
+			if r != 0 {
+				event.Cells[row][col] = string(r)
+			}
+		}
+	}
+	for _, w := range score.Words {
+		event.Words = append(event.Words, w.Word)
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		b.logger.Error("failed to encode revealed board", slog.String("error", err.Error()))
+		return
+	}
+	hub.BroadcastEvent("reveal-board", string(data))
+}
+
+// BroadcastAllReady broadcasts that the game's first letter may now be
+// announced, because every player has acknowledged the start or the wait for
+// them timed out
+func (b *Broadcaster) BroadcastAllReady(lobbyCode model.LobbyCode) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	hub.BroadcastEvent("all-ready", "ready")
+}
+
+// BroadcastScoresRevealed broadcasts that the host has revealed a delayed-reveal game's scores
+// HTMX will trigger a page fetch via hx-trigger="sse:scores-revealed"
+func (b *Broadcaster) BroadcastScoresRevealed(lobbyCode model.LobbyCode) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	// Send simple signal - HTMX will fetch the page
+	hub.BroadcastEvent("scores-revealed", "revealed")
+}
+
 // BroadcastGameAbandoned broadcasts that the game has been abandoned
 // HTMX will trigger a fetch to the lobby page via hx-trigger="sse:game-abandoned"
 func (b *Broadcaster) BroadcastGameAbandoned(lobbyCode model.LobbyCode) {
@@ -172,6 +333,18 @@ func (b *Broadcaster) BroadcastGameAbandoned(lobbyCode model.LobbyCode) {
 	hub.BroadcastEvent("game-abandoned", "abandoned")
 }
 
+// BroadcastGameTimedOut broadcasts that the game was abandoned for running
+// past the maximum game duration. It is a game-abandoned event, so clients
+// react as they would to the host abandoning it.
+func (b *Broadcaster) BroadcastGameTimedOut(lobbyCode model.LobbyCode) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	hub.BroadcastEvent("game-abandoned", "timeout")
+}
+
 // BroadcastRefresh tells all clients to refresh the page
 // HTMX will trigger a page fetch via hx-trigger="sse:refresh"
 func (b *Broadcaster) BroadcastRefresh(lobbyCode model.LobbyCode) {
@@ -184,6 +357,17 @@ func (b *Broadcaster) BroadcastRefresh(lobbyCode model.LobbyCode) {
 	hub.BroadcastEvent("refresh", "refresh")
 }
 
+// BroadcastCodeChanged tells clients of a lobby that it has moved to newCode
+// The event data is the new code, so pages can navigate to the new URL
+func (b *Broadcaster) BroadcastCodeChanged(oldCode, newCode model.LobbyCode) {
+	hub := b.hubManager.GetHub(oldCode)
+	if hub == nil {
+		return
+	}
+
+	hub.BroadcastEvent("code-changed", string(newCode))
+}
+
 // BroadcastGameDismissed broadcasts that the game scores have been dismissed
 // HTMX will trigger a fetch to the lobby page via hx-trigger="sse:game-dismissed"
 func (b *Broadcaster) BroadcastGameDismissed(lobbyCode model.LobbyCode) {
@@ -195,3 +379,41 @@ func (b *Broadcaster) BroadcastGameDismissed(lobbyCode model.LobbyCode) {
 	// Send simple signal - HTMX will fetch the lobby page
 	hub.BroadcastEvent("game-dismissed", "dismissed")
 }
+
+// BroadcastToSpectators sends an event only to the lobby's spectators and
+// its host, so spectators can talk among themselves without reaching the
+// other players. Roles are taken from lobby when called, so a member who has
+// switched role since connecting is targeted by their current one.
+func (b *Broadcaster) BroadcastToSpectators(lobby *model.Lobby, eventName, data string) {
+	hub := b.hubManager.GetHub(lobby.Code)
+	if hub == nil {
+		return
+	}
+
+	hub.BroadcastEventTo(eventName, data, lobby.InSpectatorChat)
+}
+
+// BroadcastSpectatorChat appends a spectator chat message to the chat log of
+// everyone in the spectator chat
+func (b *Broadcaster) BroadcastSpectatorChat(ctx context.Context, lobby *model.Lobby, chat *model.SpectatorChatPayload) {
+	var buf bytes.Buffer
+	if err := components.SpectatorChatMessage(*chat).Render(ctx, &buf); err != nil {
+		b.logger.Error("sse failed to render spectator chat message",
+			slog.String("lobby", string(lobby.Code)),
+			slog.Any("error", err))
+		return
+	}
+
+	b.BroadcastToSpectators(lobby, "spectator-chat", WrapForOOBAppend(components.SpectatorChatLogID, buf.String()))
+}
+
+// SendToPlayer sends an event to a single player in a lobby, such as an
+// update to their own rack or pending placement
+func (b *Broadcaster) SendToPlayer(lobbyCode model.LobbyCode, playerID model.PlayerID, eventName, data string) {
+	hub := b.hubManager.GetHub(lobbyCode)
+	if hub == nil {
+		return
+	}
+
+	hub.SendToPlayer(playerID, eventName, data)
+}
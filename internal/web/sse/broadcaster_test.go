@@ -2,10 +2,13 @@ package sse
 
 import (
 	"context"
+	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/testutil"
 )
@@ -49,7 +52,7 @@ func TestWrapForOOBSwap(t *testing.T) {
 }
 
 func TestBroadcaster_BroadcastMemberListUpdate(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	// Create a lobby
@@ -103,7 +106,7 @@ func TestBroadcaster_BroadcastMemberListUpdate(t *testing.T) {
 }
 
 func TestBroadcaster_BroadcastGameStarted(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("GAME1")
@@ -136,7 +139,7 @@ func TestBroadcaster_BroadcastGameStarted(t *testing.T) {
 }
 
 func TestBroadcaster_BroadcastLetterAnnounced(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("GAME2")
@@ -177,7 +180,7 @@ func TestBroadcaster_BroadcastLetterAnnounced(t *testing.T) {
 }
 
 func TestBroadcaster_BroadcastPlacementUpdate(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("GAME3")
@@ -222,7 +225,7 @@ func TestBroadcaster_BroadcastPlacementUpdate(t *testing.T) {
 }
 
 func TestBroadcaster_BroadcastTurnComplete(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("GAME4")
@@ -256,8 +259,53 @@ func TestBroadcaster_BroadcastTurnComplete(t *testing.T) {
 	manager.RemoveHub(lobbyCode)
 }
 
+func TestBroadcaster_BroadcastScoreboardUpdate(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
+
+	lobbyCode := model.LobbyCode("GAME7")
+	game := &model.Game{
+		ID:           "game1",
+		State:        model.GameStateAnnouncing,
+		Players:      []model.PlayerID{"player1", "player2"},
+		CurrentTurn:  1,
+		AnnouncerIdx: 1,
+	}
+
+	// Create hub and client
+	hub := manager.GetOrCreateHub(lobbyCode)
+	client := NewClient(hub, "player1")
+	hub.Register(client)
+	time.Sleep(10 * time.Millisecond)
+
+	ctx := context.Background()
+	broadcaster.BroadcastScoreboardUpdate(ctx, game, lobbyCode, "Bob")
+
+	select {
+	case msg := <-client.send:
+		msgStr := string(msg)
+		if !strings.Contains(msgStr, "event: scoreboard-update") {
+			t.Errorf("message does not contain event name: %s", msgStr)
+		}
+		if !strings.Contains(msgStr, `<div id="game-status" hx-swap-oob="true">`) {
+			t.Errorf("message does not contain OOB-wrapped game status: %s", msgStr)
+		}
+		if !strings.Contains(msgStr, "Bob is choosing a letter") {
+			t.Errorf("message does not name the new announcer: %s", msgStr)
+		}
+		// Only the new announcer's page reloads
+		if !strings.Contains(msgStr, `id="announcer-refresh-player2"`) {
+			t.Errorf("message does not target the new announcer: %s", msgStr)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive message")
+	}
+
+	manager.RemoveHub(lobbyCode)
+}
+
 func TestBroadcaster_BroadcastGameComplete(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("GAME5")
@@ -289,8 +337,59 @@ func TestBroadcaster_BroadcastGameComplete(t *testing.T) {
 	manager.RemoveHub(lobbyCode)
 }
 
+func TestBroadcaster_BroadcastGameSummary(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
+
+	lobbyCode := model.LobbyCode("GAME1")
+
+	// Create hub and client
+	hub := manager.GetOrCreateHub(lobbyCode)
+	client := NewClient(hub, "player1")
+	hub.Register(client)
+	time.Sleep(10 * time.Millisecond)
+
+	broadcaster.BroadcastGameSummary(lobbyCode, &model.GameSummary{
+		ID:          "G1",
+		FinalScores: map[model.PlayerID]int{"player1": 4, "player2": 9},
+		Winner:      "player2",
+	})
+
+	select {
+	case msg := <-client.send:
+		msgStr := string(msg)
+		if !strings.Contains(msgStr, "event: game-summary") {
+			t.Errorf("message does not contain event name: %s", msgStr)
+		}
+
+		_, data, ok := strings.Cut(strings.TrimSpace(msgStr), "data: ")
+		if !ok {
+			t.Fatalf("message has no data: %s", msgStr)
+		}
+		var event GameSummaryEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			t.Fatalf("data is not JSON: %v", err)
+		}
+		want := GameSummaryEvent{
+			GameID: "G1",
+			Winner: "player2",
+			Scores: []PlayerTotalEvent{
+				{PlayerID: "player2", Total: 9},
+				{PlayerID: "player1", Total: 4},
+			},
+		}
+		if !reflect.DeepEqual(event, want) {
+			t.Errorf("event = %+v, want %+v", event, want)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive message")
+	}
+
+	manager.RemoveHub(lobbyCode)
+}
+
 func TestBroadcaster_BroadcastRefresh(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("REFRESH")
@@ -322,8 +421,38 @@ func TestBroadcaster_BroadcastRefresh(t *testing.T) {
 	manager.RemoveHub(lobbyCode)
 }
 
+func TestBroadcaster_BroadcastCodeChanged(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
+
+	oldCode := model.LobbyCode("OLDCODE")
+
+	// Clients are connected under the old code
+	hub := manager.GetOrCreateHub(oldCode)
+	client := NewClient(hub, "player1")
+	hub.Register(client)
+	time.Sleep(10 * time.Millisecond)
+
+	broadcaster.BroadcastCodeChanged(oldCode, "NEWCODE")
+
+	select {
+	case msg := <-client.send:
+		msgStr := string(msg)
+		if !strings.Contains(msgStr, "event: code-changed") {
+			t.Errorf("message does not contain event name: %s", msgStr)
+		}
+		if !strings.Contains(msgStr, "data: NEWCODE") {
+			t.Errorf("message does not contain new code: %s", msgStr)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client did not receive message")
+	}
+
+	manager.RemoveHub(oldCode)
+}
+
 func TestBroadcaster_BroadcastGameAbandoned(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	lobbyCode := model.LobbyCode("ABANDON")
@@ -355,8 +484,55 @@ func TestBroadcaster_BroadcastGameAbandoned(t *testing.T) {
 	manager.RemoveHub(lobbyCode)
 }
 
+func TestBroadcaster_BroadcastToSpectators(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
+
+	lobby := &model.Lobby{
+		Code: "SPECT",
+		Members: []model.LobbyMember{
+			{Player: model.Player{ID: "host1", DisplayName: "Alice"}, Role: model.RolePlayer, IsHost: true},
+			{Player: model.Player{ID: "player1", DisplayName: "Dave"}, Role: model.RolePlayer},
+			{Player: model.Player{ID: "spectator1", DisplayName: "Bob"}, Role: model.RoleSpectator},
+			{Player: model.Player{ID: "spectator2", DisplayName: "Carol"}, Role: model.RoleSpectator},
+		},
+	}
+
+	hub := manager.GetOrCreateHub(lobby.Code)
+	host := NewClient(hub, "host1")
+	player := NewClient(hub, "player1")
+	spectator := NewClient(hub, "spectator2")
+	hub.Register(host)
+	hub.Register(player)
+	hub.Register(spectator)
+	time.Sleep(10 * time.Millisecond)
+
+	broadcaster.BroadcastToSpectators(lobby, "spectator-chat", "hello from Bob")
+
+	// The other spectator and the host receive the message
+	for name, client := range map[string]*Client{"spectator": spectator, "host": host} {
+		select {
+		case msg := <-client.send:
+			if !strings.Contains(string(msg), "data: hello from Bob") {
+				t.Errorf("unexpected message to %s: %s", name, string(msg))
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Errorf("%s did not receive message", name)
+		}
+	}
+
+	// The other player does not
+	select {
+	case msg := <-player.send:
+		t.Errorf("player received spectator message: %s", string(msg))
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	manager.RemoveHub(lobby.Code)
+}
+
 func TestBroadcaster_NoHubDoesNotPanic(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 	broadcaster := NewBroadcaster(manager, testutil.NopLogger())
 
 	// These should not panic when hub doesn't exist
@@ -372,6 +548,7 @@ func TestBroadcaster_NoHubDoesNotPanic(t *testing.T) {
 	broadcaster.BroadcastGameComplete("NOEXIST")
 	broadcaster.BroadcastGameAbandoned("NOEXIST")
 	broadcaster.BroadcastRefresh("NOEXIST")
+	broadcaster.SendToPlayer("NOEXIST", "player1", "rack", "private")
 
 	// If we get here without panic, test passed
 }
@@ -8,13 +8,8 @@ import (
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 )
 
-const (
-	// Time between keepalive pings - reduced from 30s to 15s for better connection detection
-	pingPeriod = 15 * time.Second
-
-	// Buffer size for outgoing messages
-	sendBufferSize = 256
-)
+// Buffer size for outgoing messages
+const sendBufferSize = 256
 
 // Client represents a connected SSE client
 type Client struct {
@@ -22,6 +17,9 @@ type Client struct {
 	playerID    model.PlayerID
 	send        chan []byte
 	connectedAt time.Time
+	// stalledSince is when the client's buffer was first found full, zero
+	// if it is keeping up. Only accessed by the hub's Run loop.
+	stalledSince time.Time
 }
 
 // NewClient creates a new SSE client
@@ -34,8 +32,9 @@ func NewClient(hub *Hub, playerID model.PlayerID) *Client {
 	}
 }
 
-// writeDeadlineExtension is the duration to extend the write deadline before each write.
-// This must be longer than pingPeriod to ensure the deadline doesn't expire between keepalives.
+// writeDeadlineExtension is the minimum duration to extend the write deadline
+// before each write. It is raised to twice the heartbeat interval if that is
+// longer, so the deadline doesn't expire between heartbeats.
 const writeDeadlineExtension = 30 * time.Second
 
 // ServeSSE handles the SSE connection for a client
@@ -50,6 +49,11 @@ func ServeSSE(w http.ResponseWriter, r *http.Request, hub *Hub, playerID model.P
 		return
 	}
 
+	if hub.Closed() {
+		http.Error(w, "Server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+
 	// Create response controller for deadline management (Go 1.20+)
 	// This allows us to extend the write deadline before each write,
 	// working around Go's WriteTimeout being an absolute deadline rather than per-write.
@@ -67,10 +71,13 @@ func ServeSSE(w http.ResponseWriter, r *http.Request, hub *Hub, playerID model.P
 	hub.Register(client)
 	defer hub.Unregister(client)
 
+	heartbeat := hub.cfg.HeartbeatInterval
+	deadlineExtension := max(writeDeadlineExtension, 2*heartbeat)
+
 	// Helper to write with deadline extension
 	writeWithDeadline := func(data []byte) error {
 		// Extend write deadline before each write to prevent timeout
-		if err := rc.SetWriteDeadline(time.Now().Add(writeDeadlineExtension)); err != nil {
+		if err := rc.SetWriteDeadline(time.Now().Add(deadlineExtension)); err != nil {
 			logger.Warn("sse failed to set write deadline", slog.Any("error", err))
 		}
 		_, err := w.Write(data)
@@ -91,8 +98,8 @@ func ServeSSE(w http.ResponseWriter, r *http.Request, hub *Hub, playerID model.P
 	}
 	flusher.Flush()
 
-	// Create ticker for keepalive
-	ticker := time.NewTicker(pingPeriod)
+	// Create ticker for heartbeats
+	ticker := time.NewTicker(heartbeat)
 	defer ticker.Stop()
 
 	// Handle client connection
@@ -111,9 +118,9 @@ func ServeSSE(w http.ResponseWriter, r *http.Request, hub *Hub, playerID model.P
 			flusher.Flush()
 
 		case <-ticker.C:
-			// Send keepalive comment
-			if err := writeWithDeadline([]byte(": keepalive\n\n")); err != nil {
-				logger.Warn("sse keepalive write error", slog.Any("error", err))
+			// Send heartbeat comment
+			if err := writeWithDeadline([]byte(":ping\n\n")); err != nil {
+				logger.Warn("sse heartbeat write error", slog.Any("error", err))
 				return
 			}
 			flusher.Flush()
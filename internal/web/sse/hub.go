@@ -1,36 +1,96 @@
 package sse
 
 import (
+	"context"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 )
 
+// HubConfig holds configuration for SSE hubs
+type HubConfig struct {
+	// HeartbeatInterval is how often idle connections are sent a comment,
+	// so proxies don't time them out
+	HeartbeatInterval time.Duration
+	// SlowClientTimeout is how long a client's buffer may stay full before
+	// the client is disconnected
+	SlowClientTimeout time.Duration
+	// PresenceRetention is how long a player's last-seen time is kept after
+	// they disconnect; CleanupEmptyHubs forgets older ones
+	PresenceRetention time.Duration
+	// CleanupInterval is how often RunCleanup calls CleanupEmptyHubs
+	CleanupInterval time.Duration
+}
+
+// DefaultHubConfig returns default hub configuration
+func DefaultHubConfig() HubConfig {
+	return HubConfig{
+		HeartbeatInterval: 15 * time.Second,
+		SlowClientTimeout: 10 * time.Second,
+		PresenceRetention: time.Hour,
+		CleanupInterval:   5 * time.Minute,
+	}
+}
+
+// withDefaults fills zero-valued fields from DefaultHubConfig
+func (c HubConfig) withDefaults() HubConfig {
+	if c.HeartbeatInterval == 0 {
+		c.HeartbeatInterval = DefaultHubConfig().HeartbeatInterval
+	}
+	if c.SlowClientTimeout == 0 {
+		c.SlowClientTimeout = DefaultHubConfig().SlowClientTimeout
+	}
+	if c.PresenceRetention == 0 {
+		c.PresenceRetention = DefaultHubConfig().PresenceRetention
+	}
+	if c.CleanupInterval == 0 {
+		c.CleanupInterval = DefaultHubConfig().CleanupInterval
+	}
+	return c
+}
+
+// outgoing is a formatted message waiting to be sent to a hub's clients
+type outgoing struct {
+	data []byte
+	// to selects which players receive the message; nil sends to everyone
+	to func(model.PlayerID) bool
+	// final closes the hub once the message has been sent
+	final bool
+}
+
 // Hub manages SSE clients for a single lobby
 type Hub struct {
 	lobbyCode model.LobbyCode
 	clients   map[*Client]bool
 	mu        sync.RWMutex
+	cfg       HubConfig
 	logger    *slog.Logger
 
 	// Channels for managing clients
 	register   chan *Client
 	unregister chan *Client
-	broadcast  chan []byte
+	broadcast  chan outgoing
 	done       chan struct{}
+	closeOnce  sync.Once
+
+	// onDisconnect is called when a player's client leaves, if set
+	onDisconnect func(playerID model.PlayerID)
 }
 
 // NewHub creates a new Hub for a lobby
-func NewHub(lobbyCode model.LobbyCode, logger *slog.Logger) *Hub {
+func NewHub(lobbyCode model.LobbyCode, cfg HubConfig, logger *slog.Logger) *Hub {
 	return &Hub{
 		lobbyCode:  lobbyCode,
 		clients:    make(map[*Client]bool),
+		cfg:        cfg.withDefaults(),
 		logger:     logger.With(slog.String("lobby", string(lobbyCode))),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
-		broadcast:  make(chan []byte, 256),
+		broadcast:  make(chan outgoing, 256),
 		done:       make(chan struct{}),
 	}
 }
@@ -50,33 +110,31 @@ func (h *Hub) Run() {
 				slog.Int("total_clients", clientCount))
 
 		case client := <-h.unregister:
-			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.send)
-				clientCount := len(h.clients)
-				h.mu.Unlock()
-				duration := time.Since(client.connectedAt)
-				h.logger.Info("sse client unregistered",
-					slog.String("player_id", string(client.playerID)),
-					slog.Duration("connection_duration", duration),
-					slog.Int("total_clients", clientCount))
-			} else {
-				h.mu.Unlock()
-			}
+			h.removeClient(client, "sse client unregistered")
 
-		case message := <-h.broadcast:
+		case msg := <-h.broadcast:
+			now := time.Now()
+			var stalled []*Client
 			h.mu.RLock()
 			sentCount := 0
 			droppedCount := 0
 			for client := range h.clients {
+				if msg.to != nil && !msg.to(client.playerID) {
+					continue
+				}
 				select {
-				case client.send <- message:
+				case client.send <- msg.data:
+					client.stalledSince = time.Time{}
 					sentCount++
 				default:
 					droppedCount++
 					h.logger.Warn("sse message dropped - client buffer full",
 						slog.String("player_id", string(client.playerID)))
+					if client.stalledSince.IsZero() {
+						client.stalledSince = now
+					} else if now.Sub(client.stalledSince) >= h.cfg.SlowClientTimeout {
+						stalled = append(stalled, client)
+					}
 				}
 			}
 			h.mu.RUnlock()
@@ -86,6 +144,15 @@ func (h *Hub) Run() {
 					slog.Int("dropped", droppedCount))
 			}
 
+			// Disconnect clients that have stopped reading, so they don't
+			// hold on to a full buffer indefinitely
+			for _, client := range stalled {
+				h.removeClient(client, "sse slow client pruned")
+			}
+			if msg.final {
+				h.Close()
+			}
+
 		case <-h.done:
 			h.mu.Lock()
 			clientCount := len(h.clients)
@@ -100,23 +167,50 @@ func (h *Hub) Run() {
 	}
 }
 
-// Register adds a client to the hub
+// removeClient removes a registered client and closes its send channel,
+// which ends its connection. Removing a client that has already gone is a
+// no-op. Only called from Run.
+func (h *Hub) removeClient(client *Client, reason string) {
+	h.mu.Lock()
+	if _, ok := h.clients[client]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.clients, client)
+	close(client.send)
+	clientCount := len(h.clients)
+	h.mu.Unlock()
+
+	h.logger.Info(reason,
+		slog.String("player_id", string(client.playerID)),
+		slog.Duration("connection_duration", time.Since(client.connectedAt)),
+		slog.Int("total_clients", clientCount))
+	if h.onDisconnect != nil {
+		h.onDisconnect(client.playerID)
+	}
+}
+
+// Register adds a client to the hub. A client registered after the hub has
+// closed has its send channel closed straight away, ending its connection.
 func (h *Hub) Register(client *Client) {
-	h.register <- client
+	select {
+	case h.register <- client:
+	case <-h.done:
+		close(client.send)
+	}
 }
 
 // Unregister removes a client from the hub
 func (h *Hub) Unregister(client *Client) {
-	h.unregister <- client
+	select {
+	case h.unregister <- client:
+	case <-h.done:
+	}
 }
 
 // Broadcast sends a message to all clients
 func (h *Hub) Broadcast(message []byte) {
-	select {
-	case h.broadcast <- message:
-	default:
-		h.logger.Warn("sse broadcast dropped - hub buffer full")
-	}
+	h.enqueue(outgoing{data: message})
 }
 
 // BroadcastEvent sends an SSE event with a name and data
@@ -125,9 +219,53 @@ func (h *Hub) BroadcastEvent(eventName, data string) {
 	h.Broadcast(msg)
 }
 
-// Close shuts down the hub
+// BroadcastEventTo sends an SSE event only to clients whose player matches
+// to. The filter runs on the hub's goroutine when the message is sent.
+func (h *Hub) BroadcastEventTo(eventName, data string, to func(model.PlayerID) bool) {
+	h.enqueue(outgoing{data: formatSSEMessage(eventName, data), to: to})
+}
+
+// SendToPlayer sends an SSE event only to the given player's clients, for
+// updates that are private to them
+func (h *Hub) SendToPlayer(playerID model.PlayerID, eventName, data string) {
+	h.BroadcastEventTo(eventName, data, func(id model.PlayerID) bool {
+		return id == playerID
+	})
+}
+
+func (h *Hub) enqueue(msg outgoing) {
+	select {
+	case h.broadcast <- msg:
+	default:
+		h.logger.Warn("sse broadcast dropped - hub buffer full")
+	}
+}
+
+// Close shuts down the hub. Closing more than once is a no-op.
 func (h *Hub) Close() {
-	close(h.done)
+	h.closeOnce.Do(func() { close(h.done) })
+}
+
+// Closed reports whether the hub has shut down
+func (h *Hub) Closed() bool {
+	select {
+	case <-h.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// closeWithEvent sends an SSE event to every client and then shuts down the
+// hub, so the event is the last thing clients receive. If the hub's buffer
+// is full the event is skipped and the hub closes straight away.
+func (h *Hub) closeWithEvent(eventName, data string) {
+	select {
+	case h.broadcast <- outgoing{data: formatSSEMessage(eventName, data), final: true}:
+	default:
+		h.logger.Warn("sse final event dropped - hub buffer full")
+		h.Close()
+	}
 }
 
 // ClientCount returns the number of connected clients
@@ -137,6 +275,23 @@ func (h *Hub) ClientCount() int {
 	return len(h.clients)
 }
 
+// LobbyCode returns the code of the lobby this hub serves
+func (h *Hub) LobbyCode() model.LobbyCode {
+	return h.lobbyCode
+}
+
+// PlayerClientCounts returns the number of connected clients per player
+// A player has more than one client when the lobby is open in several tabs
+func (h *Hub) PlayerClientCounts() map[model.PlayerID]int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	counts := make(map[model.PlayerID]int)
+	for client := range h.clients {
+		counts[client.playerID]++
+	}
+	return counts
+}
+
 // formatSSEMessage formats an SSE message with event name and data
 // Multi-line data is properly formatted with "data: " prefix on each line
 func formatSSEMessage(eventName, data string) []byte {
@@ -175,18 +330,33 @@ func splitLines(s string) []string {
 type HubManager struct {
 	hubs   map[model.LobbyCode]*Hub
 	mu     sync.RWMutex
+	cfg    HubConfig
+	clock  clock.Clock
 	logger *slog.Logger
+
+	// When each player last disconnected from each lobby, kept after the
+	// lobby's hub is cleaned up
+	seenMu   sync.Mutex
+	lastSeen map[model.LobbyCode]map[model.PlayerID]time.Time
+
+	// shutdown is set once Shutdown has been called; no new hubs run after
+	shutdown bool
 }
 
 // NewHubManager creates a new HubManager
-func NewHubManager(logger *slog.Logger) *HubManager {
+// Zero-valued config fields fall back to DefaultHubConfig.
+func NewHubManager(cfg HubConfig, clk clock.Clock, logger *slog.Logger) *HubManager {
 	return &HubManager{
-		hubs:   make(map[model.LobbyCode]*Hub),
-		logger: logger.With(slog.String("component", "sse")),
+		hubs:     make(map[model.LobbyCode]*Hub),
+		cfg:      cfg.withDefaults(),
+		clock:    clk,
+		logger:   logger.With(slog.String("component", "sse")),
+		lastSeen: make(map[model.LobbyCode]map[model.PlayerID]time.Time),
 	}
 }
 
 // GetOrCreateHub returns the hub for a lobby, creating one if it doesn't exist
+// After Shutdown it returns an already-closed hub, which ServeSSE refuses.
 func (m *HubManager) GetOrCreateHub(lobbyCode model.LobbyCode) *Hub {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -195,7 +365,14 @@ func (m *HubManager) GetOrCreateHub(lobbyCode model.LobbyCode) *Hub {
 		return hub
 	}
 
-	hub := NewHub(lobbyCode, m.logger)
+	hub := NewHub(lobbyCode, m.cfg, m.logger)
+	if m.shutdown {
+		hub.Close()
+		return hub
+	}
+	hub.onDisconnect = func(playerID model.PlayerID) {
+		m.recordSeen(lobbyCode, playerID)
+	}
 	m.hubs[lobbyCode] = hub
 	go hub.Run()
 	return hub
@@ -208,7 +385,21 @@ func (m *HubManager) GetHub(lobbyCode model.LobbyCode) *Hub {
 	return m.hubs[lobbyCode]
 }
 
-// RemoveHub removes and closes a hub
+// Hubs returns a snapshot of all active hubs, ordered by lobby code
+func (m *HubManager) Hubs() []*Hub {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	hubs := make([]*Hub, 0, len(m.hubs))
+	for _, hub := range m.hubs {
+		hubs = append(hubs, hub)
+	}
+	sort.Slice(hubs, func(i, j int) bool {
+		return hubs[i].lobbyCode < hubs[j].lobbyCode
+	})
+	return hubs
+}
+
+// RemoveHub removes and closes a hub, forgetting who was seen in the lobby
 func (m *HubManager) RemoveHub(lobbyCode model.LobbyCode) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -218,10 +409,77 @@ func (m *HubManager) RemoveHub(lobbyCode model.LobbyCode) {
 		delete(m.hubs, lobbyCode)
 		m.logger.Info("sse hub removed", slog.String("lobby", string(lobbyCode)))
 	}
+
+	m.seenMu.Lock()
+	delete(m.lastSeen, lobbyCode)
+	m.seenMu.Unlock()
+}
+
+// LastSeen reports when a player was last connected to a lobby's event stream
+// present is true if they are connected now. A zero time means the player
+// hasn't disconnected from the lobby since the server started.
+func (m *HubManager) LastSeen(lobbyCode model.LobbyCode, playerID model.PlayerID) (lastSeen time.Time, present bool) {
+	if hub := m.GetHub(lobbyCode); hub != nil && hub.PlayerClientCounts()[playerID] > 0 {
+		return m.clock.Now(), true
+	}
+
+	m.seenMu.Lock()
+	defer m.seenMu.Unlock()
+	return m.lastSeen[lobbyCode][playerID], false
 }
 
-// CleanupEmptyHubs removes hubs with no clients
+// recordSeen notes that a player was connected to a lobby until now
+func (m *HubManager) recordSeen(lobbyCode model.LobbyCode, playerID model.PlayerID) {
+	m.seenMu.Lock()
+	defer m.seenMu.Unlock()
+
+	players, ok := m.lastSeen[lobbyCode]
+	if !ok {
+		players = make(map[model.PlayerID]time.Time)
+		m.lastSeen[lobbyCode] = players
+	}
+	players[playerID] = m.clock.Now()
+}
+
+// ServerShutdownEvent is the SSE event sent to every client when the server
+// is shutting down, so clients can show they are reconnecting and back off
+const ServerShutdownEvent = "server-shutdown"
+
+// Shutdown sends ServerShutdownEvent to every connected client, closes all
+// hubs so their connections end, and refuses new connections. Call it before
+// shutting down the HTTP server, which otherwise waits on open streams.
+func (m *HubManager) Shutdown() {
+	m.mu.Lock()
+	m.shutdown = true
+	hubs := m.hubs
+	m.hubs = make(map[model.LobbyCode]*Hub)
+	m.mu.Unlock()
+
+	for _, hub := range hubs {
+		hub.closeWithEvent(ServerShutdownEvent, `{"status":"shutting_down"}`)
+	}
+	m.logger.Info("sse hubs shut down", slog.Int("hubs", len(hubs)))
+}
+
+// RunCleanup calls CleanupEmptyHubs every CleanupInterval until ctx is done
+func (m *HubManager) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CleanupEmptyHubs()
+		}
+	}
+}
+
+// CleanupEmptyHubs removes hubs with no clients, and forgets players last
+// seen longer than PresenceRetention ago
 func (m *HubManager) CleanupEmptyHubs() {
+	m.pruneLastSeen()
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -237,3 +495,21 @@ func (m *HubManager) CleanupEmptyHubs() {
 		m.logger.Info("sse empty hubs cleaned up", slog.Int("removed", removedCount))
 	}
 }
+
+// pruneLastSeen forgets last-seen times older than PresenceRetention
+func (m *HubManager) pruneLastSeen() {
+	m.seenMu.Lock()
+	defer m.seenMu.Unlock()
+
+	cutoff := m.clock.Now().Add(-m.cfg.PresenceRetention)
+	for code, players := range m.lastSeen {
+		for playerID, seen := range players {
+			if seen.Before(cutoff) {
+				delete(players, playerID)
+			}
+		}
+		if len(players) == 0 {
+			delete(m.lastSeen, code)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/clock"
+	"github.com/mcoot/crosswordgame-go2/internal/dependencies/mocks"
 	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/testutil"
 )
@@ -104,7 +106,7 @@ func TestSplitLines(t *testing.T) {
 }
 
 func TestHub_RegisterAndBroadcast(t *testing.T) {
-	hub := NewHub("TESTCODE", testutil.NopLogger())
+	hub := NewHub("TESTCODE", DefaultHubConfig(), testutil.NopLogger())
 	go hub.Run()
 	defer hub.Close()
 
@@ -135,7 +137,7 @@ func TestHub_RegisterAndBroadcast(t *testing.T) {
 }
 
 func TestHub_Unregister(t *testing.T) {
-	hub := NewHub("TESTCODE", testutil.NopLogger())
+	hub := NewHub("TESTCODE", DefaultHubConfig(), testutil.NopLogger())
 	go hub.Run()
 	defer hub.Close()
 
@@ -157,7 +159,7 @@ func TestHub_Unregister(t *testing.T) {
 }
 
 func TestHub_BroadcastToMultipleClients(t *testing.T) {
-	hub := NewHub("TESTCODE", testutil.NopLogger())
+	hub := NewHub("TESTCODE", DefaultHubConfig(), testutil.NopLogger())
 	go hub.Run()
 	defer hub.Close()
 
@@ -193,8 +195,90 @@ func TestHub_BroadcastToMultipleClients(t *testing.T) {
 	}
 }
 
+func TestHub_SendToPlayer(t *testing.T) {
+	hub := NewHub("TESTCODE", DefaultHubConfig(), testutil.NopLogger())
+	go hub.Run()
+	defer hub.Close()
+
+	client1 := NewClient(hub, "player1")
+	client2 := NewClient(hub, "player2")
+	hub.Register(client1)
+	hub.Register(client2)
+	time.Sleep(10 * time.Millisecond)
+
+	hub.SendToPlayer("player1", "rack", "private")
+
+	select {
+	case msg := <-client1.send:
+		expected := "event: rack\ndata: private\n\n"
+		if string(msg) != expected {
+			t.Errorf("player1 received %q, want %q", string(msg), expected)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("player1 did not receive message")
+	}
+
+	select {
+	case msg := <-client2.send:
+		t.Errorf("player2 received private message: %q", string(msg))
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHub_PrunesStalledClient(t *testing.T) {
+	hub := NewHub("TESTCODE", HubConfig{SlowClientTimeout: 20 * time.Millisecond}, testutil.NopLogger())
+	disconnected := make(chan model.PlayerID, 1)
+	hub.onDisconnect = func(playerID model.PlayerID) {
+		disconnected <- playerID
+	}
+	go hub.Run()
+	defer hub.Close()
+
+	// An unbuffered send channel that nobody reads is permanently full
+	stalled := &Client{hub: hub, playerID: "stalled", send: make(chan []byte), connectedAt: time.Now()}
+	healthy := NewClient(hub, "healthy")
+	hub.Register(stalled)
+	hub.Register(healthy)
+	time.Sleep(10 * time.Millisecond)
+
+	// Broadcasts keep reaching the healthy client while the stalled one
+	// times out
+	deadline := time.After(time.Second)
+	for i := 0; hub.ClientCount() > 1; i++ {
+		hub.BroadcastEvent("update", "data")
+		select {
+		case <-healthy.send:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("healthy client did not receive broadcast %d", i)
+		case <-deadline:
+			t.Fatal("stalled client was not pruned")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// The stalled client's channel is closed, ending its connection
+	if _, ok := <-stalled.send; ok {
+		t.Error("stalled client's send channel was not closed")
+	}
+	select {
+	case playerID := <-disconnected:
+		if playerID != "stalled" {
+			t.Errorf("disconnected %q, want stalled", playerID)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("disconnect callback was not called")
+	}
+
+	hub.BroadcastEvent("update", "after")
+	select {
+	case <-healthy.send:
+	case <-time.After(100 * time.Millisecond):
+		t.Error("healthy client did not receive broadcast after pruning")
+	}
+}
+
 func TestHubManager_GetOrCreateHub(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 
 	// Get or create a hub
 	hub1 := manager.GetOrCreateHub("ABC123")
@@ -220,7 +304,7 @@ func TestHubManager_GetOrCreateHub(t *testing.T) {
 }
 
 func TestHubManager_GetHub(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 
 	// GetHub on non-existent hub should return nil
 	hub := manager.GetHub("NOTEXIST")
@@ -239,7 +323,7 @@ func TestHubManager_GetHub(t *testing.T) {
 }
 
 func TestHubManager_RemoveHub(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 
 	hub := manager.GetOrCreateHub("ABC123")
 	_ = hub // Just to ensure it's created
@@ -257,7 +341,7 @@ func TestHubManager_RemoveHub(t *testing.T) {
 }
 
 func TestHubManager_CleanupEmptyHubs(t *testing.T) {
-	manager := NewHubManager(testutil.NopLogger())
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
 
 	// Create a hub with no clients
 	hub1 := manager.GetOrCreateHub(model.LobbyCode("EMPTY"))
@@ -284,3 +368,117 @@ func TestHubManager_CleanupEmptyHubs(t *testing.T) {
 
 	manager.RemoveHub("ACTIVE")
 }
+
+func TestHubManager_HubsReportsClientCounts(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	defer manager.RemoveHub("BBB222")
+	defer manager.RemoveHub("AAA111")
+
+	hubB := manager.GetOrCreateHub("BBB222")
+	hubA := manager.GetOrCreateHub("AAA111")
+	hubA.Register(NewClient(hubA, "player1"))
+	hubA.Register(NewClient(hubA, "player1"))
+	hubA.Register(NewClient(hubA, "player2"))
+	time.Sleep(10 * time.Millisecond)
+
+	hubs := manager.Hubs()
+	if len(hubs) != 2 {
+		t.Fatalf("Hubs() returned %d hubs, want 2", len(hubs))
+	}
+	if hubs[0] != hubA || hubs[1] != hubB {
+		t.Error("Hubs() not ordered by lobby code")
+	}
+	if hubA.LobbyCode() != "AAA111" {
+		t.Errorf("LobbyCode() = %q, want AAA111", hubA.LobbyCode())
+	}
+
+	counts := hubA.PlayerClientCounts()
+	if counts["player1"] != 2 || counts["player2"] != 1 {
+		t.Errorf("PlayerClientCounts() = %v, want player1=2 player2=1", counts)
+	}
+	if len(hubB.PlayerClientCounts()) != 0 {
+		t.Errorf("PlayerClientCounts() for empty hub = %v, want empty", hubB.PlayerClientCounts())
+	}
+}
+
+func TestHubManager_LastSeen(t *testing.T) {
+	clk := mocks.NewMockClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	manager := NewHubManager(DefaultHubConfig(), clk, testutil.NopLogger())
+	defer manager.RemoveHub("AAA111")
+
+	if seen, present := manager.LastSeen("AAA111", "player1"); present || !seen.IsZero() {
+		t.Errorf("LastSeen() before connecting = %v, %v; want zero, false", seen, present)
+	}
+
+	hub := manager.GetOrCreateHub("AAA111")
+	client := NewClient(hub, "player1")
+	hub.Register(client)
+	time.Sleep(10 * time.Millisecond)
+
+	if _, present := manager.LastSeen("AAA111", "player1"); !present {
+		t.Error("LastSeen() while connected reports not present")
+	}
+
+	clk.Advance(time.Minute)
+	disconnectedAt := clk.Now()
+	hub.Unregister(client)
+	time.Sleep(10 * time.Millisecond)
+
+	// The disconnect time outlives the hub being cleaned up
+	manager.CleanupEmptyHubs()
+	seen, present := manager.LastSeen("AAA111", "player1")
+	if present {
+		t.Error("LastSeen() after disconnecting reports present")
+	}
+	if !seen.Equal(disconnectedAt) {
+		t.Errorf("LastSeen() = %v, want %v", seen, disconnectedAt)
+	}
+
+	// Until it is older than the retention period
+	clk.Advance(DefaultHubConfig().PresenceRetention + time.Second)
+	manager.CleanupEmptyHubs()
+	if seen, _ := manager.LastSeen("AAA111", "player1"); !seen.IsZero() {
+		t.Errorf("LastSeen() after retention = %v, want zero", seen)
+	}
+	if len(manager.lastSeen) != 0 {
+		t.Errorf("lastSeen has %d lobbies after retention, want 0", len(manager.lastSeen))
+	}
+}
+
+func TestHubManager_ShutdownNotifiesClients(t *testing.T) {
+	manager := NewHubManager(DefaultHubConfig(), clock.New(), testutil.NopLogger())
+	hub := manager.GetOrCreateHub("LOBBY1")
+	client := NewClient(hub, "player1")
+	hub.Register(client)
+	time.Sleep(10 * time.Millisecond)
+
+	manager.Shutdown()
+
+	select {
+	case msg := <-client.send:
+		expected := "event: server-shutdown\ndata: {\"status\":\"shutting_down\"}\n\n"
+		if string(msg) != expected {
+			t.Errorf("client received %q, want %q", string(msg), expected)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("client did not receive shutdown event")
+	}
+
+	// The hub then closes the client's connection
+	select {
+	case _, ok := <-client.send:
+		if ok {
+			t.Error("expected client channel to be closed after shutdown event")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("client channel was not closed")
+	}
+
+	// New connections are refused
+	if len(manager.Hubs()) != 0 {
+		t.Errorf("Hubs() = %d after shutdown, want 0", len(manager.Hubs()))
+	}
+	if !manager.GetOrCreateHub("LOBBY2").Closed() {
+		t.Error("expected hubs created after shutdown to be closed")
+	}
+}
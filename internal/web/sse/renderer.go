@@ -51,6 +51,12 @@ func WrapForOOBSwap(id, html string) string {
 	return `<div id="` + id + `" hx-swap-oob="true">` + html + `</div>`
 }
 
+// WrapForOOBAppend wraps HTML for an out-of-band swap that appends it to the
+// element with the given ID rather than replacing the element
+func WrapForOOBAppend(id, html string) string {
+	return `<div id="` + id + `" hx-swap-oob="beforeend">` + html + `</div>`
+}
+
 // EventData represents SSE event data
 type EventData struct {
 	EventName string
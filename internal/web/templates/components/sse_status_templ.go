@@ -32,7 +32,7 @@ func SSEStatus() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div id=\"sse-status\" class=\"sse-status connected\"><span class=\"sse-dot\"></span> <span class=\"sse-text\">Reconnecting...</span></div><script>\n\t\t(function() {\n\t\t\tconst status = document.getElementById('sse-status');\n\t\t\tif (!status) return;\n\n\t\t\t// Track connection state\n\t\t\tlet isConnected = false;\n\t\t\tlet reconnectTimeout = null;\n\n\t\t\tfunction setConnected() {\n\t\t\t\tisConnected = true;\n\t\t\t\tif (reconnectTimeout) {\n\t\t\t\t\tclearTimeout(reconnectTimeout);\n\t\t\t\t\treconnectTimeout = null;\n\t\t\t\t}\n\t\t\t\tstatus.className = 'sse-status connected';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = '';\n\t\t\t}\n\n\t\t\tfunction setDisconnected() {\n\t\t\t\tisConnected = false;\n\t\t\t\tstatus.className = 'sse-status disconnected';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = 'Connection lost';\n\t\t\t}\n\n\t\t\tfunction setReconnecting() {\n\t\t\t\tstatus.className = 'sse-status reconnecting';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = 'Reconnecting...';\n\t\t\t}\n\n\t\t\t// Listen to HTMX SSE events\n\t\t\tdocument.body.addEventListener('htmx:sseOpen', function() {\n\t\t\t\tsetConnected();\n\t\t\t});\n\n\t\t\tdocument.body.addEventListener('htmx:sseError', function() {\n\t\t\t\t// On error, show reconnecting state briefly then disconnected\n\t\t\t\tsetReconnecting();\n\t\t\t\treconnectTimeout = setTimeout(function() {\n\t\t\t\t\tif (!isConnected) {\n\t\t\t\t\t\tsetDisconnected();\n\t\t\t\t\t}\n\t\t\t\t}, 5000);\n\t\t\t});\n\n\t\t\t// The 'connected' SSE event from our server indicates successful connection\n\t\t\tdocument.body.addEventListener('sse:connected', function() {\n\t\t\t\tsetConnected();\n\t\t\t});\n\n\t\t\t// Also handle the native EventSource events if exposed\n\t\t\tdocument.body.addEventListener('htmx:sseBeforeMessage', function() {\n\t\t\t\t// Any message means we're connected\n\t\t\t\tif (!isConnected) {\n\t\t\t\t\tsetConnected();\n\t\t\t\t}\n\t\t\t});\n\t\t})();\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div id=\"sse-status\" class=\"sse-status connected\"><span class=\"sse-dot\"></span> <span class=\"sse-text\">Reconnecting...</span></div><script>\n\t\t(function() {\n\t\t\tconst status = document.getElementById('sse-status');\n\t\t\tif (!status) return;\n\n\t\t\t// Track connection state\n\t\t\tlet isConnected = false;\n\t\t\tlet reconnectTimeout = null;\n\n\t\t\tfunction setConnected() {\n\t\t\t\tisConnected = true;\n\t\t\t\tif (reconnectTimeout) {\n\t\t\t\t\tclearTimeout(reconnectTimeout);\n\t\t\t\t\treconnectTimeout = null;\n\t\t\t\t}\n\t\t\t\tstatus.className = 'sse-status connected';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = '';\n\t\t\t}\n\n\t\t\tfunction setDisconnected() {\n\t\t\t\tisConnected = false;\n\t\t\t\tstatus.className = 'sse-status disconnected';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = 'Connection lost';\n\t\t\t}\n\n\t\t\tfunction setReconnecting() {\n\t\t\t\tstatus.className = 'sse-status reconnecting';\n\t\t\t\tstatus.querySelector('.sse-text').textContent = 'Reconnecting...';\n\t\t\t}\n\n\t\t\t// Listen to HTMX SSE events\n\t\t\tdocument.body.addEventListener('htmx:sseOpen', function() {\n\t\t\t\tsetConnected();\n\t\t\t});\n\n\t\t\tdocument.body.addEventListener('htmx:sseError', function() {\n\t\t\t\t// On error, show reconnecting state briefly then disconnected\n\t\t\t\tsetReconnecting();\n\t\t\t\treconnectTimeout = setTimeout(function() {\n\t\t\t\t\tif (!isConnected) {\n\t\t\t\t\t\tsetDisconnected();\n\t\t\t\t\t}\n\t\t\t\t}, 5000);\n\t\t\t});\n\n\t\t\t// The 'connected' SSE event from our server indicates successful connection\n\t\t\tdocument.body.addEventListener('sse:connected', function() {\n\t\t\t\tsetConnected();\n\t\t\t});\n\n\t\t\t// The server is restarting; its stream is about to end, so show\n\t\t\t// we're reconnecting rather than waiting for the error\n\t\t\tdocument.body.addEventListener('sse:server-shutdown', function() {\n\t\t\t\tisConnected = false;\n\t\t\t\tsetReconnecting();\n\t\t\t});\n\n\t\t\t// Also handle the native EventSource events if exposed\n\t\t\tdocument.body.addEventListener('htmx:sseBeforeMessage', function() {\n\t\t\t\t// Any message means we're connected\n\t\t\t\tif (!isConnected) {\n\t\t\t\t\tsetConnected();\n\t\t\t\t}\n\t\t\t});\n\t\t})();\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
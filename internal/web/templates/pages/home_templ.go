@@ -9,13 +9,16 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"github.com/mcoot/crosswordgame-go2/internal/model"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/components"
 	"github.com/mcoot/crosswordgame-go2/internal/web/templates/layout"
 )
 
 type HomeData struct {
 	layout.PageData
-	Next string
+	Next            string
+	ResumeLobbyCode model.LobbyCode // Set if the player has an in-progress game
+	GridSizes       []int           // Grid sizes offered when creating a lobby
 }
 
 func Home(data HomeData) templ.Component {
@@ -68,7 +71,7 @@ func Home(data HomeData) templ.Component {
 					var templ_7745c5c3_Var3 string
 					templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(data.Next)
 					if templ_7745c5c3_Err != nil {
-						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/web/templates/pages/home.templ`, Line: 26, Col: 58}
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/web/templates/pages/home.templ`, Line: 29, Col: 58}
 					}
 					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
 					if templ_7745c5c3_Err != nil {
@@ -84,20 +87,52 @@ func Home(data HomeData) templ.Component {
 					return templ_7745c5c3_Err
 				}
 			} else {
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<section class=\"home-section\"><h2>Create or Join a Lobby</h2><div class=\"card-grid\"><div class=\"card\"><h3>Create New Lobby</h3><p>Start a new game lobby and invite others to join.</p><form action=\"/lobby\" method=\"post\" class=\"form-stack\"><div class=\"form-group\"><label for=\"grid_size\">Grid Size</label>")
+				if data.ResumeLobbyCode != "" {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<section class=\"home-section\" id=\"resume-game\"><div class=\"card\"><h3>Game in Progress</h3><p>You have a game in progress in lobby <span class=\"lobby-code\">")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					var templ_7745c5c3_Var4 string
+					templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(string(data.ResumeLobbyCode))
+					if templ_7745c5c3_Err != nil {
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/web/templates/pages/home.templ`, Line: 41, Col: 102}
+					}
+					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</span>.</p><a href=\"")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					var templ_7745c5c3_Var5 templ.SafeURL
+					templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL("/lobby/" + string(data.ResumeLobbyCode) + "/game"))
+					if templ_7745c5c3_Err != nil {
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/web/templates/pages/home.templ`, Line: 41, Col: 82}
+					}
+					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "\" class=\"btn btn-primary\">Resume game</a></div></section>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, " <section class=\"home-section\"><h2>Create or Join a Lobby</h2><div class=\"card-grid\"><div class=\"card\"><h3>Create New Lobby</h3><p>Start a new game lobby and invite others to join.</p><form action=\"/lobby\" method=\"post\" class=\"form-stack\"><div class=\"form-group\"><label for=\"grid_size\">Grid Size</label>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = components.GridSizeSelect(0).Render(ctx, templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = components.GridSizeSelect(0, data.GridSizes).Render(ctx, templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</div><button type=\"submit\" class=\"btn btn-primary\">Create Lobby</button></form></div><div class=\"card\"><h3>Join Existing Lobby</h3><p>Enter a lobby code to join an existing game.</p><form action=\"/lobby/join\" method=\"post\" class=\"form-stack\"><div class=\"form-group\"><label for=\"code\">Lobby Code</label> <input type=\"text\" name=\"code\" id=\"code\" placeholder=\"ABC123\" required maxlength=\"6\" class=\"input input-uppercase\"></div><button type=\"submit\" class=\"btn btn-secondary\">Join Lobby</button></form></div></div></section>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "</div><button type=\"submit\" class=\"btn btn-primary\">Create Lobby</button></form></div><div class=\"card\"><h3>Join Existing Lobby</h3><p>Enter a lobby code to join an existing game.</p><form action=\"/lobby/join\" method=\"post\" class=\"form-stack\"><div class=\"form-group\"><label for=\"code\">Lobby Code</label> <input type=\"text\" name=\"code\" id=\"code\" placeholder=\"ABC123\" required maxlength=\"6\" class=\"input input-uppercase\"></div><button type=\"submit\" class=\"btn btn-secondary\">Join Lobby</button></form></div></div></section>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "<section class=\"home-section\"><h2>How to Play</h2><ol class=\"rules-list\"><li>Join or create a lobby with friends</li><li>Players take turns announcing a letter</li><li>Everyone places the announced letter on their own grid</li><li>Once grids are full, words are scored horizontally and vertically</li><li>Longer words score more points - full rows/columns score double!</li></ol></section></div>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<section class=\"home-section\"><h2>How to Play</h2><ol class=\"rules-list\"><li>Join or create a lobby with friends</li><li>Players take turns announcing a letter</li><li>Everyone places the announced letter on their own grid</li><li>Once grids are full, words are scored horizontally and vertically</li><li>Longer words score more points - full rows/columns score double!</li></ol></section></div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -91,3 +91,15 @@ func TestStaticFileServing(t *testing.T) {
 	// Skipping as static files are not set up in test server
 	t.Skip("Static files not configured in test server")
 }
+
+func TestUnknownPathRendersNotFoundPage(t *testing.T) {
+	ts := newWebTestServer(t)
+	ts.createGuestPlayer("Alice")
+
+	rr := ts.get("/no/such/page")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	doc := parseHTML(rr.Body)
+	assertContainsText(t, doc, ".error-page h1", "404")
+	assertContainsText(t, doc, ".nav-player", "Alice")
+}
@@ -106,6 +106,43 @@ func TestAnnounceLetter(t *testing.T) {
 	assertContainsText(t, doc, "#game-status", "A")
 }
 
+func TestAnnouncePlace(t *testing.T) {
+	ts := newWebTestServer(t)
+	lobbyCode, aliceCookies, bobCookies := setupTwoPlayerGame(t, ts, 3)
+
+	// Start game
+	ts.cookies = aliceCookies
+	ts.startGame(lobbyCode)
+
+	// Find announcer
+	rr := ts.get("/lobby/" + lobbyCode + "/game")
+	aliceDoc := parseHTML(rr.Body)
+	announcerCookies, otherCookies := aliceCookies, bobCookies
+	if aliceDoc.Find("#letter-picker").Length() == 0 {
+		announcerCookies, otherCookies = bobCookies, aliceCookies
+	}
+
+	form := url.Values{"letter": {"A"}, "row": {"0"}, "col": {"0"}}
+
+	// Non-announcer is rejected
+	ts.cookies = otherCookies
+	rr = ts.postHTMX("/lobby/"+lobbyCode+"/game/announce-place", form)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.NotEmpty(t, rr.Header().Get("HX-Redirect"))
+
+	// Announcer announces and places in one request
+	ts.cookies = announcerCookies
+	rr = ts.postHTMX("/lobby/"+lobbyCode+"/game/announce-place", form)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "hx-swap-oob")
+
+	// Other player now sees the letter to place
+	ts.cookies = otherCookies
+	rr = ts.get("/lobby/" + lobbyCode + "/game")
+	doc := parseHTML(rr.Body)
+	assertContainsText(t, doc, "#game-status", "A")
+}
+
 func TestPlaceLetter(t *testing.T) {
 	ts := newWebTestServer(t)
 	lobbyCode, aliceCookies, bobCookies := setupTwoPlayerGame(t, ts, 3)
@@ -38,15 +38,16 @@ func newWebTestServer(t *testing.T) *webTestServer {
 	require.NoError(t, err)
 
 	router := web.NewRouter(web.RouterConfig{
-		Logger:          logger,
-		AuthService:     app.AuthService,
-		LobbyController: app.LobbyController,
-		GameController:  app.GameController,
-		BoardService:    app.BoardService,
-		ScoringService:  app.ScoringService,
-		BotService:      app.BotService,
-		HubManager:      app.HubManager,
-		StaticDir:       "", // No static files in tests
+		Logger:            logger,
+		AuthService:       app.AuthService,
+		LobbyController:   app.LobbyController,
+		GameController:    app.GameController,
+		BoardService:      app.BoardService,
+		ScoringService:    app.ScoringService,
+		BotService:        app.BotService,
+		DictionaryService: app.DictionaryService,
+		HubManager:        app.HubManager,
+		StaticDir:         "", // No static files in tests
 	})
 
 	return &webTestServer{
@@ -162,7 +163,7 @@ func (ts *webTestServer) createGuestPlayer(displayName string) {
 //nolint:unused
 func (ts *webTestServer) createRegisteredPlayer(username, password, displayName string) {
 	ts.t.Helper()
-	session, err := ts.app.AuthService.RegisterPlayer(ts.t.Context(), username, password, displayName)
+	session, _, err := ts.app.AuthService.RegisterPlayer(ts.t.Context(), username, password, displayName)
 	require.NoError(ts.t, err, "Expected registration to succeed")
 	// Set the session cookie
 	ts.cookies.cookies["session"] = &http.Cookie{